@@ -71,6 +71,27 @@ func App() *buffalo.App {
 
 		app.POST("/service", serviceHandler)
 
+		unsubscribe := app.Group("/unsubscribe")
+		unsubscribe.Middleware.Skip(setCurrentUser, unsubscribeConfirm, unsubscribeHandler)
+
+		unsubscribe.GET("/{token}", unsubscribeConfirm)
+		unsubscribe.POST("/{token}", unsubscribeHandler)
+
+		feeds := app.Group("/feeds")
+		feeds.Middleware.Skip(setCurrentUser, watchFeedHandler, meetingFeedHandler, userCalendarHandler,
+			meetingCalendarHandler)
+
+		feeds.GET("/{token}/watches/{id}/{format}", watchFeedHandler)
+		feeds.GET("/{token}/meetings/{id}/{format}", meetingFeedHandler)
+		feeds.GET("/{token}/meetings/{id}.ics", meetingCalendarHandler)
+		feeds.GET("/{token}/calendar.ics", userCalendarHandler)
+
+		emailEvents := app.Group("/email-events")
+		emailEvents.Middleware.Skip(setCurrentUser, sesEventsHandler, sendGridEventsHandler)
+
+		emailEvents.POST("/ses", sesEventsHandler)
+		emailEvents.POST("/sendgrid", sendGridEventsHandler)
+
 		auth := app.Group("/auth")
 		auth.Middleware.Skip(setCurrentUser, authInvite, authRequest, authSelect, authCallback,
 			authDestroy, serviceHandler)
@@ -0,0 +1,145 @@
+package actions
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gobuffalo/buffalo"
+	"github.com/gobuffalo/buffalo/render"
+
+	"github.com/silinternational/wecarry-api/calendar"
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/models"
+)
+
+// meetingCalendarHandler responds to GET requests at /feeds/{token}/meetings/{id}.ics with a calendar containing the
+// meeting, for download into a calendar app. The meeting must be visible to the token owner.
+func meetingCalendarHandler(c buffalo.Context) error {
+	user, err := authenticateFeedToken(c)
+	if err != nil {
+		return err
+	}
+
+	var meeting models.Meeting
+	if err := meeting.FindByUUID(c.Param(feedIDParam)); err != nil || !user.CanViewMeeting(c, meeting) {
+		return c.Error(http.StatusNotFound, fmt.Errorf("meeting calendar not found, %v", err))
+	}
+
+	event, err := meetingCalendarEvent(meeting)
+	if err != nil {
+		return c.Error(http.StatusInternalServerError, err)
+	}
+
+	cal := calendar.Calendar{
+		ProductID: calendarProductID(),
+		Events:    []calendar.Event{event},
+	}
+	return renderCalendar(c, cal)
+}
+
+// userCalendarHandler responds to GET requests at /feeds/{token}/calendar.ics with a calendar for subscription in a
+// calendar app. It contains the meetings the token owner participates in, and the neededBefore dates of the requests
+// they are providing.
+func userCalendarHandler(c buffalo.Context) error {
+	user, err := authenticateFeedToken(c)
+	if err != nil {
+		return err
+	}
+
+	meetings, err := user.MeetingsAsParticipant(c)
+	if err != nil {
+		return c.Error(http.StatusInternalServerError, fmt.Errorf("error finding calendar meetings, %s", err))
+	}
+
+	requests, err := user.Requests(models.RequestsProviding)
+	if err != nil {
+		return c.Error(http.StatusInternalServerError, fmt.Errorf("error finding calendar requests, %s", err))
+	}
+
+	cal := calendar.Calendar{
+		ProductID: calendarProductID(),
+		Name:      domain.Env.AppName,
+		Events:    make([]calendar.Event, 0, len(meetings)+len(requests)),
+	}
+	for _, meeting := range meetings {
+		event, err := meetingCalendarEvent(meeting)
+		if err != nil {
+			return c.Error(http.StatusInternalServerError, err)
+		}
+		cal.Events = append(cal.Events, event)
+	}
+
+	language := user.GetLanguagePreference()
+	for _, request := range requests {
+		if !request.NeededBefore.Valid ||
+			request.Status != models.RequestStatusAccepted && request.Status != models.RequestStatusDelivered {
+			continue
+		}
+		event, err := requestCalendarEvent(request, language)
+		if err != nil {
+			return c.Error(http.StatusInternalServerError, err)
+		}
+		cal.Events = append(cal.Events, event)
+	}
+
+	return renderCalendar(c, cal)
+}
+
+// calendarProductID returns the iCalendar product identifier of the App
+func calendarProductID() string {
+	return "-//" + domain.Env.AppName + "//Calendar//EN"
+}
+
+// renderCalendar renders the calendar as an iCalendar document
+func renderCalendar(c buffalo.Context, cal calendar.Calendar) error {
+	return c.Render(http.StatusOK, r.Func(calendar.ContentType, func(w io.Writer, _ render.Data) error {
+		return calendar.Write(w, cal)
+	}))
+}
+
+// meetingCalendarEvent returns the calendar event for a meeting. The event changes its sequence number whenever the
+// meeting is updated.
+func meetingCalendarEvent(meeting models.Meeting) (calendar.Event, error) {
+	location, err := meeting.GetLocation()
+	if err != nil {
+		return calendar.Event{}, fmt.Errorf("error getting meeting %s location, %s", meeting.UUID, err)
+	}
+
+	return calendar.Event{
+		UID:         "meeting-" + meeting.UUID.String(),
+		Sequence:    meeting.Sequence,
+		Summary:     meeting.Name,
+		Description: meeting.Description.String,
+		Location:    location.Description,
+		URL:         meeting.MoreInfoURL.String,
+		StartDate:   meeting.StartDate,
+		EndDate:     meeting.EndDate,
+		Updated:     meeting.UpdatedAt,
+	}, nil
+}
+
+// requestCalendarEvent returns the calendar event for the neededBefore date of a request
+func requestCalendarEvent(request models.Request, language string) (calendar.Event, error) {
+	destination, err := request.GetDestination()
+	if err != nil {
+		return calendar.Event{}, fmt.Errorf("error getting request %s destination, %s", request.UUID, err)
+	}
+
+	summary, err := domain.TranslateWithLang(language, "Calendar.NeededBefore",
+		map[string]string{"requestTitle": request.Title})
+	if err != nil {
+		domain.ErrLogger.Printf("error translating calendar event summary, %s", err)
+		summary = request.Title
+	}
+
+	return calendar.Event{
+		UID:         "request-" + request.UUID.String(),
+		Summary:     summary,
+		Description: destination.Description,
+		URL:         domain.GetRequestUIURL(request.UUID.String()),
+		StartDate:   request.NeededBefore.Time,
+		EndDate:     request.NeededBefore.Time,
+		Updated:     request.UpdatedAt,
+	}, nil
+}
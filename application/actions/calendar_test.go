@@ -0,0 +1,113 @@
+package actions
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gobuffalo/nulls"
+
+	"github.com/silinternational/wecarry-api/calendar"
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/internal/test"
+	"github.com/silinternational/wecarry-api/models"
+)
+
+func (as *ActionSuite) Test_Calendars() {
+	f := createFixturesForWatches(as)
+	user := f.Users[1]
+
+	createFixture(as, &models.MeetingParticipant{MeetingID: f.Meetings[0].ID, UserID: user.ID})
+
+	hidden := models.Meeting{
+		UUID:        domain.GetUUID(),
+		CreatedByID: f.Users[0].ID,
+		Name:        "Hidden Mtg",
+		LocationID:  f.Locations[0].ID,
+		StartDate:   time.Now(),
+		EndDate:     time.Now().Add(domain.DurationWeek),
+		Visibility:  models.MeetingVisibilityInviteOnly,
+	}
+	createFixture(as, &hidden)
+
+	neededBefore := time.Now().Add(domain.DurationWeek * 3)
+	requests := test.CreateRequestFixtures(as.DB, 3, false)
+	statuses := []models.RequestStatus{models.RequestStatusAccepted, models.RequestStatusOpen,
+		models.RequestStatusAccepted}
+	for i := range requests {
+		requests[i].ProviderID = nulls.NewInt(user.ID)
+		requests[i].Status = statuses[i]
+		requests[i].NeededBefore = nulls.NewTime(neededBefore)
+	}
+	requests[2].NeededBefore = nulls.Time{}
+	as.NoError(as.DB.Update(&requests))
+
+	token := models.FeedToken{UserID: user.ID}
+	as.NoError(token.Create())
+
+	tests := []struct {
+		name            string
+		path            string
+		wantStatus      int
+		wantContains    []string
+		wantNotContains []string
+	}{
+		{
+			name:       "meeting",
+			path:       "/feeds/" + token.Token + "/meetings/" + f.Meetings[0].UUID.String() + ".ics",
+			wantStatus: http.StatusOK,
+			wantContains: []string{
+				"UID:meeting-" + f.Meetings[0].UUID.String(),
+				"SUMMARY:" + f.Meetings[0].Name,
+				"DTSTART;VALUE=DATE:" + f.Meetings[0].StartDate.Format("20060102"),
+			},
+		},
+		{
+			name:       "user calendar",
+			path:       "/feeds/" + token.Token + "/calendar.ics",
+			wantStatus: http.StatusOK,
+			wantContains: []string{
+				"UID:meeting-" + f.Meetings[0].UUID.String(),
+				"UID:request-" + requests[0].UUID.String(),
+				"SUMMARY:Request deadline: " + requests[0].Title,
+				"DTSTART;VALUE=DATE:" + neededBefore.Format("20060102"),
+			},
+			wantNotContains: []string{
+				"UID:meeting-" + hidden.UUID.String(),
+				"UID:request-" + requests[1].UUID.String(),
+				"UID:request-" + requests[2].UUID.String(),
+			},
+		},
+		{
+			name:       "invisible meeting",
+			path:       "/feeds/" + token.Token + "/meetings/" + hidden.UUID.String() + ".ics",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "bad token",
+			path:       "/feeds/bad/calendar.ics",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		as.T().Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", tt.path, nil)
+			rr := httptest.NewRecorder()
+			as.App.ServeHTTP(rr, req)
+
+			as.Equal(tt.wantStatus, rr.Code, "incorrect status code")
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			as.Equal(calendar.ContentType, rr.Header().Get("Content-Type"))
+			for _, s := range tt.wantContains {
+				as.Contains(rr.Body.String(), s)
+			}
+			for _, s := range tt.wantNotContains {
+				as.NotContains(rr.Body.String(), s)
+			}
+		})
+	}
+}
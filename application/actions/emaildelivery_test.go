@@ -0,0 +1,68 @@
+package actions
+
+import (
+	"errors"
+
+	"github.com/gobuffalo/nulls"
+
+	"github.com/silinternational/wecarry-api/internal/test"
+	"github.com/silinternational/wecarry-api/models"
+)
+
+type emailDeliveriesResponse struct {
+	Deliveries []struct {
+		ID       string `json:"id"`
+		Template string `json:"template"`
+		ToEmail  string `json:"toEmail"`
+		User     *struct {
+			ID string `json:"id"`
+		} `json:"user"`
+		Status    models.OutboxMessageStatus `json:"status"`
+		Attempts  int                        `json:"attempts"`
+		LastError *string                    `json:"lastError"`
+	} `json:"deliveries"`
+}
+
+func (as *ActionSuite) Test_EmailDeliveries() {
+	users := test.CreateUserFixtures(as.DB, 2).Users
+	users[0].AdminRole = models.UserAdminRoleSuperAdmin
+	as.NoError(as.DB.Save(&users[0]))
+
+	messages := models.OutboxMessages{
+		{UserID: nulls.NewInt(users[1].ID), Template: "new_request", ToEmail: users[1].Email},
+		{UserID: nulls.NewInt(users[1].ID), Template: "digest", ToEmail: users[1].Email},
+		{Template: "new_user_welcome", ToEmail: "someone@example.com"},
+	}
+	for i := range messages {
+		messages[i].HTMLBody = "<p>body</p>"
+		_, err := messages[i].Enqueue()
+		as.NoError(err)
+	}
+	as.NoError(messages[0].RecordFailure(errors.New("mailbox full")))
+
+	query := `{ deliveries: emailDeliveries(userID: "` + users[1].UUID.String() + `", template: "new_request",
+		status: QUEUED) { id template toEmail user { id } status attempts lastError } }`
+
+	var resp emailDeliveriesResponse
+	as.NoError(as.testGqlQuery(query, users[0].Nickname, &resp))
+
+	as.Equal(1, len(resp.Deliveries), "incorrect number of email deliveries")
+	d := resp.Deliveries[0]
+	as.Equal(messages[0].UUID.String(), d.ID, "incorrect ID")
+	as.Equal("new_request", d.Template, "incorrect template")
+	as.Equal(users[1].Email, d.ToEmail, "incorrect toEmail")
+	as.NotNil(d.User, "user is missing")
+	as.Equal(users[1].UUID.String(), d.User.ID, "incorrect user")
+	as.Equal(models.OutboxMessageStatusQueued, d.Status, "incorrect status")
+	as.Equal(1, d.Attempts, "incorrect number of attempts")
+	as.NotNil(d.LastError, "lastError is missing")
+	as.Equal("mailbox full", *d.LastError, "incorrect lastError")
+
+	query = `{ deliveries: emailDeliveries { id } }`
+	resp = emailDeliveriesResponse{}
+	as.NoError(as.testGqlQuery(query, users[0].Nickname, &resp))
+	as.Equal(3, len(resp.Deliveries), "incorrect number of unfiltered email deliveries")
+
+	resp = emailDeliveriesResponse{}
+	as.Error(as.testGqlQuery(query, users[1].Nickname, &resp), "non-admin was allowed to see email deliveries")
+}
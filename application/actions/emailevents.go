@@ -0,0 +1,161 @@
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/gobuffalo/buffalo"
+
+	"github.com/silinternational/wecarry-api/aws"
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/models"
+	"github.com/silinternational/wecarry-api/notifications"
+)
+
+const (
+	emailEventSourceSES      = "ses"
+	emailEventSourceSendGrid = "sendgrid"
+
+	// maxEmailEventBodySize limits the size of a bounce or complaint report
+	maxEmailEventBodySize = 1024 * 1024
+)
+
+// readEmailEventBody reads the body of a bounce or complaint report
+func readEmailEventBody(c buffalo.Context) ([]byte, error) {
+	body, err := ioutil.ReadAll(io.LimitReader(c.Request().Body, maxEmailEventBodySize))
+	if err != nil {
+		return nil, c.Error(http.StatusBadRequest, fmt.Errorf("error reading email event body, %s", err))
+	}
+	return body, nil
+}
+
+// sesEventsHandler receives SES bounce and complaint notifications through an SNS subscription. Only signed messages
+// from the topic in SES_NOTIFICATION_TOPIC_ARN are accepted.
+func sesEventsHandler(c buffalo.Context) error {
+	body, err := readEmailEventBody(c)
+	if err != nil {
+		return err
+	}
+
+	var msg aws.SNSMessage
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return c.Error(http.StatusBadRequest, fmt.Errorf("error parsing SNS message, %s", err))
+	}
+
+	if domain.Env.SESNotificationTopicARN == "" || msg.TopicArn != domain.Env.SESNotificationTopicARN {
+		return c.Error(http.StatusForbidden, fmt.Errorf("SNS message from unexpected topic '%s'", msg.TopicArn))
+	}
+
+	if err := msg.Verify(); err != nil {
+		return c.Error(http.StatusForbidden, fmt.Errorf("SNS message not verified, %s", err))
+	}
+
+	switch msg.Type {
+	case aws.SNSTypeSubscriptionConfirmation:
+		if err := msg.ConfirmSubscription(); err != nil {
+			return c.Error(http.StatusInternalServerError, err)
+		}
+		domain.Logger.Printf("confirmed SNS subscription to %s", msg.TopicArn)
+
+	case aws.SNSTypeNotification:
+		var n aws.SESNotification
+		if err := json.Unmarshal([]byte(msg.Message), &n); err != nil {
+			return c.Error(http.StatusBadRequest, fmt.Errorf("error parsing SES notification, %s", err))
+		}
+		if err := recordSESNotification(n); err != nil {
+			return c.Error(http.StatusInternalServerError, err)
+		}
+	}
+
+	return c.Render(http.StatusOK, nil)
+}
+
+// recordSESNotification suppresses the addresses in a permanent bounce or complaint notification
+func recordSESNotification(n aws.SESNotification) error {
+	var lastErr error
+	switch {
+	case n.IsPermanentBounce():
+		for _, r := range n.Bounce.BouncedRecipients {
+			details := strings.TrimSpace(n.Bounce.BounceSubType + " " + r.DiagnosticCode)
+			if err := suppressEmail(r.EmailAddress, models.EmailSuppressionReasonBounce, emailEventSourceSES,
+				details); err != nil {
+				lastErr = err
+			}
+		}
+	case n.IsComplaint():
+		for _, r := range n.Complaint.ComplainedRecipients {
+			if err := suppressEmail(r.EmailAddress, models.EmailSuppressionReasonComplaint, emailEventSourceSES,
+				n.Complaint.ComplaintFeedbackType); err != nil {
+				lastErr = err
+			}
+		}
+	}
+	return lastErr
+}
+
+// sendGridEventsHandler receives the SendGrid event webhook. Only requests signed with the key in
+// SENDGRID_WEBHOOK_PUBLIC_KEY are accepted.
+func sendGridEventsHandler(c buffalo.Context) error {
+	body, err := readEmailEventBody(c)
+	if err != nil {
+		return err
+	}
+
+	req := c.Request()
+	if err := notifications.VerifySendGridSignature(domain.Env.SendGridWebhookPublicKey,
+		req.Header.Get(notifications.SendGridSignatureHeader), req.Header.Get(notifications.SendGridTimestampHeader),
+		body); err != nil {
+		return c.Error(http.StatusForbidden, fmt.Errorf("SendGrid event webhook not verified, %s", err))
+	}
+
+	var events []notifications.SendGridEvent
+	if err := json.Unmarshal(body, &events); err != nil {
+		return c.Error(http.StatusBadRequest, fmt.Errorf("error parsing SendGrid events, %s", err))
+	}
+
+	var lastErr error
+	for _, e := range events {
+		var reason models.EmailSuppressionReason
+		switch {
+		case e.IsPermanentBounce():
+			reason = models.EmailSuppressionReasonBounce
+		case e.IsComplaint():
+			reason = models.EmailSuppressionReasonComplaint
+		default:
+			continue
+		}
+
+		if err := suppressEmail(e.Email, reason, emailEventSourceSendGrid, e.Reason); err != nil {
+			lastErr = err
+		}
+	}
+	if lastErr != nil {
+		return c.Error(http.StatusInternalServerError, lastErr)
+	}
+
+	return c.Render(http.StatusOK, nil)
+}
+
+// suppressEmail stops email to an address that bounced or complained
+func suppressEmail(email string, reason models.EmailSuppressionReason, source, details string) error {
+	if email == "" {
+		return errors.New("email event has no email address")
+	}
+
+	s, err := models.SuppressEmail(email, reason, source, details)
+	if err != nil {
+		return fmt.Errorf("error suppressing email address after %s, %s", reason, err)
+	}
+
+	if s.UserID.Valid {
+		domain.Logger.Printf("email to user %d suppressed after %s reported by %s", s.UserID.Int, reason, source)
+	} else {
+		domain.Logger.Printf("email to an address with no user suppressed after %s reported by %s", reason, source)
+	}
+	return nil
+}
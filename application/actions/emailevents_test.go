@@ -0,0 +1,115 @@
+package actions
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/internal/test"
+	"github.com/silinternational/wecarry-api/models"
+	"github.com/silinternational/wecarry-api/notifications"
+)
+
+func (as *ActionSuite) Test_SendGridEventsHandler() {
+	users := test.CreateUserFixtures(as.DB, 3).Users
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	as.NoError(err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	as.NoError(err)
+
+	oldKey := domain.Env.SendGridWebhookPublicKey
+	domain.Env.SendGridWebhookPublicKey = base64.StdEncoding.EncodeToString(der)
+	defer func() { domain.Env.SendGridWebhookPublicKey = oldKey }()
+
+	sign := func(timestamp, body string) string {
+		digest := sha256.Sum256([]byte(timestamp + body))
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+		as.NoError(err)
+		sig, err := asn1.Marshal(struct{ R, S interface{} }{r, s})
+		as.NoError(err)
+		return base64.StdEncoding.EncodeToString(sig)
+	}
+
+	body := fmt.Sprintf(`[
+		{"email":"%s","event":"bounce","type":"bounce","reason":"550 5.1.1 unknown user"},
+		{"email":"%s","event":"spamreport"},
+		{"email":"%s","event":"bounce","type":"blocked"},
+		{"email":"%s","event":"delivered"}
+	]`, users[0].Email, users[1].Email, users[2].Email, users[2].Email)
+	timestamp := "1587556800"
+
+	tests := []struct {
+		name       string
+		signature  string
+		wantStatus int
+	}{
+		{name: "bad signature", signature: sign(timestamp, "[]"), wantStatus: http.StatusForbidden},
+		{name: "good signature", signature: sign(timestamp, body), wantStatus: http.StatusOK},
+	}
+	for _, tt := range tests {
+		as.T().Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/email-events/sendgrid", strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set(notifications.SendGridSignatureHeader, tt.signature)
+			req.Header.Set(notifications.SendGridTimestampHeader, timestamp)
+			rr := httptest.NewRecorder()
+			as.App.ServeHTTP(rr, req)
+
+			as.Equal(tt.wantStatus, rr.Code, "incorrect status code, %s", rr.Body.String())
+		})
+	}
+
+	want := []*models.EmailSuppressionReason{
+		reasonPtr(models.EmailSuppressionReasonBounce), reasonPtr(models.EmailSuppressionReasonComplaint), nil,
+	}
+	for i := range users {
+		got, err := users[i].GetEmailSuppression()
+		as.NoError(err)
+		if want[i] == nil {
+			as.Nil(got, "user %d should not be flagged", i)
+			continue
+		}
+		as.NotNil(got, "user %d should be flagged", i)
+		as.Equal(*want[i], got.Reason, "wrong reason for user %d", i)
+		as.Equal(users[i].ID, got.UserID.Int, "suppression not recorded against user %d", i)
+	}
+}
+
+func reasonPtr(r models.EmailSuppressionReason) *models.EmailSuppressionReason {
+	return &r
+}
+
+func (as *ActionSuite) Test_SESEventsHandler_Topic() {
+	oldTopic := domain.Env.SESNotificationTopicARN
+	domain.Env.SESNotificationTopicARN = "arn:aws:sns:us-east-1:123456789012:ses-events"
+	defer func() { domain.Env.SESNotificationTopicARN = oldTopic }()
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "other topic", body: `{"Type":"Notification","TopicArn":"arn:aws:sns:us-east-1:999999999999:other"}`},
+		{name: "unsigned", body: `{"Type":"Notification","TopicArn":"arn:aws:sns:us-east-1:123456789012:ses-events"}`},
+	}
+	for _, tt := range tests {
+		as.T().Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/email-events/ses", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "text/plain; charset=UTF-8")
+			rr := httptest.NewRecorder()
+			as.App.ServeHTTP(rr, req)
+
+			as.Equal(http.StatusForbidden, rr.Code, "incorrect status code")
+		})
+	}
+}
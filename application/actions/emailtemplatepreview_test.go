@@ -0,0 +1,70 @@
+package actions
+
+import (
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/internal/test"
+	"github.com/silinternational/wecarry-api/models"
+	"github.com/silinternational/wecarry-api/notifications"
+)
+
+type emailTemplatePreview struct {
+	Template string `json:"template"`
+	Subject  string `json:"subject"`
+	HTMLBody string `json:"htmlBody"`
+	TextBody string `json:"textBody"`
+}
+
+func (as *ActionSuite) Test_EmailTemplatePreviews() {
+	users := test.CreateUserFixtures(as.DB, 2).Users
+	users[0].AdminRole = models.UserAdminRoleSuperAdmin
+	as.NoError(as.DB.Save(&users[0]))
+
+	var resp struct {
+		Previews []emailTemplatePreview `json:"previews"`
+	}
+
+	query := `{ previews: emailTemplatePreviews(language: FR) { template subject htmlBody textBody } }`
+	as.NoError(as.testGqlQuery(query, users[0].Nickname, &resp))
+	as.Equal(len(domain.MessageTemplates), len(resp.Previews), "incorrect number of previews")
+	for i, p := range resp.Previews {
+		as.Equal(domain.MessageTemplates[i], p.Template, "incorrect template")
+	}
+
+	query = `{ previews: emailTemplatePreviews(template: "new_request", language: FR) {
+		template subject htmlBody textBody } }`
+	as.NoError(as.testGqlQuery(query, users[0].Nickname, &resp))
+	as.Equal(1, len(resp.Previews), "incorrect number of previews")
+	as.Contains(resp.Previews[0].HTMLBody, `lang="fr"`, "preview is not in French")
+	as.NotEmpty(resp.Previews[0].TextBody, "text body is missing")
+
+	query = `{ previews: emailTemplatePreviews(template: "bad_template") { template } }`
+	as.Error(as.testGqlQuery(query, users[0].Nickname, &resp), "unknown template did not return an error")
+
+	query = `{ previews: emailTemplatePreviews { template } }`
+	as.Error(as.testGqlQuery(query, users[1].Nickname, &resp), "non-admin was allowed to preview templates")
+}
+
+func (as *ActionSuite) Test_SendTestEmail() {
+	users := test.CreateUserFixtures(as.DB, 2).Users
+	users[0].AdminRole = models.UserAdminRoleSuperAdmin
+	as.NoError(as.DB.Save(&users[0]))
+
+	notifications.TestEmailService.DeleteSentMessages()
+
+	var resp struct {
+		Preview emailTemplatePreview `json:"preview"`
+	}
+
+	query := `mutation { preview: sendTestEmail(template: "new_user_welcome", language: ES) { template subject } }`
+	as.NoError(as.testGqlQuery(query, users[0].Nickname, &resp))
+	as.Equal("new_user_welcome", resp.Preview.Template, "incorrect template")
+	as.Equal(1, notifications.TestEmailService.GetNumberOfMessagesSent(), "incorrect number of emails sent")
+	as.Equal(users[0].Email, notifications.TestEmailService.GetLastToEmail(), "test email sent to wrong address")
+
+	query = `mutation { preview: sendTestEmail(template: "phone_code") { template } }`
+	as.Error(as.testGqlQuery(query, users[0].Nickname, &resp), "template without email did not return an error")
+
+	query = `mutation { preview: sendTestEmail(template: "new_request") { template } }`
+	as.Error(as.testGqlQuery(query, users[1].Nickname, &resp), "non-admin was allowed to send a test email")
+	as.Equal(1, notifications.TestEmailService.GetNumberOfMessagesSent(), "non-admin test email was sent")
+}
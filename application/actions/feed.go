@@ -0,0 +1,163 @@
+package actions
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gobuffalo/buffalo"
+	"github.com/gobuffalo/buffalo/render"
+
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/feeds"
+	"github.com/silinternational/wecarry-api/models"
+)
+
+// Route parameters of the feed URLs, /feeds/{token}/watches/{id}/{format} and /feeds/{token}/meetings/{id}/{format}
+const (
+	feedTokenParam  = "token"
+	feedIDParam     = "id"
+	feedFormatParam = "format"
+)
+
+// watchFeedHandler responds to GET requests at /feeds/{token}/watches/{id}/{format} with a feed of the open requests
+// that match one of the token owner's watches
+func watchFeedHandler(c buffalo.Context) error {
+	user, format, err := authenticateFeed(c)
+	if err != nil {
+		return err
+	}
+
+	var watch models.Watch
+	if err := watch.FindByUUID(c.Param(feedIDParam)); err != nil || watch.OwnerID != user.ID {
+		return c.Error(http.StatusNotFound, fmt.Errorf("watch feed not found, %v", err))
+	}
+
+	var requests models.Requests
+	if err := requests.FindByWatch(c, watch, 1, domain.MaxPageSize); err != nil {
+		return c.Error(http.StatusInternalServerError, fmt.Errorf("error finding watch feed requests, %s", err))
+	}
+
+	feed := feeds.Feed{
+		Title:       fmt.Sprintf("%s: %s", domain.Env.AppName, watch.Name),
+		Description: watch.Name,
+		Author:      domain.Env.AppName,
+		Link:        domain.Env.UIURL + domain.DefaultUIPath,
+		FeedURL:     domain.GetFeedURL(c.Param(feedTokenParam), "watches", watch.UUID.String(), string(format)),
+	}
+	return renderFeed(c, feed, requests, format)
+}
+
+// meetingFeedHandler responds to GET requests at /feeds/{token}/meetings/{id}/{format} with a feed of the requests for
+// a meeting. The meeting and the requests must be visible to the token owner.
+func meetingFeedHandler(c buffalo.Context) error {
+	user, format, err := authenticateFeed(c)
+	if err != nil {
+		return err
+	}
+
+	var meeting models.Meeting
+	if err := meeting.FindByUUID(c.Param(feedIDParam)); err != nil || !user.CanViewMeeting(c, meeting) {
+		return c.Error(http.StatusNotFound, fmt.Errorf("meeting feed not found, %v", err))
+	}
+
+	var requests models.Requests
+	if err := requests.FindByMeeting(c, user, meeting, 1, domain.MaxPageSize); err != nil {
+		return c.Error(http.StatusInternalServerError, fmt.Errorf("error finding meeting feed requests, %s", err))
+	}
+
+	feed := feeds.Feed{
+		Title:       fmt.Sprintf("%s: %s", domain.Env.AppName, meeting.Name),
+		Description: meeting.Description.String,
+		Author:      domain.Env.AppName,
+		Link:        domain.Env.UIURL + domain.DefaultUIPath,
+		FeedURL:     domain.GetFeedURL(c.Param(feedTokenParam), "meetings", meeting.UUID.String(), string(format)),
+	}
+	return renderFeed(c, feed, requests, format)
+}
+
+// authenticateFeed validates the feed format and the feed token of a feed request, and returns the token owner. Any
+// problem results in a 404, so that feed URLs cannot be probed for valid tokens.
+func authenticateFeed(c buffalo.Context) (models.User, feeds.Format, error) {
+	format := feeds.Format(c.Param(feedFormatParam))
+	if !format.IsValid() {
+		return models.User{}, format, c.Error(http.StatusNotFound, fmt.Errorf("invalid feed format '%s'", format))
+	}
+
+	user, err := authenticateFeedToken(c)
+	return user, format, err
+}
+
+// authenticateFeedToken validates the feed token of a feed or calendar request, and returns the token owner. An
+// invalid token results in a 404.
+func authenticateFeedToken(c buffalo.Context) (models.User, error) {
+	var token models.FeedToken
+	if err := token.FindByToken(c.Param(feedTokenParam)); err != nil {
+		if err == models.ErrInvalidFeedToken {
+			return models.User{}, c.Error(http.StatusNotFound, err)
+		}
+		return models.User{}, c.Error(http.StatusInternalServerError, err)
+	}
+
+	user, err := token.GetUser()
+	if err != nil {
+		return models.User{}, c.Error(http.StatusInternalServerError,
+			fmt.Errorf("error finding feed token owner, %s", err))
+	}
+
+	if err := token.UpdateLastUsedAt(time.Now()); err != nil {
+		domain.ErrLogger.Printf("error updating feed token %s last used time, %s", token.UUID, err)
+	}
+
+	return user, nil
+}
+
+// renderFeed adds the requests to the feed and renders it in the requested format
+func renderFeed(c buffalo.Context, feed feeds.Feed, requests models.Requests, format feeds.Format) error {
+	feed.Items = make([]feeds.Item, len(requests))
+	for i, request := range requests {
+		item, err := requestFeedItem(request)
+		if err != nil {
+			return c.Error(http.StatusInternalServerError, err)
+		}
+		feed.Items[i] = item
+		if request.UpdatedAt.After(feed.Updated) {
+			feed.Updated = request.UpdatedAt
+		}
+	}
+	if feed.Updated.IsZero() {
+		feed.Updated = time.Now()
+	}
+
+	return c.Render(http.StatusOK, r.Func(format.ContentType(), func(w io.Writer, _ render.Data) error {
+		return feeds.Write(w, feed, format)
+	}))
+}
+
+// requestFeedItem returns the feed item for a request. The item links to the request in the UI.
+func requestFeedItem(request models.Request) (feeds.Item, error) {
+	creator, err := request.GetCreator()
+	if err != nil {
+		return feeds.Item{}, fmt.Errorf("error getting request %s creator, %s", request.UUID, err)
+	}
+	destination, err := request.GetDestination()
+	if err != nil {
+		return feeds.Item{}, fmt.Errorf("error getting request %s destination, %s", request.UUID, err)
+	}
+
+	description := destination.Description
+	if request.Description.Valid && request.Description.String != "" {
+		description += "\n\n" + request.Description.String
+	}
+
+	return feeds.Item{
+		ID:          "urn:uuid:" + request.UUID.String(),
+		Title:       request.Title,
+		Link:        domain.GetRequestUIURL(request.UUID.String()),
+		Description: description,
+		Author:      creator.Nickname,
+		Published:   request.CreatedAt,
+		Updated:     request.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,145 @@
+package actions
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gobuffalo/nulls"
+
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/internal/test"
+	"github.com/silinternational/wecarry-api/models"
+)
+
+func (as *ActionSuite) Test_Feeds() {
+	f := createFixturesForWatches(as)
+	requests := test.CreateRequestFixtures(as.DB, 2, false)
+
+	requests[1].MeetingID = nulls.NewInt(f.Meetings[0].ID)
+	as.NoError(as.DB.Update(&requests[1]))
+
+	watch := models.Watch{UUID: domain.GetUUID(), OwnerID: f.Users[1].ID, Name: "all requests"}
+	createFixture(as, &watch)
+
+	token := models.FeedToken{UserID: f.Users[1].ID}
+	as.NoError(token.Create())
+
+	watchPath := "/feeds/" + token.Token + "/watches/" + watch.UUID.String() + "/"
+	meetingPath := "/feeds/" + token.Token + "/meetings/" + f.Meetings[0].UUID.String() + "/"
+
+	tests := []struct {
+		name            string
+		path            string
+		wantStatus      int
+		wantContentType string
+		wantContains    []string
+		wantNotContains []string
+	}{
+		{
+			name:            "watch rss",
+			path:            watchPath + "rss",
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/rss+xml",
+			wantContains:    []string{requests[0].Title, requests[1].Title, domain.GetRequestUIURL(requests[0].UUID.String())},
+		},
+		{
+			name:            "watch atom",
+			path:            watchPath + "atom",
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/atom+xml",
+			wantContains:    []string{"urn:uuid:" + requests[0].UUID.String()},
+		},
+		{
+			name:            "meeting json",
+			path:            meetingPath + "json",
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/feed+json",
+			wantContains:    []string{requests[1].Title},
+			wantNotContains: []string{requests[0].Title},
+		},
+		{
+			name:       "another user's watch",
+			path:       "/feeds/" + token.Token + "/watches/" + f.Watches[0].UUID.String() + "/rss",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "bad token",
+			path:       "/feeds/bad/watches/" + watch.UUID.String() + "/rss",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "bad format",
+			path:       watchPath + "ics",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		as.T().Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", tt.path, nil)
+			rr := httptest.NewRecorder()
+			as.App.ServeHTTP(rr, req)
+
+			as.Equal(tt.wantStatus, rr.Code, "incorrect status code")
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			as.Contains(rr.Header().Get("Content-Type"), tt.wantContentType)
+			for _, s := range tt.wantContains {
+				as.Contains(rr.Body.String(), s)
+			}
+			for _, s := range tt.wantNotContains {
+				as.NotContains(rr.Body.String(), s)
+			}
+		})
+	}
+
+	var found models.FeedToken
+	as.NoError(found.FindByUUID(token.UUID.String()))
+	as.True(found.LastUsedAt.Valid, "feed token last used time was not recorded")
+
+	// a revoked token no longer works
+	as.NoError(token.Destroy())
+	req := httptest.NewRequest("GET", watchPath+"rss", nil)
+	rr := httptest.NewRecorder()
+	as.App.ServeHTTP(rr, req)
+	as.Equal(http.StatusNotFound, rr.Code, "revoked token should not be accepted")
+}
+
+type feedTokensResponse struct {
+	Tokens []struct {
+		ID         string  `json:"id"`
+		Token      *string `json:"token"`
+		LastUsedAt *string `json:"lastUsedAt"`
+	} `json:"tokens"`
+}
+
+func (as *ActionSuite) Test_FeedTokens() {
+	users := test.CreateUserFixtures(as.DB, 2).Users
+
+	var created struct {
+		Token struct {
+			ID    string `json:"id"`
+			Token string `json:"token"`
+		} `json:"token"`
+	}
+	as.NoError(as.testGqlQuery(`mutation { token: createFeedToken { id token } }`, users[0].Nickname, &created))
+	as.NotEmpty(created.Token.Token, "token was not provided on creation")
+
+	var token models.FeedToken
+	as.NoError(token.FindByToken(created.Token.Token))
+	as.Equal(created.Token.ID, token.UUID.String(), "incorrect feed token ID")
+
+	var resp feedTokensResponse
+	as.NoError(as.testGqlQuery(`{ tokens: myFeedTokens { id token lastUsedAt } }`, users[0].Nickname, &resp))
+	as.Equal(1, len(resp.Tokens), "incorrect number of feed tokens")
+	as.Nil(resp.Tokens[0].Token, "token should only be provided on creation")
+
+	revoke := `mutation { tokens: revokeFeedToken(id: "` + created.Token.ID + `") { id } }`
+	as.Error(as.testGqlQuery(revoke, users[1].Nickname, &resp), "another user should not be able to revoke the token")
+
+	resp = feedTokensResponse{}
+	as.NoError(as.testGqlQuery(revoke, users[0].Nickname, &resp))
+	as.Equal(0, len(resp.Tokens), "feed token was not revoked")
+}
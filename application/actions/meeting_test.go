@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"strconv"
 	"testing"
+	"time"
 
+	"github.com/gobuffalo/nulls"
 	"github.com/gofrs/uuid"
 
 	"github.com/silinternational/wecarry-api/domain"
-	"github.com/silinternational/wecarry-api/gqlgen"
+	"github.com/silinternational/wecarry-api/internal/test"
 	"github.com/silinternational/wecarry-api/models"
 )
 
@@ -37,6 +39,7 @@ type meeting struct {
 	MoreInfoURL string `json:"moreInfoURL"`
 	StartDate   string `json:"startDate"`
 	EndDate     string `json:"endDate"`
+	Visibility  string `json:"visibility"`
 	CreatedBy   struct {
 		Nickname string `json:"nickname"`
 	} `json:"createdBy"`
@@ -53,7 +56,7 @@ type meeting struct {
 	Participants []meetingParticipant `json:"participants"`
 }
 
-const allMeetingFields = `id name description moreInfoURL startDate endDate createdBy {nickname} imageFile {id}
+const allMeetingFields = `id name description moreInfoURL startDate endDate visibility createdBy {nickname} imageFile {id}
 	location {country} requests {id} invites {meeting{id} email} participants {user{id} meeting{id}}`
 
 type meetingInvitesResponse struct {
@@ -67,11 +70,14 @@ type meetingInvite struct {
 	Inviter struct {
 		ID string `json:"id"`
 	} `json:"inviter"`
-	Email     string `json:"email"`
-	AvatarURL string `json:"avatarURL"`
+	Email     string                     `json:"email"`
+	AvatarURL string                     `json:"avatarURL"`
+	Status    models.MeetingInviteStatus `json:"status"`
+	SendCount int                        `json:"sendCount"`
+	SentAt    *string                    `json:"sentAt"`
 }
 
-const allMeetingInviteFields = "meeting {id} inviter {id} email avatarURL"
+const allMeetingInviteFields = "meeting {id} inviter {id} email avatarURL status sendCount sentAt"
 
 type meetingParticipantResponse struct {
 	MeetingParticipant meetingParticipant `json:"MeetingParticipant"`
@@ -188,36 +194,101 @@ func (as *ActionSuite) Test_MeetingsQuery() {
 	}
 }
 
-func (as *ActionSuite) Test_RecentMeetingsQuery() {
+func (as *ActionSuite) Test_MeetingsQueryFilters() {
 	f := createFixturesForMeetings(as)
 	meetings := f.Meetings
 
-	query := `{ meetings: recentMeetings
+	today := time.Now().Format(domain.DateFormat)
+	lastMonth := time.Now().Add(-domain.DurationDay * 30).Format(domain.DateFormat)
+
+	tests := []struct {
+		name   string
+		params string
+		want   []int
+	}{
+		{
+			name:   "recent",
+			params: `endAfter: "` + lastMonth + `", endBefore: "` + today + `"`,
+			want:   []int{1},
+		},
+		{
+			name:   "future",
+			params: `startAfter: "` + today + `"`,
+			want:   []int{3},
+		},
+		{
+			name:   "started",
+			params: `startBefore: "` + today + `"`,
+			want:   []int{0, 1, 2},
+		},
 		{
-		    id
-		    name
-		    startDate
-		    endDate
-		}}`
+			name:   "search text",
+			params: `searchText: "now"`,
+			want:   []int{2},
+		},
+		{
+			name:   "second page",
+			params: `page: 2, perPage: 1`,
+			want:   []int{3},
+		},
+	}
+	for _, tt := range tests {
+		as.T().Run(tt.name, func(t *testing.T) {
+			query := `{ meetings: meetings(` + tt.params + `) { id } }`
 
-	var resp meetingsResponse
+			var resp meetingsResponse
+			as.NoError(as.testGqlQuery(query, f.Users[0].Nickname, &resp))
 
-	user := f.Users[0]
+			got := make([]string, len(resp.Meetings))
+			for i, m := range resp.Meetings {
+				got[i] = m.ID
+			}
+			want := make([]string, len(tt.want))
+			for i, w := range tt.want {
+				want[i] = meetings[w].UUID.String()
+			}
+			as.Equal(want, got, "incorrect meetings")
+		})
+	}
 
-	err := as.testGqlQuery(query, user.Nickname, &resp)
-	as.NoError(err, "unexpected error getting recent meetings")
+	var resp meetingsResponse
+	as.Error(as.testGqlQuery(`{ meetings(endAfter: "tomorrow") { id } }`, f.Users[0].Nickname, &resp),
+		"expected an error for an invalid date")
 
+	// the deprecated recentMeetings query is the same as the "recent" filters
+	resp = meetingsResponse{}
+	as.NoError(as.testGqlQuery(`{ meetings: recentMeetings { id } }`, f.Users[0].Nickname, &resp))
 	as.Equal(1, len(resp.Meetings), "incorrect number of recent meetings")
+	if len(resp.Meetings) == 1 {
+		as.Equal(meetings[1].UUID.String(), resp.Meetings[0].ID, "incorrect recent meeting")
+	}
+}
 
-	wantMtg := meetings[1]
-	gotMtg := resp.Meetings[0]
+func (as *ActionSuite) Test_MeetingVisibility() {
+	f := createFixturesForMeetings(as)
 
-	as.Equal(wantMtg.UUID.String(), gotMtg.ID, "incorrect meeting UUID")
-	as.Equal(wantMtg.Name, gotMtg.Name, "incorrect meeting Name")
-	as.Equal(wantMtg.StartDate.Format(domain.DateFormat), gotMtg.StartDate,
-		"incorrect meeting StartDate")
-	as.Equal(wantMtg.EndDate.Format(domain.DateFormat), gotMtg.EndDate,
-		"incorrect meeting EndDate")
+	hidden := f.Meetings[3]
+	hidden.Visibility = models.MeetingVisibilityInviteOnly
+	as.NoError(as.DB.Update(&hidden))
+
+	var resp meetingResponse
+	query := `{ meeting(id: "` + hidden.UUID.String() + `") { id } }`
+	as.Error(as.testGqlQuery(query, f.Users[2].Nickname, &resp), "expected an error for a hidden meeting")
+
+	// users[1] is invited
+	as.NoError(as.testGqlQuery(query, f.Users[1].Nickname, &resp), "invitee should see the meeting")
+	as.Equal(hidden.UUID.String(), resp.Meeting.ID, "incorrect meeting")
+
+	var listResp meetingsResponse
+	as.NoError(as.testGqlQuery(`{ meetings { id } }`, f.Users[2].Nickname, &listResp))
+	as.Equal(1, len(listResp.Meetings), "incorrect number of visible meetings")
+	as.Equal(f.Meetings[2].UUID.String(), listResp.Meetings[0].ID, "incorrect visible meeting")
+
+	// an INVITE_ONLY meeting cannot be joined without a code, even by an invitee
+	join := `mutation { meetingParticipant: createMeetingParticipant(input: {meetingID: "` + hidden.UUID.String() +
+		`"}) { meeting { id } } }`
+	var joinResp meetingParticipantResponse
+	as.Error(as.testGqlQuery(join, f.Users[1].Nickname, &joinResp), "expected an error joining without a code")
 }
 
 func (as *ActionSuite) Test_CreateMeeting() {
@@ -232,7 +303,7 @@ func (as *ActionSuite) Test_CreateMeeting() {
 			startDate: "2025-03-01"
 			endDate: "2025-03-21"
 			moreInfoURL: "example.com"
-			visibility: ` + gqlgen.MeetingVisibilityInviteOnly.String() + `
+			visibility: ` + models.MeetingVisibilityInviteOnly.String() + `
 		`
 	query := `mutation { meeting: createMeeting(input: {` + input + `}) {` + allMeetingFields + "}}"
 
@@ -250,6 +321,7 @@ func (as *ActionSuite) Test_CreateMeeting() {
 		"incorrect meeting StartDate")
 	as.Equal("2025-03-21", gotMtg.EndDate,
 		"incorrect meeting EndDate")
+	as.Equal(models.MeetingVisibilityInviteOnly.String(), gotMtg.Visibility, "incorrect meeting Visibility")
 
 	as.Equal(f.File.UUID.String(), gotMtg.ImageFile.ID, "incorrect FileID")
 
@@ -269,7 +341,7 @@ func (as *ActionSuite) Test_UpdateMeeting() {
 			startDate: "2025-09-19"
 			endDate: "2025-09-29"
 			moreInfoURL: "new.example.com"
-			visibility: ` + gqlgen.MeetingVisibilityInviteOnly.String() + `
+			visibility: ` + models.MeetingVisibilityInviteOnly.String() + `
 		`
 	query := `mutation { meeting: updateMeeting(input: {` + input + `}) {` + allMeetingFields + "}}"
 
@@ -284,6 +356,7 @@ func (as *ActionSuite) Test_UpdateMeeting() {
 	as.Equal(f.Users[0].Nickname, gotMtg.CreatedBy.Nickname, "incorrect meeting CreatedBy")
 	as.Equal("2025-09-19", gotMtg.StartDate, "incorrect meeting StartDate")
 	as.Equal("2025-09-29", gotMtg.EndDate, "incorrect meeting EndDate")
+	as.Equal(models.MeetingVisibilityInviteOnly.String(), gotMtg.Visibility, "incorrect meeting Visibility")
 
 	as.Equal(f.File.UUID.String(), gotMtg.ImageFile.ID)
 	as.Equal("dc", gotMtg.Location.Country, "incorrect meeting Location.Country")
@@ -373,6 +446,40 @@ func (as *ActionSuite) Test_CreateMeetingInvites() {
 	}
 }
 
+func (as *ActionSuite) Test_ResendMeetingInvite() {
+	f := createFixturesForMeetings(as)
+
+	var resp struct {
+		MeetingInvite meetingInvite `json:"meetingInvite"`
+	}
+	query := fmt.Sprintf(`mutation { meetingInvite: resendMeetingInvite(input: { meetingID: "%s" email: "%s" }) { %s } }`,
+		f.Meetings[2].UUID.String(), f.MeetingInvites[1].Email, allMeetingInviteFields)
+
+	err := as.testGqlQuery(query, f.Users[1].Nickname, &resp)
+	as.Error(err, "expected an error for an unauthorized user")
+	as.Contains(err.Error(), "not allowed", "didn't get expected error message")
+
+	as.NoError(as.testGqlQuery(query, f.Users[0].Nickname, &resp))
+	as.Equal(f.MeetingInvites[1].Email, resp.MeetingInvite.Email, "incorrect invite")
+	as.Equal(1, resp.MeetingInvite.SendCount, "incorrect send count")
+	as.NotNil(resp.MeetingInvite.SentAt, "sent time was not recorded")
+
+	err = as.testGqlQuery(query, f.Users[0].Nickname, &resp)
+	as.Error(err, "expected an error for an invite sent too recently")
+	as.Contains(err.Error(), "too recently", "didn't get expected error message")
+
+	// new invites are sent unless sendEmail is false
+	var invitesResp meetingInvitesResponse
+	create := fmt.Sprintf(`mutation { meetingInvites: createMeetingInvites(input: { meetingID: "%s" emails: ["new@example.com"] }) { %s } }`,
+		f.Meetings[2].UUID.String(), allMeetingInviteFields)
+	as.NoError(as.testGqlQuery(create, f.Users[0].Nickname, &invitesResp))
+	for _, invite := range invitesResp.MeetingInvites {
+		if invite.Email == "new@example.com" {
+			as.Equal(1, invite.SendCount, "new invite was not sent")
+		}
+	}
+}
+
 func (as *ActionSuite) Test_RemoveMeetingInvite() {
 	f := createFixturesForMeetings(as)
 
@@ -516,6 +623,248 @@ func (as *ActionSuite) Test_RemoveMeetingParticipant() {
 	}
 }
 
+func (as *ActionSuite) Test_MeetingOrganizers() {
+	f := createFixturesForMeetings(as)
+	meetingID := f.Meetings[2].UUID.String()
+
+	type organizersResponse struct {
+		Organizers []struct {
+			ID string `json:"id"`
+		} `json:"organizers"`
+	}
+
+	testCases := []struct {
+		name     string
+		mutation string
+		userID   string
+		testUser models.User
+		wantIDs  []string
+		wantErr  string
+	}{
+		{
+			name:     "participant, not organizer",
+			mutation: "addMeetingOrganizer",
+			userID:   f.Users[2].UUID.String(),
+			testUser: f.Users[2],
+			wantErr:  "not allowed",
+		},
+		{
+			name:     "organizer adds organizer",
+			mutation: "addMeetingOrganizer",
+			userID:   f.Users[2].UUID.String(),
+			testUser: f.Users[1],
+			wantIDs:  []string{f.Users[1].UUID.String(), f.Users[2].UUID.String()},
+		},
+		{
+			name:     "creator removes organizer",
+			mutation: "removeMeetingOrganizer",
+			userID:   f.Users[1].UUID.String(),
+			testUser: f.Users[0],
+			wantIDs:  []string{f.Users[2].UUID.String()},
+		},
+		{
+			name:     "last organizer",
+			mutation: "removeMeetingOrganizer",
+			userID:   f.Users[2].UUID.String(),
+			testUser: f.Users[2],
+			wantErr:  "last organizer",
+		},
+	}
+
+	for _, tc := range testCases {
+		as.T().Run(tc.name, func(t *testing.T) {
+			query := fmt.Sprintf(`mutation { organizers: %s(input: { meetingID: "%s" userID: "%s" }) { id } }`,
+				tc.mutation, meetingID, tc.userID)
+
+			var resp organizersResponse
+			err := as.testGqlQuery(query, tc.testUser.Nickname, &resp)
+
+			if tc.wantErr != "" {
+				as.Error(err)
+				as.Contains(err.Error(), tc.wantErr, "didn't get expected error message")
+				return
+			}
+			as.NoError(err)
+
+			ids := make([]string, len(resp.Organizers))
+			for i := range resp.Organizers {
+				ids[i] = resp.Organizers[i].ID
+			}
+			as.ElementsMatch(tc.wantIDs, ids, "incorrect organizers")
+		})
+	}
+}
+
+func (as *ActionSuite) Test_MeetingAnnouncements() {
+	f := createFixturesForMeetings(as)
+	meetingID := f.Meetings[2].UUID.String()
+
+	type announcement struct {
+		ID      string `json:"id"`
+		Content string `json:"content"`
+		Author  struct {
+			ID string `json:"id"`
+		} `json:"author"`
+		Meeting struct {
+			ID string `json:"id"`
+		} `json:"meeting"`
+	}
+
+	testCases := []struct {
+		name     string
+		testUser models.User
+		content  string
+		wantErr  string
+	}{
+		{
+			name:     "participant, not organizer",
+			testUser: f.Users[2],
+			content:  "not allowed",
+			wantErr:  "not allowed",
+		},
+		{
+			name:     "organizer",
+			testUser: f.Users[1],
+			content:  "The carry exchange desk is in room B at 3pm",
+		},
+		{
+			name:     "creator",
+			testUser: f.Users[0],
+			content:  "The exchange desk moved to room C",
+		},
+	}
+
+	for _, tc := range testCases {
+		as.T().Run(tc.name, func(t *testing.T) {
+			query := fmt.Sprintf(`mutation { announcement: createMeetingAnnouncement(input: { meetingID: "%s" content: "%s" })
+				{ id content author { id } meeting { id } } }`, meetingID, tc.content)
+
+			var resp struct {
+				Announcement announcement `json:"announcement"`
+			}
+			err := as.testGqlQuery(query, tc.testUser.Nickname, &resp)
+
+			if tc.wantErr != "" {
+				as.Error(err)
+				as.Contains(err.Error(), tc.wantErr, "didn't get expected error message")
+				return
+			}
+			as.NoError(err)
+			as.Equal(tc.content, resp.Announcement.Content)
+			as.Equal(tc.testUser.UUID.String(), resp.Announcement.Author.ID)
+			as.Equal(meetingID, resp.Announcement.Meeting.ID)
+		})
+	}
+
+	var resp struct {
+		Meeting struct {
+			Announcements []announcement `json:"announcements"`
+		} `json:"meeting"`
+	}
+	query := `{ meeting(id: "` + meetingID + `") { announcements { id content } } }`
+	as.NoError(as.testGqlQuery(query, f.Users[2].Nickname, &resp))
+	as.Equal(2, len(resp.Meeting.Announcements), "incorrect number of announcements")
+	as.Equal(testCases[2].content, resp.Meeting.Announcements[0].Content, "announcements are not newest first")
+
+	nonParticipant := test.CreateUserFixtures(as.DB, 1).Users[0]
+	as.NoError(as.testGqlQuery(query, nonParticipant.Nickname, &resp))
+	as.Equal(0, len(resp.Meeting.Announcements), "a non-participant should not see the announcements")
+}
+
+func (as *ActionSuite) Test_MeetingExchange() {
+	f := createFixturesForMeetings(as)
+	meetingID := f.Meetings[2].UUID.String()
+	provider := f.Users[2]
+
+	for i := range f.Requests[:2] {
+		f.Requests[i].Status = models.RequestStatusAccepted
+		f.Requests[i].ProviderID = nulls.NewInt(provider.ID)
+		as.NoError(f.Requests[i].Update())
+	}
+
+	var exchangeResp struct {
+		Handoffs []struct {
+			Provider struct {
+				ID string `json:"id"`
+			} `json:"provider"`
+			Receiver struct {
+				ID string `json:"id"`
+			} `json:"receiver"`
+			Requests []struct {
+				ID string `json:"id"`
+			} `json:"requests"`
+		} `json:"handoffs"`
+	}
+	exchangeQuery := `{ handoffs: meetingExchange(meetingID: "` + meetingID + `")
+		{ provider { id } receiver { id } requests { id } } }`
+
+	err := as.testGqlQuery(exchangeQuery, provider.Nickname, &exchangeResp)
+	as.Error(err, "expected an error for a participant who is not an organizer")
+	as.Contains(err.Error(), "not allowed", "didn't get expected error message")
+
+	as.NoError(as.testGqlQuery(exchangeQuery, f.Users[1].Nickname, &exchangeResp))
+	as.Equal(1, len(exchangeResp.Handoffs), "incorrect number of handoff groups")
+	as.Equal(provider.UUID.String(), exchangeResp.Handoffs[0].Provider.ID, "incorrect provider")
+	as.Equal(f.Users[0].UUID.String(), exchangeResp.Handoffs[0].Receiver.ID, "incorrect receiver")
+	as.Equal(2, len(exchangeResp.Handoffs[0].Requests), "incorrect number of requests")
+
+	var codeResp struct {
+		Meeting struct {
+			Requests []struct {
+				ID          string  `json:"id"`
+				HandoffCode *string `json:"handoffCode"`
+			} `json:"requests"`
+		} `json:"meeting"`
+	}
+	getCode := func(user models.User) *string {
+		codeQuery := `{ meeting(id: "` + meetingID + `") { requests { id handoffCode } } }`
+		as.NoError(as.testGqlQuery(codeQuery, user.Nickname, &codeResp))
+		for _, r := range codeResp.Meeting.Requests {
+			if r.ID == f.Requests[0].UUID.String() {
+				return r.HandoffCode
+			}
+		}
+		as.Fail("request not found in meeting requests")
+		return nil
+	}
+	as.Nil(getCode(f.Users[1]), "handoff code should be hidden from other users")
+	receiverCode := getCode(f.Users[0])
+	as.NotNil(receiverCode, "handoff code should be visible to the receiver")
+	providerCode := getCode(provider)
+	as.NotNil(providerCode, "handoff code should be visible to the provider")
+	as.Equal(*receiverCode, *providerCode, "receiver and provider should see the same handoff code")
+	code := *receiverCode
+
+	var completeResp struct {
+		Results []struct {
+			Code    string  `json:"code"`
+			Error   *string `json:"error"`
+			Request *struct {
+				ID     string `json:"id"`
+				Status string `json:"status"`
+			} `json:"request"`
+		} `json:"results"`
+	}
+	completeQuery := fmt.Sprintf(`mutation { results: completeMeetingHandoffs(input: { meetingID: "%s"
+		codes: ["%s", "NOTACODE"] }) { code error request { id status } } }`, meetingID, code)
+
+	err = as.testGqlQuery(completeQuery, provider.Nickname, &completeResp)
+	as.Error(err, "expected an error for a participant who is not an organizer")
+
+	as.NoError(as.testGqlQuery(completeQuery, f.Users[1].Nickname, &completeResp))
+	as.Equal(2, len(completeResp.Results), "incorrect number of results")
+	as.Nil(completeResp.Results[0].Error, "unexpected error for a valid code")
+	as.NotNil(completeResp.Results[0].Request, "completed request was not returned")
+	as.Equal(f.Requests[0].UUID.String(), completeResp.Results[0].Request.ID, "incorrect request completed")
+	as.Equal(models.RequestStatusCompleted.String(), completeResp.Results[0].Request.Status, "request not completed")
+	as.Equal("NOTACODE", completeResp.Results[1].Code)
+	as.Nil(completeResp.Results[1].Request, "no request should be returned for an invalid code")
+	as.NotNil(completeResp.Results[1].Error, "expected an error for an invalid code")
+
+	as.NoError(as.testGqlQuery(exchangeQuery, f.Users[1].Nickname, &exchangeResp))
+	as.Equal(1, len(exchangeResp.Handoffs[0].Requests), "completed request should no longer be listed")
+}
+
 func (as *ActionSuite) Test_CreateMeetingParticipant() {
 	f := createFixturesForMeetings(as)
 
@@ -0,0 +1,89 @@
+package actions
+
+import (
+	"time"
+
+	"github.com/gobuffalo/nulls"
+
+	"github.com/silinternational/wecarry-api/internal/test"
+	"github.com/silinternational/wecarry-api/models"
+)
+
+type notificationsResponse struct {
+	Notifications []struct {
+		ID      string `json:"id"`
+		Type    string `json:"type"`
+		Title   string `json:"title"`
+		Request *struct {
+			ID string `json:"id"`
+		} `json:"request"`
+		ReadAt *time.Time `json:"readAt"`
+	} `json:"notifications"`
+	UnreadCount int `json:"unreadCount"`
+}
+
+type markNotificationsReadResponse struct {
+	UnreadCount int `json:"unreadCount"`
+}
+
+func createFixturesForNotifications(as *ActionSuite) (models.Users, models.Requests, models.Notifications) {
+	users := test.CreateUserFixtures(as.DB, 2).Users
+	requests := test.CreateRequestFixtures(as.DB, 1, false)
+
+	notifications := models.Notifications{
+		{UserID: users[0].ID, Type: "new_request", Title: "zero", RequestID: nulls.NewInt(requests[0].ID)},
+		{UserID: users[0].ID, Type: "new_request", Title: "one", ReadAt: nulls.NewTime(time.Now())},
+		{UserID: users[0].ID, Type: "new_thread_message", Title: "two"},
+		{UserID: users[1].ID, Type: "new_request", Title: "three"},
+	}
+	for i := range notifications {
+		as.NoError(notifications[i].Create())
+	}
+
+	return users, requests, notifications
+}
+
+func (as *ActionSuite) Test_MyNotifications() {
+	users, requests, notifications := createFixturesForNotifications(as)
+
+	query := `{ notifications: myNotifications(page: 1, perPage: 2) { id type title request { id } readAt }
+		unreadCount: unreadNotificationCount }`
+
+	var resp notificationsResponse
+	as.NoError(as.testGqlQuery(query, users[0].Nickname, &resp))
+
+	as.Equal(2, len(resp.Notifications), "incorrect number of notifications")
+	as.Equal(notifications[2].UUID.String(), resp.Notifications[0].ID, "incorrect notification ID")
+	as.Equal("two", resp.Notifications[0].Title, "incorrect notification title")
+	as.Nil(resp.Notifications[0].Request, "notification should not have a request")
+	as.NotNil(resp.Notifications[1].ReadAt, "notification should have been read")
+	as.Equal(2, resp.UnreadCount, "incorrect unread notification count")
+
+	query = `{ notifications: myNotifications(page: 2, perPage: 2) { id type title request { id } readAt } }`
+	resp = notificationsResponse{}
+	as.NoError(as.testGqlQuery(query, users[0].Nickname, &resp))
+
+	as.Equal(1, len(resp.Notifications), "incorrect number of notifications on second page")
+	as.NotNil(resp.Notifications[0].Request, "notification request is missing")
+	as.Equal(requests[0].UUID.String(), resp.Notifications[0].Request.ID, "incorrect notification request")
+}
+
+func (as *ActionSuite) Test_MarkNotificationsRead() {
+	users, _, notifications := createFixturesForNotifications(as)
+
+	query := `mutation { unreadCount: markNotificationsRead(input: {ids: ["` + notifications[2].UUID.String() + `"]}) }`
+
+	var resp markNotificationsReadResponse
+	as.NoError(as.testGqlQuery(query, users[0].Nickname, &resp))
+	as.Equal(1, resp.UnreadCount, "incorrect unread notification count")
+
+	query = `mutation { unreadCount: markNotificationsRead(input: {}) }`
+	resp = markNotificationsReadResponse{}
+	as.NoError(as.testGqlQuery(query, users[0].Nickname, &resp))
+	as.Equal(0, resp.UnreadCount, "incorrect unread notification count")
+
+	var n models.Notifications
+	count, err := n.UnreadCount(users[1])
+	as.NoError(err)
+	as.Equal(1, count, "another user's notification was marked as read")
+}
@@ -0,0 +1,90 @@
+package actions
+
+import (
+	"fmt"
+
+	"github.com/silinternational/wecarry-api/models"
+)
+
+type OrganizationWebhook struct {
+	ID         string   `json:"id"`
+	URL        string   `json:"url"`
+	Events     []string `json:"events"`
+	Secret     string   `json:"secret"`
+	Active     bool     `json:"active"`
+	Deliveries []struct {
+		ID string `json:"id"`
+	} `json:"deliveries"`
+}
+
+type OrganizationWebhookResponse struct {
+	Webhook OrganizationWebhook `json:"webhook"`
+}
+
+type OrganizationWebhooksResponse struct {
+	Webhooks []OrganizationWebhook `json:"webhooks"`
+}
+
+const allOrganizationWebhookFields = `id url events secret active deliveries { id }`
+
+func (as *ActionSuite) Test_CreateUpdateRemoveOrganizationWebhook() {
+	f := fixturesForOrganizationDomain(as)
+	user, admin := f.Users[0], f.Users[1]
+	orgID := f.Organizations[0].UUID.String()
+
+	createQuery := func(url string) string {
+		return fmt.Sprintf(`mutation{webhook: createOrganizationWebhook(input: {organizationID:"%s" url:"%s"
+			events:[REQUEST_CREATED, MEETING_CREATED, REQUEST_CREATED]}) {%s}}`,
+			orgID, url, allOrganizationWebhookFields)
+	}
+
+	var resp OrganizationWebhookResponse
+	as.Error(as.testGqlQuery(createQuery("https://example.com/hook"), user.Nickname, &resp),
+		"non-admin should not be allowed to create a webhook")
+
+	as.Error(as.testGqlQuery(createQuery("http://example.com/hook"), admin.Nickname, &resp),
+		"http webhook URL should not be allowed")
+
+	as.NoError(as.testGqlQuery(createQuery("https://example.com/hook"), admin.Nickname, &resp))
+	created := resp.Webhook
+	as.Equal("https://example.com/hook", created.URL, "wrong URL")
+	as.Equal([]string{"REQUEST_CREATED", "MEETING_CREATED"}, created.Events, "wrong events")
+	as.NotEqual("", created.Secret, "secret was not generated")
+	as.True(created.Active, "webhook should be active")
+	as.Equal(0, len(created.Deliveries), "new webhook should have no deliveries")
+
+	updateQuery := fmt.Sprintf(`mutation{webhook: updateOrganizationWebhook(input: {id:"%s" active:false
+		events:[USER_JOINED_ORGANIZATION]}) {%s}}`, created.ID, allOrganizationWebhookFields)
+	as.Error(as.testGqlQuery(updateQuery, user.Nickname, &resp),
+		"non-admin should not be allowed to update a webhook")
+
+	as.NoError(as.testGqlQuery(updateQuery, admin.Nickname, &resp))
+	as.Equal("https://example.com/hook", resp.Webhook.URL, "URL should not be changed")
+	as.Equal([]string{"USER_JOINED_ORGANIZATION"}, resp.Webhook.Events, "events were not updated")
+	as.False(resp.Webhook.Active, "webhook was not deactivated")
+	as.Equal(created.Secret, resp.Webhook.Secret, "secret should not be changed")
+
+	listQuery := fmt.Sprintf(`{org: organization(id:"%s") {webhooks {%s}}}`, orgID, allOrganizationWebhookFields)
+	var listResp struct {
+		Org OrganizationWebhooksResponse `json:"org"`
+	}
+	as.NoError(as.testGqlQuery(listQuery, admin.Nickname, &listResp))
+	as.Equal(1, len(listResp.Org.Webhooks), "wrong number of webhooks")
+	as.Equal(created.ID, listResp.Org.Webhooks[0].ID)
+
+	as.Error(as.testGqlQuery(listQuery, user.Nickname, &listResp),
+		"non-admin should not be allowed to view webhooks")
+
+	removeQuery := fmt.Sprintf(`mutation{webhooks: removeOrganizationWebhook(input: {id:"%s"}) {%s}}`,
+		created.ID, allOrganizationWebhookFields)
+	var removeResp OrganizationWebhooksResponse
+	as.Error(as.testGqlQuery(removeQuery, user.Nickname, &removeResp),
+		"non-admin should not be allowed to remove a webhook")
+
+	as.NoError(as.testGqlQuery(removeQuery, admin.Nickname, &removeResp))
+	as.Equal(0, len(removeResp.Webhooks), "webhook was not removed")
+
+	var webhooks models.OrganizationWebhooks
+	as.NoError(as.DB.All(&webhooks))
+	as.Equal(0, len(webhooks), "webhook is still in the database")
+}
@@ -0,0 +1,81 @@
+package actions
+
+import (
+	"github.com/silinternational/wecarry-api/internal/test"
+	"github.com/silinternational/wecarry-api/models"
+)
+
+type pushSubscriptionResponse struct {
+	Subscription struct {
+		ID       string `json:"id"`
+		Endpoint string `json:"endpoint"`
+	} `json:"subscription"`
+}
+
+type pushSubscriptionsResponse struct {
+	Subscriptions []struct {
+		ID       string `json:"id"`
+		Endpoint string `json:"endpoint"`
+	} `json:"subscriptions"`
+}
+
+func (as *ActionSuite) Test_CreatePushSubscription() {
+	users := test.CreateUserFixtures(as.DB, 2).Users
+	endpoint := "https://fcm.googleapis.com/fcm/send/abc123"
+
+	query := `mutation { subscription: createPushSubscription(input: {endpoint: "` + endpoint +
+		`", p256dh: "BNcRdreALRFXTkOOUHK1EtK2wtaz5Ry4YfYCA_0QTpQtUbVlUls0VJXg7A8u-Ts1XbjhazAkj7I99e8QcYP7DkM", auth: "tBHItJI5svbpez7KI4CCXg"})
+		{ id endpoint } }`
+
+	var resp pushSubscriptionResponse
+	as.NoError(as.testGqlQuery(query, users[0].Nickname, &resp))
+	as.Equal(endpoint, resp.Subscription.Endpoint, "incorrect endpoint")
+
+	// the same browser registered again by the same user keeps its subscription
+	again := pushSubscriptionResponse{}
+	as.NoError(as.testGqlQuery(query, users[0].Nickname, &again))
+	as.Equal(resp.Subscription.ID, again.Subscription.ID, "a new subscription was created")
+
+	// another user of the same browser takes over the subscription
+	other := pushSubscriptionResponse{}
+	as.NoError(as.testGqlQuery(query, users[1].Nickname, &other))
+	as.Equal(resp.Subscription.ID, other.Subscription.ID, "a new subscription was created for another user")
+
+	var subscriptions models.PushSubscriptions
+	as.NoError(subscriptions.FindByUser(users[0]))
+	as.Equal(0, len(subscriptions), "subscription was not taken from the original user")
+	as.NoError(subscriptions.FindByUser(users[1]))
+	as.Equal(1, len(subscriptions), "subscription was not reassigned")
+
+	unknownService := `mutation { subscription: createPushSubscription(input: {endpoint: "https://push.example.com/send/abc123",
+		p256dh: "key", auth: "auth"}) { id endpoint } }`
+	err := as.testGqlQuery(unknownService, users[0].Nickname, &pushSubscriptionResponse{})
+	as.Error(err, "endpoint of an unknown push service was accepted")
+}
+
+func (as *ActionSuite) Test_RemovePushSubscription() {
+	users := test.CreateUserFixtures(as.DB, 2).Users
+
+	subscriptions := models.PushSubscriptions{
+		{UserID: users[0].ID, Endpoint: "https://fcm.googleapis.com/fcm/send/0", P256dh: "key0", Auth: "auth0"},
+		{UserID: users[0].ID, Endpoint: "https://fcm.googleapis.com/fcm/send/1", P256dh: "key1", Auth: "auth1"},
+		{UserID: users[1].ID, Endpoint: "https://fcm.googleapis.com/fcm/send/2", P256dh: "key2", Auth: "auth2"},
+	}
+	for i := range subscriptions {
+		test.MustCreate(as.DB, &subscriptions[i])
+	}
+
+	query := `mutation { subscriptions: removePushSubscription(input: {endpoint: "https://fcm.googleapis.com/fcm/send/0"})
+		{ id endpoint } }`
+
+	var resp pushSubscriptionsResponse
+	as.NoError(as.testGqlQuery(query, users[0].Nickname, &resp))
+	as.Equal(1, len(resp.Subscriptions), "incorrect number of remaining subscriptions")
+	as.Equal(subscriptions[1].UUID.String(), resp.Subscriptions[0].ID, "wrong subscription removed")
+
+	query = `mutation { subscriptions: removePushSubscription(input: {endpoint: "https://fcm.googleapis.com/fcm/send/2"})
+		{ id endpoint } }`
+	resp = pushSubscriptionsResponse{}
+	err := as.testGqlQuery(query, users[0].Nickname, &resp)
+	as.Error(err, "removed another user's subscription")
+}
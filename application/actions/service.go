@@ -35,6 +35,19 @@ const (
 
 	// ServiceTaskTokenCleanup removes expired user access tokens
 	ServiceTaskTokenCleanup ServiceTaskName = "token_cleanup"
+
+	// ServiceTaskDigest sends the daily and weekly digests that are due. It should be scheduled to run every hour.
+	ServiceTaskDigest ServiceTaskName = "digest"
+
+	// ServiceTaskOutbox retries delivery of queued emails that failed. It should be scheduled to run every few minutes.
+	ServiceTaskOutbox ServiceTaskName = "outbox"
+
+	// ServiceTaskWebhooks retries delivery of queued webhook payloads. It should be scheduled to run every few minutes.
+	ServiceTaskWebhooks ServiceTaskName = "webhooks"
+
+	// ServiceTaskWatchExpiration deactivates expired watches and notifies their owners. It should be scheduled to run
+	// daily.
+	ServiceTaskWatchExpiration ServiceTaskName = "watch_expiration"
 )
 
 var serviceTasks = map[ServiceTaskName]ServiceTask{
@@ -44,6 +57,18 @@ var serviceTasks = map[ServiceTaskName]ServiceTask{
 	ServiceTaskTokenCleanup: {
 		Handler: tokenCleanupHandler,
 	},
+	ServiceTaskDigest: {
+		Handler: digestHandler,
+	},
+	ServiceTaskOutbox: {
+		Handler: outboxHandler,
+	},
+	ServiceTaskWebhooks: {
+		Handler: webhooksHandler,
+	},
+	ServiceTaskWatchExpiration: {
+		Handler: watchExpirationHandler,
+	},
 }
 
 func serviceHandler(c buffalo.Context) error {
@@ -90,3 +115,31 @@ func tokenCleanupHandler(c buffalo.Context) error {
 	}
 	return nil
 }
+
+func digestHandler(c buffalo.Context) error {
+	if err := job.Submit(job.Digest, nil); err != nil {
+		return c.Error(http.StatusInternalServerError, fmt.Errorf("digest job not started, %s", err))
+	}
+	return nil
+}
+
+func outboxHandler(c buffalo.Context) error {
+	if err := job.Submit(job.Outbox, nil); err != nil {
+		return c.Error(http.StatusInternalServerError, fmt.Errorf("outbox job not started, %s", err))
+	}
+	return nil
+}
+
+func webhooksHandler(c buffalo.Context) error {
+	if err := job.Submit(job.Webhooks, nil); err != nil {
+		return c.Error(http.StatusInternalServerError, fmt.Errorf("webhooks job not started, %s", err))
+	}
+	return nil
+}
+
+func watchExpirationHandler(c buffalo.Context) error {
+	if err := job.Submit(job.WatchExpiration, nil); err != nil {
+		return c.Error(http.StatusInternalServerError, fmt.Errorf("watch expiration job not started, %s", err))
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package actions
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+
+	"github.com/gobuffalo/buffalo"
+	"github.com/gobuffalo/buffalo/render"
+
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/models"
+)
+
+// unsubscribeTokenParam is the route parameter holding the unsubscribe token
+const unsubscribeTokenParam = "token"
+
+// unsubscribePage is the minimal page shown for the unsubscribe links in notification emails. The recipient may not
+// have a session, so it is rendered by the API rather than the UI.
+var unsubscribePage = template.Must(template.New("unsubscribe").Parse(`<!DOCTYPE html>
+<html lang="{{.Language}}">
+<head>
+	<meta charset="utf-8">
+	<meta name="viewport" content="width=device-width, initial-scale=1">
+	<title>{{.Title}}</title>
+</head>
+<body>
+	<h1>{{.Title}}</h1>
+	<p>{{.Message}}</p>
+	{{if .Button}}<form method="post"><button type="submit">{{.Button}}</button></form>{{end}}
+</body>
+</html>
+`))
+
+// unsubscribePageData holds the translated text of the unsubscribe page
+type unsubscribePageData struct {
+	Language string
+	Title    string
+	Message  string
+	Button   string
+}
+
+// unsubscribeConfirm responds to GET requests at /unsubscribe/{token} with a confirmation form. Opting out is not done
+// on GET, so that link scanners in mail systems do not unsubscribe the recipient.
+func unsubscribeConfirm(c buffalo.Context) error {
+	var user models.User
+	if _, err := user.FindByUnsubscribeToken(c.Param(unsubscribeTokenParam)); err != nil {
+		return renderUnsubscribeError(c, err)
+	}
+
+	lang := user.GetLanguagePreference()
+	return renderUnsubscribePage(c, http.StatusOK, unsubscribePageData{
+		Language: lang,
+		Title:    translateUnsubscribe(lang, "Unsubscribe.Title"),
+		Message:  translateUnsubscribe(lang, "Unsubscribe.Confirm"),
+		Button:   translateUnsubscribe(lang, "Unsubscribe.Button"),
+	})
+}
+
+// unsubscribeHandler responds to POST requests at /unsubscribe/{token}, from the confirmation form or from a mail
+// client's one-click unsubscribe (RFC 8058)
+func unsubscribeHandler(c buffalo.Context) error {
+	var user models.User
+	category, err := user.FindByUnsubscribeToken(c.Param(unsubscribeTokenParam))
+	if err != nil {
+		return renderUnsubscribeError(c, err)
+	}
+
+	if err := user.Unsubscribe(category); err != nil {
+		return c.Error(http.StatusInternalServerError, fmt.Errorf("error unsubscribing user %d from %s, %s",
+			user.ID, category, err))
+	}
+	domain.Logger.Printf("user %s unsubscribed from %s notifications", user.UUID, category)
+
+	lang := user.GetLanguagePreference()
+	return renderUnsubscribePage(c, http.StatusOK, unsubscribePageData{
+		Language: lang,
+		Title:    translateUnsubscribe(lang, "Unsubscribe.Title"),
+		Message:  translateUnsubscribe(lang, "Unsubscribe.Done"),
+	})
+}
+
+// renderUnsubscribeError responds to an unsubscribe request with an invalid token. Other errors are passed on to the
+// error handler.
+func renderUnsubscribeError(c buffalo.Context, err error) error {
+	if err != models.ErrInvalidUnsubscribeToken {
+		return c.Error(http.StatusInternalServerError, fmt.Errorf("error validating unsubscribe token, %s", err))
+	}
+
+	lang := domain.UserPreferenceLanguageEnglish
+	return renderUnsubscribePage(c, http.StatusBadRequest, unsubscribePageData{
+		Language: lang,
+		Title:    translateUnsubscribe(lang, "Unsubscribe.Title"),
+		Message:  translateUnsubscribe(lang, "Unsubscribe.InvalidToken"),
+	})
+}
+
+func renderUnsubscribePage(c buffalo.Context, status int, data unsubscribePageData) error {
+	return c.Render(status, r.Func("text/html; charset=utf-8", func(w io.Writer, _ render.Data) error {
+		return unsubscribePage.Execute(w, data)
+	}))
+}
+
+// translateUnsubscribe returns the translation of the unsubscribe page text, falling back to the translation ID
+func translateUnsubscribe(lang, translationID string) string {
+	s, err := domain.TranslateWithLang(lang, translationID)
+	if err != nil {
+		domain.ErrLogger.Printf("error translating '%s', %s", translationID, err)
+		return translationID
+	}
+	return s
+}
@@ -0,0 +1,67 @@
+package actions
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/internal/test"
+	"github.com/silinternational/wecarry-api/models"
+)
+
+func (as *ActionSuite) Test_Unsubscribe() {
+	user := test.CreateUserFixtures(as.DB, 1).Users[0]
+
+	token, err := user.NewUnsubscribeToken(domain.UserPreferenceKeyNotifyNewMessage)
+	as.NoError(err)
+
+	tests := []struct {
+		name        string
+		method      string
+		token       string
+		wantStatus  int
+		wantContent string
+		wantChannel string
+	}{
+		{
+			name:        "bad token",
+			method:      "POST",
+			token:       "bad.token",
+			wantStatus:  http.StatusBadRequest,
+			wantContent: "invalid or has expired",
+			wantChannel: domain.NotificationChannelEmail,
+		},
+		{
+			name:        "confirm",
+			method:      "GET",
+			token:       token,
+			wantStatus:  http.StatusOK,
+			wantContent: `<form method="post">`,
+			wantChannel: domain.NotificationChannelEmail,
+		},
+		{
+			name:        "unsubscribe",
+			method:      "POST",
+			token:       token,
+			wantStatus:  http.StatusOK,
+			wantContent: "You have been unsubscribed",
+			wantChannel: domain.NotificationChannelNone,
+		},
+	}
+	for _, tt := range tests {
+		as.T().Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/unsubscribe/"+tt.token, nil)
+			rr := httptest.NewRecorder()
+			as.App.ServeHTTP(rr, req)
+
+			as.Equal(tt.wantStatus, rr.Code, "incorrect status code")
+			as.Contains(rr.Header().Get("Content-Type"), "text/html")
+			as.Contains(rr.Body.String(), tt.wantContent)
+
+			var u models.User
+			as.NoError(u.FindByID(user.ID))
+			as.Equal(tt.wantChannel, u.GetNotificationChannel(domain.UserPreferenceKeyNotifyNewMessage))
+		})
+	}
+}
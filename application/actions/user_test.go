@@ -5,7 +5,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/gobuffalo/nulls"
+
 	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/internal/test"
 	"github.com/silinternational/wecarry-api/models"
 )
 
@@ -28,9 +31,11 @@ type User struct {
 	AvatarURL   string               `json:"avatarURL"`
 	PhotoID     string               `json:"photoID"`
 	Preferences struct {
-		Language   *string `json:"language"`
-		TimeZone   *string `json:"timeZone"`
-		WeightUnit *string `json:"weightUnit"`
+		Language        *string `json:"language"`
+		TimeZone        *string `json:"timeZone"`
+		WeightUnit      *string `json:"weightUnit"`
+		QuietHoursStart *string `json:"quietHoursStart"`
+		QuietHoursEnd   *string `json:"quietHoursEnd"`
 	}
 	Location *struct {
 		Description string  `json:"description"`
@@ -47,10 +52,11 @@ type User struct {
 	MeetingsAsParticipant []struct {
 		ID string `json:"id"`
 	} `json:"meetingsAsParticipant"`
+	PhoneNumber *string `json:"phoneNumber"`
 }
 
 const allUserFields = `id email nickname createdAt updatedAt adminRole avatarURL photoID
-	preferences {language timeZone weightUnit}
+	preferences {language timeZone weightUnit quietHoursStart quietHoursEnd}
 	location {description country latitude longitude}
 	organizations {id}
 	requests (role: CREATEDBY) {id}
@@ -208,7 +214,8 @@ func (as *ActionSuite) TestUpdateUser() {
 	newNickname := "U1 New Nickname"
 	location := `{description: "Paris, France", country: "FR", latitude: 48.8588377, longitude: 2.2770202}`
 
-	preferences := fmt.Sprintf(`{weightUnit: %s}`, strings.ToUpper(domain.UserPreferenceWeightUnitKGs))
+	preferences := fmt.Sprintf(`{weightUnit: %s, quietHoursStart: "22:00", quietHoursEnd: "07:00"}`,
+		strings.ToUpper(domain.UserPreferenceWeightUnitKGs))
 
 	update := fmt.Sprintf(`mutation { user: updateUser(input:{id: "%s", nickname: "%s", location: %s,
 			preferences: %s, photoID: "%s"}) {%s} }`,
@@ -249,8 +256,18 @@ func (as *ActionSuite) TestUpdateUser() {
 					"incorrect preference - weightUnit")
 				as.Equal("", *resp.User.Preferences.Language, "incorrect preference - language")
 				as.Equal("", *resp.User.Preferences.TimeZone, "incorrect preference - timeZone")
+				as.Equal("22:00", *resp.User.Preferences.QuietHoursStart, "incorrect preference - quietHoursStart")
+				as.Equal("07:00", *resp.User.Preferences.QuietHoursEnd, "incorrect preference - quietHoursEnd")
 			},
 		},
+		{
+			Name: "invalid quiet hours",
+			Payload: fmt.Sprintf(`mutation {user: updateUser(input:{id: "%v", preferences: {quietHoursStart: "10pm"}})
+				{%s}}`, f.Users[1].UUID, allUserFields),
+			TestUser:    f.Users[0],
+			Test:        func(t *testing.T) {},
+			ExpectError: "One of the user preferences is not valid",
+		},
 		{
 			Name: "not allowed",
 			Payload: fmt.Sprintf(`mutation {user: updateUser(input:{id: "%v", location: %v}) {%s}}`,
@@ -301,3 +318,35 @@ func (as *ActionSuite) TestUpdateUser() {
 		t.Run(test.Name, test.Test)
 	}
 }
+
+func (as *ActionSuite) TestPhoneNumber() {
+	user := test.CreateUserFixtures(as.DB, 1).Users[0]
+
+	query := `mutation { user: sendPhoneCode(input: {phoneNumber: "555-0100"}) { id phoneNumber } }`
+	var resp UserResponse
+	as.Error(as.testGqlQuery(query, user.Nickname, &resp), "invalid phone number was accepted")
+
+	query = `mutation { user: sendPhoneCode(input: {phoneNumber: "+15555550100"}) { id phoneNumber } }`
+	resp = UserResponse{}
+	as.NoError(as.testGqlQuery(query, user.Nickname, &resp))
+	as.Equal(user.UUID.String(), resp.User.ID)
+	as.Nil(resp.User.PhoneNumber, "phone number should not be set before it is confirmed")
+
+	query = `mutation { user: confirmPhoneCode(input: {code: "wrong"}) { id phoneNumber } }`
+	resp = UserResponse{}
+	as.Error(as.testGqlQuery(query, user.Nickname, &resp), "incorrect code was accepted")
+
+	user.PhoneNumber = nulls.NewString("+15555550100")
+	as.NoError(user.Save())
+
+	query = `{ user { id phoneNumber } }`
+	resp = UserResponse{}
+	as.NoError(as.testGqlQuery(query, user.Nickname, &resp))
+	as.NotNil(resp.User.PhoneNumber)
+	as.Equal("+15555550100", *resp.User.PhoneNumber)
+
+	query = `mutation { user: removePhoneNumber { id phoneNumber } }`
+	resp = UserResponse{}
+	as.NoError(as.testGqlQuery(query, user.Nickname, &resp))
+	as.Nil(resp.User.PhoneNumber, "phone number was not removed")
+}
@@ -39,8 +39,15 @@ type watch struct {
 	Meeting     struct {
 		ID string `json:"id"`
 	}
-	SearchText string `json:"searchText"`
-	Size       string `json:"size"`
+	SearchText    string   `json:"searchText"`
+	Size          string   `json:"size"`
+	Radius        *int     `json:"radius"`
+	LocationMatch string   `json:"locationMatch"`
+	NeededAfter   *string  `json:"neededAfter"`
+	NeededBefore  *string  `json:"neededBefore"`
+	Kilograms     *float64 `json:"kilograms"`
+	ExpiresOn     *string  `json:"expiresOn"`
+	IsActive      bool     `json:"isActive"`
 }
 
 type location struct {
@@ -51,13 +58,19 @@ type location struct {
 }
 
 type watchInput struct {
-	id          *string
-	name        string
-	destination locationInput
-	origin      locationInput
-	meetingID   string
-	searchText  string
-	size        models.RequestSize
+	id            *string
+	name          string
+	destination   locationInput
+	origin        locationInput
+	meetingID     string
+	searchText    string
+	size          models.RequestSize
+	radius        int
+	locationMatch models.WatchLocationMatch
+	neededAfter   string
+	neededBefore  string
+	kilograms     float64
+	expiresOn     string
 }
 
 type locationInput struct {
@@ -76,6 +89,13 @@ const allWatchFields = `
     meeting { id }
     searchText
     size
+    radius
+    locationMatch
+    neededAfter
+    neededBefore
+    kilograms
+    expiresOn
+    isActive
 	`
 
 func createFixturesForWatches(as *ActionSuite) watchQueryFixtures {
@@ -133,6 +153,34 @@ func (as *ActionSuite) Test_MyWatches() {
 	as.Equal(f.Locations[0].Country, got[1].Destination.Country, "incorrect Watch Destination")
 }
 
+func (as *ActionSuite) Test_WatchMatchingRequests() {
+	f := createFixturesForWatches(as)
+	requests := test.CreateRequestFixtures(as.DB, 2, false)
+
+	watch := models.Watch{UUID: domain.GetUUID(), OwnerID: f.Users[1].ID, Name: "all requests"}
+	createFixture(as, &watch)
+
+	var resp struct {
+		Watches []struct {
+			MatchingRequests []struct {
+				ID string `json:"id"`
+			} `json:"matchingRequests"`
+		} `json:"watches"`
+	}
+
+	query := `{ watches: myWatches { matchingRequests(page: 2, perPage: 1) { id } } }`
+	as.NoError(as.testGqlQuery(query, f.Users[1].Nickname, &resp))
+	as.Equal(1, len(resp.Watches), "incorrect number of Watches")
+	as.Equal(1, len(resp.Watches[0].MatchingRequests), "incorrect number of matching requests")
+	as.Equal(requests[0].UUID.String(), resp.Watches[0].MatchingRequests[0].ID, "incorrect matching request")
+
+	// the requests were created by user 0, so they don't match user 0's watches
+	query = `{ watches: myWatches { matchingRequests { id } } }`
+	as.NoError(as.testGqlQuery(query, f.Users[0].Nickname, &resp))
+	as.Equal(2, len(resp.Watches), "incorrect number of Watches")
+	as.Equal(0, len(resp.Watches[0].MatchingRequests), "own requests should not match")
+}
+
 func (as *ActionSuite) Test_CreateWatch() {
 	f := createFixturesForWatches(as)
 	user := f.Users[0]
@@ -157,12 +205,47 @@ func (as *ActionSuite) Test_CreateWatch() {
 					latitude:    1.1,
 					longitude:   2.2,
 				},
-				meetingID:  f.Meetings[0].UUID.String(),
-				searchText: "search",
-				size:       models.RequestSizeXlarge,
+				meetingID:     f.Meetings[0].UUID.String(),
+				searchText:    "search",
+				size:          models.RequestSizeXlarge,
+				radius:        25,
+				locationMatch: models.WatchLocationMatchNear,
+			},
+			testUser: f.Users[0],
+		},
+		{
+			name: "country",
+			watch: watchInput{
+				name: "bar",
+				destination: locationInput{
+					description: "watch destination",
+					country:     "KE",
+					latitude:    1.1,
+					longitude:   2.2,
+				},
+				locationMatch: models.WatchLocationMatchCountry,
 			},
 			testUser: f.Users[0],
 		},
+		{
+			name: "radius too large",
+			watch: watchInput{
+				name:   "baz",
+				radius: domain.MaxWatchRadiusKm + 1,
+			},
+			testUser:    f.Users[0],
+			expectError: true,
+		},
+		{
+			name: "needed before earlier than needed after",
+			watch: watchInput{
+				name:         "qux",
+				neededAfter:  "2030-06-02",
+				neededBefore: "2030-06-01",
+			},
+			testUser:    f.Users[0],
+			expectError: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -186,6 +269,13 @@ func (as *ActionSuite) Test_CreateWatch() {
 			as.Equal(tc.watch.destination.longitude, resp.Watch.Destination.Longitude, "incorrect watch Longitude")
 			as.Equal(tc.watch.meetingID, resp.Watch.Meeting.ID, "incorrect Watch meeting ID")
 			as.Equal(tc.watch.searchText, resp.Watch.SearchText, "incorrect Watch search text")
+			as.Equal(tc.watch.locationMatch.String(), resp.Watch.LocationMatch, "incorrect Watch location match")
+			if tc.watch.radius == 0 {
+				as.Nil(resp.Watch.Radius, "radius should be null")
+			} else {
+				as.NotNil(resp.Watch.Radius, "radius is missing")
+				as.Equal(tc.watch.radius, *resp.Watch.Radius, "incorrect Watch radius")
+			}
 
 			var dbWatch models.Watch
 			err = as.DB.Where("uuid = ?", resp.Watch.ID).First(&dbWatch)
@@ -208,6 +298,9 @@ func (as *ActionSuite) Test_UpdateWatch() {
 	var resp watchResponse
 
 	watchUUID := f.Watches[0].UUID.String()
+	f.Watches[0].DeactivatedAt = nulls.NewTime(time.Now())
+	as.NoError(as.DB.Update(&f.Watches[0]))
+	expiresOn := time.Now().AddDate(0, 1, 0).Format(domain.DateFormat)
 
 	testCases := []testCase{
 		{
@@ -221,9 +314,15 @@ func (as *ActionSuite) Test_UpdateWatch() {
 					latitude:    1.1,
 					longitude:   2.2,
 				},
-				meetingID:  f.Meetings[0].UUID.String(),
-				searchText: "search",
-				size:       models.RequestSizeXlarge,
+				meetingID:     f.Meetings[0].UUID.String(),
+				searchText:    "search",
+				size:          models.RequestSizeXlarge,
+				radius:        500,
+				locationMatch: models.WatchLocationMatchCountry,
+				neededAfter:   "2030-06-01",
+				neededBefore:  "2030-06-30",
+				kilograms:     2.5,
+				expiresOn:     expiresOn,
 			},
 			testUser: f.Users[0],
 		},
@@ -261,6 +360,14 @@ func (as *ActionSuite) Test_UpdateWatch() {
 			as.Equal(tc.watch.destination.longitude, resp.Watch.Destination.Longitude, "incorrect watch Longitude")
 			as.Equal(tc.watch.meetingID, resp.Watch.Meeting.ID, "incorrect Watch meeting ID")
 			as.Equal(tc.watch.searchText, resp.Watch.SearchText, "incorrect Watch search text")
+			as.Equal(tc.watch.locationMatch.String(), resp.Watch.LocationMatch, "incorrect Watch location match")
+			as.NotNil(resp.Watch.Radius, "radius is missing")
+			as.Equal(tc.watch.radius, *resp.Watch.Radius, "incorrect Watch radius")
+			as.Equal(tc.watch.neededAfter, *resp.Watch.NeededAfter, "incorrect Watch neededAfter")
+			as.Equal(tc.watch.neededBefore, *resp.Watch.NeededBefore, "incorrect Watch neededBefore")
+			as.Equal(tc.watch.kilograms, *resp.Watch.Kilograms, "incorrect Watch kilograms")
+			as.Equal(tc.watch.expiresOn, *resp.Watch.ExpiresOn, "incorrect Watch expiresOn")
+			as.True(resp.Watch.IsActive, "updated Watch was not reactivated")
 
 			var dbWatch models.Watch
 			err = as.DB.Where("uuid = ?", resp.Watch.ID).First(&dbWatch)
@@ -284,6 +391,25 @@ func (as *ActionSuite) watchInputString(watch watchInput) string {
 		input, watch.name, watch.destination.description, watch.destination.country, watch.destination.latitude,
 		watch.destination.longitude, watch.meetingID, watch.searchText, watch.size)
 
+	if watch.radius != 0 {
+		input = fmt.Sprintf("%s radius: %d", input, watch.radius)
+	}
+	if watch.locationMatch != "" {
+		input = fmt.Sprintf("%s locationMatch: %s", input, watch.locationMatch)
+	}
+	if watch.neededAfter != "" {
+		input = fmt.Sprintf(`%s neededAfter: "%s"`, input, watch.neededAfter)
+	}
+	if watch.neededBefore != "" {
+		input = fmt.Sprintf(`%s neededBefore: "%s"`, input, watch.neededBefore)
+	}
+	if watch.kilograms != 0 {
+		input = fmt.Sprintf("%s kilograms: %f", input, watch.kilograms)
+	}
+	if watch.expiresOn != "" {
+		input = fmt.Sprintf(`%s expiresOn: "%s"`, input, watch.expiresOn)
+	}
+
 	return input
 }
 
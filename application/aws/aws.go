@@ -8,6 +8,7 @@ import (
 	"mime/multipart"
 	"net/textproto"
 	"net/url"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -186,14 +187,14 @@ func CreateS3Bucket() error {
 }
 
 // SendEmail sends a message using SES
-func SendEmail(to, from, subject, body string) error {
+func SendEmail(to, from, subject, body string, headers map[string]string) error {
 	svc, err := createSESService(getSESConfigFromEnv())
 	if err != nil {
 		return fmt.Errorf("SendEmail failed creating SES service, %s", err)
 	}
 
 	input := &ses.SendRawEmailInput{
-		RawMessage: &ses.RawMessage{Data: rawEmail(to, from, subject, body)},
+		RawMessage: &ses.RawMessage{Data: rawEmail(to, from, subject, body, headers)},
 		Source:     aws.String(from),
 	}
 
@@ -219,6 +220,7 @@ func SendEmail(to, from, subject, body string) error {
 //  From: from@example.com
 //	To: to@example.com
 //	Subject: subject text
+//	List-Unsubscribe: <https://example.com/unsubscribe/token>
 //	Content-Type: multipart/alternative; boundary="boundary_alternative"
 //
 //	--boundary_alternative
@@ -238,7 +240,7 @@ func SendEmail(to, from, subject, body string) error {
 //	Content-ID: <logo>
 //	--boundary_related--
 //	--boundary_alternative--
-func rawEmail(to, from, subject, body string) []byte {
+func rawEmail(to, from, subject, body string, headers map[string]string) []byte {
 	tbody, err := html2text.FromString(body)
 	if err != nil {
 		domain.Logger.Printf("error converting html email to plain text ... %s", err.Error())
@@ -250,6 +252,16 @@ func rawEmail(to, from, subject, body string) []byte {
 	b.WriteString("From: " + from + "\n")
 	b.WriteString("To: " + to + "\n")
 	b.WriteString("Subject: " + subject + "\n")
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		b.WriteString(name + ": " + headers[name] + "\n")
+	}
+
 	b.WriteString("MIME-Version: 1.0\n")
 
 	alternativeWriter := multipart.NewWriter(b)
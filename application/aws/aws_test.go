@@ -28,7 +28,8 @@ func (ts *TestSuite) TestSendEmail() {
 		"me@example.com",
 		domain.Env.EmailFromAddress,
 		"test subject",
-		`<h4>body</h4><img src="cid:logo"><p>End of body</p>`)
+		`<h4>body</h4><img src="cid:logo"><p>End of body</p>`,
+		nil)
 	ts.NoError(err)
 }
 
@@ -42,9 +43,11 @@ func (ts *TestSuite) TestRawEmail() {
 		"to@example.com",
 		domain.Env.EmailFromAddress,
 		"test subject",
-		`<h4>body</h4><img src="cid:logo"><p>End of body</p>`)
+		`<h4>body</h4><img src="cid:logo"><p>End of body</p>`,
+		map[string]string{"List-Unsubscribe": "<https://example.com/unsubscribe/token>"})
 
 	ts.Greater(len(raw), 1000)
+	ts.Contains(string(raw), "\nList-Unsubscribe: <https://example.com/unsubscribe/token>\n")
 
 	ts.Equal("", buf.String(), "Got an unexpected error log entry")
 }
@@ -0,0 +1,249 @@
+package aws
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SNS message types
+const (
+	SNSTypeNotification             = "Notification"
+	SNSTypeSubscriptionConfirmation = "SubscriptionConfirmation"
+	SNSTypeUnsubscribeConfirmation  = "UnsubscribeConfirmation"
+)
+
+// snsHostPattern matches the hosts from which SNS signing certificates and subscription URLs are served
+var snsHostPattern = regexp.MustCompile(`^sns\.[a-z0-9-]+\.amazonaws\.com(\.cn)?$`)
+
+// snsClient is used to fetch signing certificates and to confirm subscriptions
+var snsClient = &http.Client{Timeout: 10 * time.Second}
+
+// snsCerts caches the parsed signing certificates by URL
+var snsCerts sync.Map
+
+// fetchSNSCert gets a signing certificate. It is a variable so that it can be replaced in tests.
+var fetchSNSCert = func(certURL string) ([]byte, error) {
+	resp, err := snsClient.Get(certURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("signing certificate request returned status %s", resp.Status)
+	}
+	return ioutil.ReadAll(io.LimitReader(resp.Body, 64*1024))
+}
+
+// SNSMessage is a message posted by Amazon Simple Notification Service (SNS) to an HTTPS subscription
+type SNSMessage struct {
+	Type             string
+	MessageId        string
+	Token            string
+	TopicArn         string
+	Subject          string
+	Message          string
+	Timestamp        string
+	SignatureVersion string
+	Signature        string
+	SigningCertURL   string
+	SubscribeURL     string
+	UnsubscribeURL   string
+}
+
+// stringToSign builds the canonical form of the message signed by SNS
+func (m SNSMessage) stringToSign() (string, error) {
+	var fields [][2]string
+	switch m.Type {
+	case SNSTypeNotification:
+		fields = [][2]string{{"Message", m.Message}, {"MessageId", m.MessageId}}
+		if m.Subject != "" {
+			fields = append(fields, [2]string{"Subject", m.Subject})
+		}
+		fields = append(fields, [][2]string{{"Timestamp", m.Timestamp}, {"TopicArn", m.TopicArn}, {"Type", m.Type}}...)
+	case SNSTypeSubscriptionConfirmation, SNSTypeUnsubscribeConfirmation:
+		fields = [][2]string{
+			{"Message", m.Message}, {"MessageId", m.MessageId}, {"SubscribeURL", m.SubscribeURL},
+			{"Timestamp", m.Timestamp}, {"Token", m.Token}, {"TopicArn", m.TopicArn}, {"Type", m.Type},
+		}
+	default:
+		return "", fmt.Errorf("unknown SNS message type '%s'", m.Type)
+	}
+
+	var b strings.Builder
+	for _, f := range fields {
+		b.WriteString(f[0] + "\n" + f[1] + "\n")
+	}
+	return b.String(), nil
+}
+
+// Verify checks the message signature against the SNS signing certificate. Only certificates served by SNS over https
+// are accepted.
+func (m SNSMessage) Verify() error {
+	var hash crypto.Hash
+	switch m.SignatureVersion {
+	case "1":
+		hash = crypto.SHA1
+	case "2":
+		hash = crypto.SHA256
+	default:
+		return fmt.Errorf("unsupported SNS signature version '%s'", m.SignatureVersion)
+	}
+
+	if err := checkSNSURL(m.SigningCertURL); err != nil {
+		return fmt.Errorf("invalid signing certificate URL, %s", err)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(m.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid SNS signature encoding, %s", err)
+	}
+
+	s, err := m.stringToSign()
+	if err != nil {
+		return err
+	}
+
+	var digest []byte
+	if hash == crypto.SHA1 {
+		sum := sha1.Sum([]byte(s))
+		digest = sum[:]
+	} else {
+		sum := sha256.Sum256([]byte(s))
+		digest = sum[:]
+	}
+
+	key, err := getSNSPublicKey(m.SigningCertURL)
+	if err != nil {
+		return err
+	}
+
+	if err := rsa.VerifyPKCS1v15(key, hash, digest, signature); err != nil {
+		return errors.New("SNS signature is not valid")
+	}
+	return nil
+}
+
+// ConfirmSubscription visits the SubscribeURL of a subscription confirmation message, so that SNS starts sending
+// notifications to the endpoint
+func (m SNSMessage) ConfirmSubscription() error {
+	if m.Type != SNSTypeSubscriptionConfirmation {
+		return fmt.Errorf("SNS message type '%s' is not a subscription confirmation", m.Type)
+	}
+
+	if err := checkSNSURL(m.SubscribeURL); err != nil {
+		return fmt.Errorf("invalid subscribe URL, %s", err)
+	}
+
+	resp, err := snsClient.Get(m.SubscribeURL)
+	if err != nil {
+		return fmt.Errorf("error confirming SNS subscription, %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("SNS subscription confirmation returned status %s", resp.Status)
+	}
+	return nil
+}
+
+// checkSNSURL returns an error unless the URL is an https URL on an SNS host
+func checkSNSURL(u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return err
+	}
+	if parsed.Scheme != "https" || !snsHostPattern.MatchString(parsed.Hostname()) {
+		return fmt.Errorf("'%s' is not an https URL on an SNS host", u)
+	}
+	return nil
+}
+
+// getSNSPublicKey returns the public key of the signing certificate at the given URL
+func getSNSPublicKey(certURL string) (*rsa.PublicKey, error) {
+	if cert, ok := snsCerts.Load(certURL); ok {
+		return cert.(*x509.Certificate).PublicKey.(*rsa.PublicKey), nil
+	}
+
+	data, err := fetchSNSCert(certURL)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching SNS signing certificate, %s", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("SNS signing certificate is not PEM encoded")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing SNS signing certificate, %s", err)
+	}
+
+	now := time.Now()
+	if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+		return nil, errors.New("SNS signing certificate is expired or not yet valid")
+	}
+
+	key, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("SNS signing certificate does not have an RSA key")
+	}
+
+	snsCerts.Store(certURL, cert)
+	return key, nil
+}
+
+// SESNotification is the content of an SNS notification of an SES bounce or complaint. Both the notification format
+// (`notificationType`) and the event publishing format (`eventType`) are supported.
+type SESNotification struct {
+	NotificationType string `json:"notificationType"`
+	EventType        string `json:"eventType"`
+	Bounce           struct {
+		BounceType        string `json:"bounceType"`
+		BounceSubType     string `json:"bounceSubType"`
+		BouncedRecipients []struct {
+			EmailAddress   string `json:"emailAddress"`
+			DiagnosticCode string `json:"diagnosticCode"`
+		} `json:"bouncedRecipients"`
+	} `json:"bounce"`
+	Complaint struct {
+		ComplaintFeedbackType string `json:"complaintFeedbackType"`
+		ComplainedRecipients  []struct {
+			EmailAddress string `json:"emailAddress"`
+		} `json:"complainedRecipients"`
+	} `json:"complaint"`
+}
+
+// Kind returns the type of the notification, e.g. "Bounce" or "Complaint"
+func (n SESNotification) Kind() string {
+	if n.NotificationType != "" {
+		return n.NotificationType
+	}
+	return n.EventType
+}
+
+// IsPermanentBounce is true for a hard bounce, after which mail to the address will not be delivered
+func (n SESNotification) IsPermanentBounce() bool {
+	return n.Kind() == "Bounce" && n.Bounce.BounceType == "Permanent"
+}
+
+// IsComplaint is true if a recipient marked the email as spam
+func (n SESNotification) IsComplaint() bool {
+	return n.Kind() == "Complaint"
+}
@@ -0,0 +1,137 @@
+package aws
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"time"
+)
+
+const testSNSCertURL = "https://sns.us-east-1.amazonaws.com/SimpleNotificationService-test.pem"
+
+// useTestSNSCert replaces the SNS signing certificate with a new self-signed certificate, and returns its key
+func (ts *TestSuite) useTestSNSCert() (*rsa.PrivateKey, func()) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	ts.NoError(err)
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "sns.amazonaws.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	ts.NoError(err)
+
+	oldFetch := fetchSNSCert
+	fetchSNSCert = func(string) ([]byte, error) {
+		return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), nil
+	}
+	snsCerts.Delete(testSNSCertURL)
+
+	return key, func() {
+		fetchSNSCert = oldFetch
+		snsCerts.Delete(testSNSCertURL)
+	}
+}
+
+func signSNSMessage(ts *TestSuite, key *rsa.PrivateKey, m *SNSMessage) {
+	s, err := m.stringToSign()
+	ts.NoError(err)
+
+	var sig []byte
+	if m.SignatureVersion == "1" {
+		sum := sha1.Sum([]byte(s))
+		sig, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA1, sum[:])
+	} else {
+		sum := sha256.Sum256([]byte(s))
+		sig, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, sum[:])
+	}
+	ts.NoError(err)
+	m.Signature = base64.StdEncoding.EncodeToString(sig)
+}
+
+func (ts *TestSuite) TestSNSMessage_Verify() {
+	key, restore := ts.useTestSNSCert()
+	defer restore()
+
+	newMessage := func(version string) SNSMessage {
+		m := SNSMessage{
+			Type:             SNSTypeNotification,
+			MessageId:        "22b80b92-fdea-4c2c-8f9d-bdfb0c7bf324",
+			TopicArn:         "arn:aws:sns:us-east-1:123456789012:ses-events",
+			Message:          `{"notificationType":"Bounce"}`,
+			Timestamp:        "2020-04-22T12:00:00.000Z",
+			SignatureVersion: version,
+			SigningCertURL:   testSNSCertURL,
+		}
+		signSNSMessage(ts, key, &m)
+		return m
+	}
+
+	ts.NoError(newMessage("1").Verify(), "valid version 1 signature was rejected")
+	ts.NoError(newMessage("2").Verify(), "valid version 2 signature was rejected")
+
+	tampered := newMessage("1")
+	tampered.Message = `{"notificationType":"Complaint"}`
+	ts.Error(tampered.Verify(), "tampered message was accepted")
+
+	badHost := newMessage("1")
+	badHost.SigningCertURL = "https://sns.us-east-1.amazonaws.com.example.com/cert.pem"
+	ts.Error(badHost.Verify(), "certificate from a non-SNS host was accepted")
+
+	httpURL := newMessage("1")
+	httpURL.SigningCertURL = "http://sns.us-east-1.amazonaws.com/cert.pem"
+	ts.Error(httpURL.Verify(), "certificate over http was accepted")
+
+	badVersion := newMessage("1")
+	badVersion.SignatureVersion = "3"
+	ts.Error(badVersion.Verify(), "unknown signature version was accepted")
+
+	confirmation := SNSMessage{
+		Type:             SNSTypeSubscriptionConfirmation,
+		MessageId:        "165545c9-2a5c-472c-8df2-7ff2be2b3b1b",
+		Token:            "token",
+		TopicArn:         "arn:aws:sns:us-east-1:123456789012:ses-events",
+		Message:          "You have chosen to subscribe to the topic",
+		SubscribeURL:     "https://sns.us-east-1.amazonaws.com/?Action=ConfirmSubscription&Token=token",
+		Timestamp:        "2020-04-22T12:00:00.000Z",
+		SignatureVersion: "1",
+		SigningCertURL:   testSNSCertURL,
+	}
+	signSNSMessage(ts, key, &confirmation)
+	ts.NoError(confirmation.Verify(), "valid subscription confirmation was rejected")
+
+	confirmation.SubscribeURL = "https://example.com/?Action=ConfirmSubscription"
+	ts.Error(confirmation.Verify(), "modified subscribe URL was accepted")
+	ts.Error(confirmation.ConfirmSubscription(), "subscribe URL on a non-SNS host was visited")
+}
+
+func (ts *TestSuite) TestSESNotification() {
+	tests := []struct {
+		name          string
+		notification  SESNotification
+		wantPermanent bool
+		wantComplaint bool
+	}{
+		{name: "permanent bounce", notification: SESNotification{NotificationType: "Bounce"},
+			wantPermanent: true},
+		{name: "transient bounce", notification: SESNotification{NotificationType: "Bounce"}},
+		{name: "complaint event", notification: SESNotification{EventType: "Complaint"}, wantComplaint: true},
+		{name: "delivery", notification: SESNotification{NotificationType: "Delivery"}},
+	}
+	tests[0].notification.Bounce.BounceType = "Permanent"
+	tests[1].notification.Bounce.BounceType = "Transient"
+
+	for _, test := range tests {
+		ts.Equal(test.wantPermanent, test.notification.IsPermanentBounce(), test.name)
+		ts.Equal(test.wantComplaint, test.notification.IsComplaint(), test.name)
+	}
+}
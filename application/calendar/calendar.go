@@ -0,0 +1,133 @@
+package calendar
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ContentType is the media type of an iCalendar document
+const ContentType = "text/calendar; charset=utf-8"
+
+const (
+	dateFormat     = "20060102"
+	dateTimeFormat = "20060102T150405Z"
+
+	// maxLineOctets is the maximum length of a content line, excluding the line break, as specified by RFC 5545
+	maxLineOctets = 75
+)
+
+// Calendar is an iCalendar (RFC 5545) document
+type Calendar struct {
+	// ProductID identifies the product that created the calendar, e.g. "-//WeCarry//Calendar//EN"
+	ProductID string
+	// Name is the display name of the calendar, used by calendar clients for subscriptions
+	Name   string
+	Events []Event
+}
+
+// Event is an all-day event in a calendar
+type Event struct {
+	// UID is a permanent, unique identifier of the event
+	UID string
+	// Sequence is the revision number of the event. It must be increased whenever the event is changed, so that
+	// calendar clients replace their copy.
+	Sequence    int
+	Summary     string
+	Description string
+	Location    string
+	URL         string
+
+	// StartDate and EndDate are the first and last days of the event. Only the dates are used.
+	StartDate time.Time
+	EndDate   time.Time
+
+	// Updated is the time the event was last changed
+	Updated time.Time
+}
+
+// Write renders the calendar as an iCalendar document
+func Write(w io.Writer, cal Calendar) error {
+	bw := bufio.NewWriter(w)
+
+	writeLine(bw, "BEGIN", "VCALENDAR")
+	writeLine(bw, "VERSION", "2.0")
+	writeLine(bw, "PRODID", cal.ProductID)
+	writeLine(bw, "CALSCALE", "GREGORIAN")
+	writeLine(bw, "METHOD", "PUBLISH")
+	if cal.Name != "" {
+		writeLine(bw, "X-WR-CALNAME", escapeText(cal.Name))
+	}
+
+	for _, e := range cal.Events {
+		writeEvent(bw, e)
+	}
+
+	writeLine(bw, "END", "VCALENDAR")
+	return bw.Flush()
+}
+
+func writeEvent(w *bufio.Writer, e Event) {
+	// all-day events end on the day after the last day
+	endDate := e.EndDate
+	if endDate.Before(e.StartDate) {
+		endDate = e.StartDate
+	}
+	endDate = endDate.AddDate(0, 0, 1)
+
+	writeLine(w, "BEGIN", "VEVENT")
+	writeLine(w, "UID", e.UID)
+	writeLine(w, "SEQUENCE", fmt.Sprintf("%d", e.Sequence))
+	writeLine(w, "DTSTAMP", e.Updated.UTC().Format(dateTimeFormat))
+	writeLine(w, "LAST-MODIFIED", e.Updated.UTC().Format(dateTimeFormat))
+	writeLine(w, "DTSTART;VALUE=DATE", e.StartDate.Format(dateFormat))
+	writeLine(w, "DTEND;VALUE=DATE", endDate.Format(dateFormat))
+	writeLine(w, "SUMMARY", escapeText(e.Summary))
+	if e.Description != "" {
+		writeLine(w, "DESCRIPTION", escapeText(e.Description))
+	}
+	if e.Location != "" {
+		writeLine(w, "LOCATION", escapeText(e.Location))
+	}
+	if e.URL != "" {
+		writeLine(w, "URL", e.URL)
+	}
+	writeLine(w, "TRANSP", "TRANSPARENT")
+	writeLine(w, "END", "VEVENT")
+}
+
+// writeLine writes a content line, folded at 75 octets without splitting a UTF-8 character
+func writeLine(w *bufio.Writer, name, value string) {
+	line := name + ":" + value
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		_, _ = w.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+
+		// continuation lines start with a space, which counts toward the limit
+		limit = maxLineOctets - 1
+	}
+	_, _ = w.WriteString(line + "\r\n")
+}
+
+// textEscaper replaces the characters that must be escaped in a TEXT property value
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+// escapeText escapes a TEXT property value as specified in section 3.3.11 of RFC 5545
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
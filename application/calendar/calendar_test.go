@@ -0,0 +1,80 @@
+package calendar
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testCalendar() Calendar {
+	start := time.Date(2020, 5, 4, 0, 0, 0, 0, time.UTC)
+	return Calendar{
+		ProductID: "-//WeCarry//Calendar//EN",
+		Name:      "WeCarry",
+		Events: []Event{
+			{
+				UID:         "00000000-0000-0000-0000-000000000001",
+				Sequence:    2,
+				Summary:     "Conference; Annual, 2020",
+				Description: "Line one\nLine two",
+				Location:    "Nairobi, Kenya",
+				URL:         "https://example.com/conference",
+				StartDate:   start,
+				EndDate:     start.AddDate(0, 0, 4),
+				Updated:     time.Date(2020, 4, 28, 14, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+}
+
+func TestWrite(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, testCalendar()); err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+	got := buf.String()
+
+	wantContains := []string{
+		"BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//WeCarry//Calendar//EN\r\n",
+		"X-WR-CALNAME:WeCarry\r\n",
+		"BEGIN:VEVENT\r\nUID:00000000-0000-0000-0000-000000000001\r\nSEQUENCE:2\r\n",
+		"DTSTAMP:20200428T140000Z\r\n",
+		"DTSTART;VALUE=DATE:20200504\r\n",
+		"DTEND;VALUE=DATE:20200509\r\n",
+		`SUMMARY:Conference\; Annual\, 2020` + "\r\n",
+		`DESCRIPTION:Line one\nLine two` + "\r\n",
+		`LOCATION:Nairobi\, Kenya` + "\r\n",
+		"URL:https://example.com/conference\r\n",
+		"END:VEVENT\r\nEND:VCALENDAR\r\n",
+	}
+	for _, want := range wantContains {
+		if !strings.Contains(got, want) {
+			t.Errorf("calendar does not contain %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestWrite_Folding(t *testing.T) {
+	cal := testCalendar()
+	cal.Events[0].Description = strings.Repeat("é", 100)
+
+	var buf bytes.Buffer
+	if err := Write(&buf, cal); err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+		if len(line) > maxLineOctets {
+			t.Errorf("line is longer than %d octets: %q", maxLineOctets, line)
+		}
+		if !strings.HasPrefix(line, " ") && strings.Contains(line, "é") && !strings.HasPrefix(line, "DESCRIPTION:") {
+			t.Errorf("folded line does not start with a space: %q", line)
+		}
+	}
+
+	unfolded := strings.Replace(buf.String(), "\r\n ", "", -1)
+	if !strings.Contains(unfolded, "DESCRIPTION:"+strings.Repeat("é", 100)+"\r\n") {
+		t.Errorf("description was not folded correctly:\n%s", buf.String())
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"reflect"
 	"regexp"
@@ -35,11 +37,32 @@ const (
 	DateTimeFormat              = "2006-01-02 15:04:05"
 	NewMessageNotificationDelay = 1 * time.Minute
 	DefaultProximityDistanceKm  = 100
+	MaxWatchRadiusKm            = 2000
 	DurationDay                 = time.Duration(time.Hour * 24)
 	DurationWeek                = time.Duration(DurationDay * 7)
-	RecentMeetingDelay          = DurationDay * 30
+	DigestHour                  = 7 // local hour of the day at which digests are sent
+	DigestWeekday               = time.Monday
 	DataLoaderMaxBatch          = 100
 	DataLoaderWaitMilliSeconds  = 5 * time.Millisecond
+	DefaultPageSize             = 20
+	MaxPageSize                 = 100
+	PhoneCodeLifetime           = 10 * time.Minute
+	PhoneCodeResendDelay        = time.Minute
+	PhoneCodeMaxAttempts        = 5
+	MeetingInviteResendDelay    = time.Hour
+	MeetingInviteMaxSends       = 5
+	OutboxMaxAttempts           = 8
+	OutboxRetryDelay            = time.Minute // doubled after each failed attempt
+	OutboxMaxRetryDelay         = 6 * time.Hour
+	OutboxClaimDuration         = 5 * time.Minute
+	OutboxBatchSize             = 100
+	UnsubscribeTokenLifetime    = 90 * 24 * time.Hour
+	WebhookMaxAttempts          = 8
+	WebhookRetryDelay           = time.Minute // doubled after each failed attempt
+	WebhookMaxRetryDelay        = 6 * time.Hour
+	WebhookClaimDuration        = 5 * time.Minute
+	WebhookBatchSize            = 100
+	WebhookTimeout              = 10 * time.Second
 )
 
 // Event Kinds
@@ -52,16 +75,29 @@ const (
 	EventApiPotentialProviderCreated       = "api:potentialprovider:created"
 	EventApiPotentialProviderRejected      = "api:potentialprovider:rejected"
 	EventApiPotentialProviderSelfDestroyed = "api:potentialprovider:selfdestroyed"
+	EventApiPhoneCodeCreated               = "api:user:phonecode:created"
+	EventApiMeetingCreated                 = "api:meeting:created"
+	EventApiUserOrganizationCreated        = "api:userorganization:created"
+	EventApiWatchSummaryRequested          = "api:watch:summary:requested"
+	EventApiMeetingInviteCreated           = "api:meetinginvite:created"
+	EventApiMeetingInviteResent            = "api:meetinginvite:resent"
+	EventApiMeetingOrganizerAdded          = "api:meeting:organizer:added"
+	EventApiMeetingAnnouncementCreated     = "api:meeting:announcement:created"
 )
 
 // Event and Job argument names
 const (
-	ArgMessageID = "message_id"
+	ArgMessageID   = "message_id"
+	ArgUserID      = "user_id"
+	ArgPhoneNumber = "phone_number"
+	ArgPhoneCode   = "phone_code"
+	ArgWatchID     = "watch_id"
 )
 
 // Notification Message Template Names
 const (
 	MessageTemplateNewRequest                      = "new_request"
+	MessageTemplateDigest                          = "digest"
 	MessageTemplateNewThreadMessage                = "new_thread_message"
 	MessageTemplateNewUserWelcome                  = "new_user_welcome"
 	MessageTemplateRequestFromAcceptedToCompleted  = "request_from_accepted_to_completed"
@@ -83,8 +119,47 @@ const (
 	MessageTemplatePotentialProviderCreated        = "request_potentialprovider_created"
 	MessageTemplatePotentialProviderRejected       = "request_potentialprovider_rejected"
 	MessageTemplatePotentialProviderSelfDestroyed  = "request_potentialprovider_self_destroyed"
+	MessageTemplatePhoneCode                       = "phone_code"
+	MessageTemplateWatchExpired                    = "watch_expired"
+	MessageTemplateWatchSummary                    = "watch_summary"
+	MessageTemplateMeetingInvite                   = "meeting_invite"
+	MessageTemplateMeetingOrganizerAdded           = "meeting_organizer_added"
+	MessageTemplateMeetingAnnouncement             = "meeting_announcement"
 )
 
+// MessageTemplates lists all of the notification message template names, e.g. for previewing the templates
+var MessageTemplates = []string{
+	MessageTemplateNewRequest,
+	MessageTemplateDigest,
+	MessageTemplateNewThreadMessage,
+	MessageTemplateNewUserWelcome,
+	MessageTemplateRequestFromAcceptedToCompleted,
+	MessageTemplateRequestFromAcceptedToDelivered,
+	MessageTemplateRequestFromAcceptedToOpen,
+	MessageTemplateRequestFromAcceptedToReceived,
+	MessageTemplateRequestFromAcceptedToRemoved,
+	MessageTemplateRequestFromCompletedToAccepted,
+	MessageTemplateRequestFromCompletedToDelivered,
+	MessageTemplateRequestFromCompletedToReceived,
+	MessageTemplateRequestFromDeliveredToAccepted,
+	MessageTemplateRequestFromDeliveredToCompleted,
+	MessageTemplateRequestFromOpenToAccepted,
+	MessageTemplateRequestFromOpenToRemoved,
+	MessageTemplateRequestFromReceivedToCompleted,
+	MessageTemplateRequestDelivered,
+	MessageTemplateRequestReceived,
+	MessageTemplateRequestNotReceivedAfterAll,
+	MessageTemplatePotentialProviderCreated,
+	MessageTemplatePotentialProviderRejected,
+	MessageTemplatePotentialProviderSelfDestroyed,
+	MessageTemplatePhoneCode,
+	MessageTemplateWatchExpired,
+	MessageTemplateWatchSummary,
+	MessageTemplateMeetingInvite,
+	MessageTemplateMeetingOrganizerAdded,
+	MessageTemplateMeetingAnnouncement,
+}
+
 // User preferences
 const (
 	UserPreferenceKeyLanguage        = "language"
@@ -96,9 +171,48 @@ const (
 
 	UserPreferenceKeyTimeZone = "time_zone"
 
+	// Quiet hours are the local times, in the user's time zone, between which non-urgent notifications are held.
+	// The window may span midnight, e.g. from "22:00" to "07:00".
+	UserPreferenceKeyQuietHoursStart = "quiet_hours_start"
+	UserPreferenceKeyQuietHoursEnd   = "quiet_hours_end"
+	QuietHoursTimeFormat             = "15:04"
+
 	UserPreferenceKeyWeightUnit    = "weight_unit"
 	UserPreferenceWeightUnitPounds = "pounds"
 	UserPreferenceWeightUnitKGs    = "kilograms"
+
+	UserPreferenceKeyDigest       = "digest"
+	UserPreferenceDigestImmediate = "immediate"
+	UserPreferenceDigestDaily     = "daily"
+	UserPreferenceDigestWeekly    = "weekly"
+)
+
+// Notification preferences, one per type of notification event. The value is the channel used for delivery.
+const (
+	UserPreferenceKeyNotifyNewRequest          = "notify_new_request"
+	UserPreferenceKeyNotifyWatchMatch          = "notify_watch_match"
+	UserPreferenceKeyNotifyNewMessage          = "notify_new_message"
+	UserPreferenceKeyNotifyOfferReceived       = "notify_offer_received"
+	UserPreferenceKeyNotifyOfferRejected       = "notify_offer_rejected"
+	UserPreferenceKeyNotifyOfferRetracted      = "notify_offer_retracted"
+	UserPreferenceKeyNotifyRequestAccepted     = "notify_request_accepted"
+	UserPreferenceKeyNotifyRequestDelivered    = "notify_request_delivered"
+	UserPreferenceKeyNotifyRequestReceived     = "notify_request_received"
+	UserPreferenceKeyNotifyRequestNotDelivered = "notify_request_not_delivered"
+	UserPreferenceKeyNotifyRequestNotReceived  = "notify_request_not_received"
+	UserPreferenceKeyNotifyRequestReopened     = "notify_request_reopened"
+	UserPreferenceKeyNotifyRequestRemoved      = "notify_request_removed"
+	UserPreferenceKeyNotifyMeetingAnnouncement = "notify_meeting_announcement"
+
+	// UnsubscribeCategoryDigest is the unsubscribe category for digest emails, which turns off both new request and
+	// watch match notifications. The other unsubscribe categories are the notification preference keys.
+	UnsubscribeCategoryDigest = "digest"
+
+	NotificationChannelEmail  = "email"
+	NotificationChannelMobile = "mobile"
+	NotificationChannelInApp  = "in_app"
+	NotificationChannelPush   = "push"
+	NotificationChannelNone   = "none"
 )
 
 // UI URL Paths
@@ -106,6 +220,13 @@ const (
 	DefaultUIPath = "/#/requests"
 	requestUIPath = "/#/requests/"
 	threadUIPath  = "/#/messages/"
+	inviteUIPath  = "/#/auth/invite?code="
+	meetingUIPath = "/#/events/"
+)
+
+const (
+	unsubscribePath = "/unsubscribe/"
+	feedPath        = "/feeds/"
 )
 
 // BuffaloContextType is a custom type used as a value key passed to context.WithValue as per the recommendations
@@ -115,6 +236,25 @@ type BuffaloContextType string
 // BuffaloContext is the key for the call to context.WithValue in gqlHandler
 const BuffaloContext = BuffaloContextType("BuffaloContext")
 
+// AllowedLanguages are the supported user interface and notification languages. English is the default.
+var AllowedLanguages = []string{
+	UserPreferenceLanguageEnglish,
+	UserPreferenceLanguageFrench,
+	UserPreferenceLanguageSpanish,
+	UserPreferenceLanguageKorean,
+	UserPreferenceLanguagePortuguese,
+}
+
+// PushServiceHosts are the Web Push services used by the supported browsers. Push subscriptions are accepted only for
+// endpoints on these hosts. An entry beginning with "*." matches any subdomain.
+var PushServiceHosts = []string{
+	"fcm.googleapis.com",
+	"android.googleapis.com",
+	"updates.push.services.mozilla.com",
+	"*.push.apple.com",
+	"*.notify.windows.com",
+}
+
 var Logger log.Logger
 var ErrLogger ErrLogProxy
 var AuthCallbackURL string
@@ -158,12 +298,26 @@ var Env struct {
 	RollbarServerRoot          string
 	RollbarToken               string
 	SendGridAPIKey             string
+	SendGridWebhookPublicKey   string
 	ServerPort                 int
+	SESNotificationTopicARN    string
 	SessionSecret              string
+	SMTPHost                   string
+	SMTPPassword               string
+	SMTPPort                   string
+	SMTPSecurity               string
+	SMTPUsername               string
 	SupportEmail               string
+	TwilioAccountSID           string
+	TwilioAuthToken            string
+	TwilioBaseURL              string
+	TwilioFromNumber           string
 	TwitterKey                 string
 	TwitterSecret              string
 	UIURL                      string
+	VapidPrivateKey            string
+	VapidPublicKey             string
+	VapidSubscriber            string
 }
 
 // T is the Buffalo i18n translator
@@ -219,13 +373,27 @@ func readEnv() {
 	Env.RollbarServerRoot = envy.Get("ROLLBAR_SERVER_ROOT", "github.com/silinternational/wecarry-api")
 	Env.RollbarToken = envy.Get("ROLLBAR_TOKEN", "")
 	Env.SendGridAPIKey = envy.Get("SENDGRID_API_KEY", "")
+	Env.SendGridWebhookPublicKey = envy.Get("SENDGRID_WEBHOOK_PUBLIC_KEY", "")
+	Env.SESNotificationTopicARN = envy.Get("SES_NOTIFICATION_TOPIC_ARN", "")
 	Env.ServerPort, _ = strconv.Atoi(envy.Get("PORT", "3000"))
 	Env.ServiceIntegrationToken = envy.Get("SERVICE_INTEGRATION_TOKEN", "")
 	Env.SessionSecret = envy.Get("SESSION_SECRET", "testing")
+	Env.SMTPHost = envy.Get("SMTP_HOST", "")
+	Env.SMTPPassword = envy.Get("SMTP_PASSWORD", "")
+	Env.SMTPPort = envy.Get("SMTP_PORT", "587")
+	Env.SMTPSecurity = envy.Get("SMTP_SECURITY", "starttls")
+	Env.SMTPUsername = envy.Get("SMTP_USERNAME", "")
 	Env.SupportEmail = envy.Get("SUPPORT_EMAIL", "")
+	Env.TwilioAccountSID = envy.Get("TWILIO_ACCOUNT_SID", "")
+	Env.TwilioAuthToken = envy.Get("TWILIO_AUTH_TOKEN", "")
+	Env.TwilioBaseURL = envy.Get("TWILIO_BASE_URL", "https://api.twilio.com")
+	Env.TwilioFromNumber = envy.Get("TWILIO_FROM_NUMBER", "")
 	Env.TwitterKey = envy.Get("TWITTER_KEY", "")
 	Env.TwitterSecret = envy.Get("TWITTER_SECRET", "")
 	Env.UIURL = envy.Get("UI_URL", "dev.wecarry.app")
+	Env.VapidPrivateKey = envy.Get("VAPID_PRIVATE_KEY", "")
+	Env.VapidPublicKey = envy.Get("VAPID_PUBLIC_KEY", "")
+	Env.VapidSubscriber = envy.Get("VAPID_SUBSCRIBER", Env.SupportEmail)
 }
 
 func envToInt(name string, def int) int {
@@ -486,11 +654,32 @@ func GetThreadUIURL(threadUUID string) string {
 	return Env.UIURL + threadUIPath + threadUUID
 }
 
+// GetMeetingUIURL returns a UI URL for the given Meeting
+func GetMeetingUIURL(meetingUUID string) string {
+	return Env.UIURL + meetingUIPath + meetingUUID
+}
+
+// GetInviteUIURL returns a UI URL for accepting an invite with the given invite code or secret
+func GetInviteUIURL(code string) string {
+	return Env.UIURL + inviteUIPath + code
+}
+
+// GetUnsubscribeURL returns the API URL for opting out of notifications with the given unsubscribe token
+func GetUnsubscribeURL(token string) string {
+	return Env.ApiBaseURL + unsubscribePath + token
+}
+
+// GetFeedURL returns the API URL of a user's feed, authenticated by the given feed token. `kind` is the type of the
+// feed's object, e.g. "watches" or "meetings", and `format` is the feed document format, e.g. "rss".
+func GetFeedURL(token, kind, objectUUID, format string) string {
+	return Env.ApiBaseURL + feedPath + token + "/" + kind + "/" + objectUUID + "/" + format
+}
+
 func IsLanguageAllowed(lang string) bool {
-	switch lang {
-	case UserPreferenceLanguageEnglish, UserPreferenceLanguageFrench, UserPreferenceLanguageKorean,
-		UserPreferenceLanguagePortuguese, UserPreferenceLanguageSpanish:
-		return true
+	for _, l := range AllowedLanguages {
+		if lang == l {
+			return true
+		}
 	}
 
 	return false
@@ -505,6 +694,119 @@ func IsWeightUnitAllowed(unit string) bool {
 	return false
 }
 
+func IsDigestAllowed(digest string) bool {
+	switch digest {
+	case UserPreferenceDigestImmediate, UserPreferenceDigestDaily, UserPreferenceDigestWeekly:
+		return true
+	}
+
+	return false
+}
+
+func IsNotificationChannelAllowed(channel string) bool {
+	switch channel {
+	case NotificationChannelEmail, NotificationChannelMobile, NotificationChannelInApp, NotificationChannelPush,
+		NotificationChannelNone:
+		return true
+	}
+
+	return false
+}
+
+// phoneNumberRegexp matches a phone number in E.164 format, e.g. +15555550100
+var phoneNumberRegexp = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+
+// IsPhoneNumberValid returns true if the given phone number is in E.164 format
+func IsPhoneNumberValid(phoneNumber string) bool {
+	return phoneNumberRegexp.MatchString(phoneNumber)
+}
+
+// IsQuietHoursTimeAllowed returns true if the given time of day is in QuietHoursTimeFormat, e.g. "22:00"
+func IsQuietHoursTimeAllowed(timeOfDay string) bool {
+	if len(timeOfDay) != len(QuietHoursTimeFormat) {
+		return false
+	}
+	_, err := time.Parse(QuietHoursTimeFormat, timeOfDay)
+	return err == nil
+}
+
+// IsPushServiceURLAllowed returns true if the given Web Push endpoint is an https URL on one of the PushServiceHosts
+func IsPushServiceURLAllowed(endpoint string) bool {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Scheme != "https" || u.User != nil || u.Port() != "" {
+		return false
+	}
+
+	host := strings.ToLower(u.Hostname())
+	for _, allowed := range PushServiceHosts {
+		if host == allowed || (strings.HasPrefix(allowed, "*.") && strings.HasSuffix(host, allowed[1:])) {
+			return true
+		}
+	}
+	return false
+}
+
+// nonPublicNetworks are the loopback, private, shared and link-local address ranges, which requests to URLs provided by
+// users, e.g. webhooks, must not reach
+var nonPublicNetworks = parseCIDRs(
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"::1/128",
+	"fc00::/7",
+	"fe80::/10",
+)
+
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks[i] = n
+	}
+	return networks
+}
+
+// IsPublicIP returns false for loopback, private, link-local, unspecified and multicast addresses
+func IsPublicIP(ip net.IP) bool {
+	if ip == nil || ip.IsUnspecified() || ip.IsMulticast() {
+		return false
+	}
+	for _, n := range nonPublicNetworks {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// lookupIP resolves host names. It is a variable so that it can be replaced in tests.
+var lookupIP = net.LookupIP
+
+// CheckPublicHost returns an error unless the given host name or IP address resolves only to public addresses
+func CheckPublicHost(host string) error {
+	ips := []net.IP{net.ParseIP(host)}
+	if ips[0] == nil {
+		var err error
+		if ips, err = lookupIP(host); err != nil {
+			return fmt.Errorf("error resolving host %s, %s", host, err)
+		}
+	}
+
+	for _, ip := range ips {
+		if !IsPublicIP(ip) {
+			return fmt.Errorf("host %s resolves to non-public address %s", host, ip)
+		}
+	}
+	return nil
+}
+
 func IsTimeZoneAllowed(name string) bool {
 	_, err := time.LoadLocation(name)
 
@@ -4,7 +4,13 @@ import (
 	"errors"
 	"github.com/gobuffalo/validate"
 	"github.com/stretchr/testify/suite"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"net"
 	"net/http"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -453,6 +459,46 @@ func (ts *TestSuite) TestIsWeightUnitAllowed() {
 	ts.False(got, unit+" should not be an allowed weight unit")
 }
 
+func (ts *TestSuite) TestIsDigestAllowed() {
+	digest := UserPreferenceDigestWeekly
+	got := IsDigestAllowed(digest)
+	ts.True(got, digest+" should be an allowed digest frequency")
+
+	digest = "hourly"
+	got = IsDigestAllowed(digest)
+	ts.False(got, digest+" should not be an allowed digest frequency")
+}
+
+func (ts *TestSuite) TestIsNotificationChannelAllowed() {
+	channel := NotificationChannelInApp
+	got := IsNotificationChannelAllowed(channel)
+	ts.True(got, channel+" should be an allowed notification channel")
+
+	channel = "carrier_pigeon"
+	got = IsNotificationChannelAllowed(channel)
+	ts.False(got, channel+" should not be an allowed notification channel")
+}
+
+func (ts *TestSuite) TestIsPhoneNumberValid() {
+	for _, n := range []string{"+15555550100", "+447700900123", "+8210123456"} {
+		ts.True(IsPhoneNumberValid(n), n+" should be a valid phone number")
+	}
+
+	for _, n := range []string{"", "15555550100", "+1 555 555 0100", "+05555550100", "+1555", "+1234567890123456"} {
+		ts.False(IsPhoneNumberValid(n), n+" should not be a valid phone number")
+	}
+}
+
+func (ts *TestSuite) TestIsQuietHoursTimeAllowed() {
+	for _, tod := range []string{"00:00", "07:30", "22:00", "23:59"} {
+		ts.True(IsQuietHoursTimeAllowed(tod), tod+" should be an allowed time of day")
+	}
+
+	for _, tod := range []string{"", "7:30", "24:00", "22:60", "10pm", "22:00:00"} {
+		ts.False(IsQuietHoursTimeAllowed(tod), tod+" should not be an allowed time of day")
+	}
+}
+
 func (ts *TestSuite) TestIsTimeZoneAllowed() {
 	zone := "America/New_York"
 	got := IsTimeZoneAllowed(zone)
@@ -467,6 +513,26 @@ func (ts *TestSuite) TestIsTimeZoneAllowed() {
 	ts.False(got, zone+" should not be an time zone")
 }
 
+func (ts *TestSuite) TestMessageTemplates() {
+	f, err := parser.ParseFile(token.NewFileSet(), "domain.go", nil, 0)
+	ts.NoError(err, "error parsing domain.go")
+
+	count := 0
+	for _, obj := range f.Scope.Objects {
+		if obj.Kind != ast.Con || !strings.HasPrefix(obj.Name, "MessageTemplate") {
+			continue
+		}
+		count++
+
+		lit, ok := obj.Decl.(*ast.ValueSpec).Values[0].(*ast.BasicLit)
+		ts.True(ok, obj.Name+" is not a string literal")
+		value, _ := strconv.Unquote(lit.Value)
+		ts.True(IsStringInSlice(value, MessageTemplates), obj.Name+" is missing from MessageTemplates")
+	}
+
+	ts.Equal(count, len(MessageTemplates), "MessageTemplates has an unknown or duplicate template")
+}
+
 func (ts *TestSuite) TestGetTranslatedSubject() {
 	t := ts.T()
 	requestTitle := "MyRequest"
@@ -746,3 +812,78 @@ func (ts *TestSuite) TestUniquifyIntSlice() {
 		})
 	}
 }
+
+func (ts *TestSuite) TestCheckPublicHost() {
+	t := ts.T()
+
+	oldLookupIP := lookupIP
+	defer func() { lookupIP = oldLookupIP }()
+	lookupIP = func(host string) ([]net.IP, error) {
+		switch host {
+		case "public.example.com":
+			return []net.IP{net.ParseIP("93.184.216.34"), net.ParseIP("2606:2800:220:1::248")}, nil
+		case "mixed.example.com":
+			return []net.IP{net.ParseIP("93.184.216.34"), net.ParseIP("10.1.2.3")}, nil
+		case "metadata.example.com":
+			return []net.IP{net.ParseIP("169.254.169.254")}, nil
+		}
+		return nil, errors.New("no such host")
+	}
+
+	tests := []struct {
+		name    string
+		host    string
+		wantErr bool
+	}{
+		{name: "public name", host: "public.example.com"},
+		{name: "public IPv4", host: "8.8.8.8"},
+		{name: "public IPv6", host: "2001:4860:4860::8888"},
+		{name: "name with one private address", host: "mixed.example.com", wantErr: true},
+		{name: "link-local name", host: "metadata.example.com", wantErr: true},
+		{name: "unknown name", host: "nowhere.example.com", wantErr: true},
+		{name: "loopback IPv4", host: "127.0.0.1", wantErr: true},
+		{name: "loopback IPv6", host: "::1", wantErr: true},
+		{name: "private IPv4", host: "192.168.1.1", wantErr: true},
+		{name: "private IPv6", host: "fd00::1", wantErr: true},
+		{name: "unspecified", host: "0.0.0.0", wantErr: true},
+		{name: "IPv4-mapped loopback", host: "::ffff:127.0.0.1", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := CheckPublicHost(test.host)
+			if test.wantErr {
+				ts.Error(err)
+				return
+			}
+			ts.NoError(err)
+		})
+	}
+}
+
+func (ts *TestSuite) TestIsPushServiceURLAllowed() {
+	t := ts.T()
+	tests := []struct {
+		name     string
+		endpoint string
+		want     bool
+	}{
+		{name: "chrome", endpoint: "https://fcm.googleapis.com/fcm/send/abc123", want: true},
+		{name: "firefox", endpoint: "https://updates.push.services.mozilla.com/wpush/v2/abc123", want: true},
+		{name: "safari subdomain", endpoint: "https://web.push.apple.com/abc123", want: true},
+		{name: "edge subdomain", endpoint: "https://wns2-by3p.notify.windows.com/w/?token=abc", want: true},
+		{name: "upper case host", endpoint: "https://FCM.googleapis.com/fcm/send/abc123", want: true},
+		{name: "http", endpoint: "http://fcm.googleapis.com/fcm/send/abc123"},
+		{name: "unknown host", endpoint: "https://push.example.com/send/abc123"},
+		{name: "suffix without a dot", endpoint: "https://evilpush.apple.com/abc123"},
+		{name: "bare wildcard domain", endpoint: "https://push.apple.com/abc123"},
+		{name: "allowed host as a subdomain", endpoint: "https://fcm.googleapis.com.example.com/send"},
+		{name: "port", endpoint: "https://fcm.googleapis.com:8443/fcm/send/abc123"},
+		{name: "user info", endpoint: "https://user@fcm.googleapis.com/fcm/send/abc123"},
+		{name: "not a URL", endpoint: "not a url"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts.Equal(test.want, IsPushServiceURLAllowed(test.endpoint))
+		})
+	}
+}
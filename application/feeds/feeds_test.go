@@ -0,0 +1,114 @@
+package feeds
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testFeed() Feed {
+	published := time.Date(2020, 4, 26, 14, 0, 0, 0, time.UTC)
+	return Feed{
+		Title:       "WeCarry: Coffee",
+		Description: "Coffee to Nairobi",
+		Author:      "WeCarry",
+		Link:        "https://example.com/#/requests",
+		FeedURL:     "https://api.example.com/feeds/token/watches/id/rss",
+		Updated:     published.Add(time.Hour),
+		Items: []Item{
+			{
+				ID:          "urn:uuid:00000000-0000-0000-0000-000000000001",
+				Title:       "A bag of coffee & tea",
+				Link:        "https://example.com/#/requests/00000000-0000-0000-0000-000000000001",
+				Description: "Nairobi, Kenya",
+				Author:      "Requester",
+				Published:   published,
+				Updated:     published.Add(time.Hour),
+			},
+		},
+	}
+}
+
+func TestFormat_IsValid(t *testing.T) {
+	for _, f := range []Format{FormatRSS, FormatAtom, FormatJSON} {
+		if !f.IsValid() {
+			t.Errorf("format %s should be valid", f)
+		}
+	}
+	if Format("ics").IsValid() {
+		t.Error("format ics should not be valid")
+	}
+}
+
+func TestWrite(t *testing.T) {
+	tests := []struct {
+		name         string
+		format       Format
+		wantContains []string
+	}{
+		{
+			name:   "rss",
+			format: FormatRSS,
+			wantContains: []string{
+				`<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom">`,
+				`<atom:link href="https://api.example.com/feeds/token/watches/id/rss" rel="self"`,
+				`<title>A bag of coffee &amp; tea</title>`,
+				`<guid isPermaLink="false">urn:uuid:00000000-0000-0000-0000-000000000001</guid>`,
+				`<pubDate>Sun, 26 Apr 2020 14:00:00 +0000</pubDate>`,
+			},
+		},
+		{
+			name:   "atom",
+			format: FormatAtom,
+			wantContains: []string{
+				`<feed xmlns="http://www.w3.org/2005/Atom">`,
+				`<id>urn:uuid:00000000-0000-0000-0000-000000000001</id>`,
+				`<published>2020-04-26T14:00:00Z</published>`,
+				`<updated>2020-04-26T15:00:00Z</updated>`,
+				`<name>Requester</name>`,
+			},
+		},
+		{
+			name:   "json",
+			format: FormatJSON,
+			wantContains: []string{
+				`"version": "https://jsonfeed.org/version/1.1"`,
+				`"url": "https://example.com/#/requests/00000000-0000-0000-0000-000000000001"`,
+				`"date_published": "2020-04-26T14:00:00Z"`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Write(&buf, testFeed(), tt.format); err != nil {
+				t.Fatalf("unexpected error, %s", err)
+			}
+
+			if tt.format == FormatJSON {
+				var v map[string]interface{}
+				if err := json.Unmarshal(buf.Bytes(), &v); err != nil {
+					t.Errorf("invalid JSON, %s", err)
+				}
+			} else {
+				var v struct{}
+				if err := xml.Unmarshal(buf.Bytes(), &v); err != nil {
+					t.Errorf("invalid XML, %s", err)
+				}
+			}
+
+			for _, want := range tt.wantContains {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("feed does not contain %s:\n%s", want, buf.String())
+				}
+			}
+		})
+	}
+
+	if err := Write(&bytes.Buffer{}, testFeed(), Format("ics")); err == nil {
+		t.Error("expected an error for an unsupported format")
+	}
+}
@@ -4,6 +4,7 @@ go 1.13
 
 require (
 	github.com/99designs/gqlgen v0.10.1
+	github.com/SherClockHolmes/webpush-go v1.1.0
 	github.com/aws/aws-sdk-go v1.25.0
 	github.com/beevik/etree v1.1.0 // indirect
 	github.com/caddyserver/certmagic v0.10.5
@@ -20,6 +21,7 @@ require (
 	github.com/gobuffalo/mw-paramlogger v0.0.0-20190224201358-0d45762ab655
 	github.com/gobuffalo/nulls v0.1.0
 	github.com/gobuffalo/packr/v2 v2.7.1
+	github.com/gobuffalo/plush v3.8.3+incompatible
 	github.com/gobuffalo/pop v4.12.2+incompatible
 	github.com/gobuffalo/suite v2.8.1+incompatible
 	github.com/gobuffalo/validate v2.0.4+incompatible
@@ -31,6 +33,7 @@ require (
 	github.com/markbates/goth v1.56.0
 	github.com/markbates/grift v1.5.0
 	github.com/mrjones/oauth v0.0.0-20180629183705-f4e24b6d100c
+	github.com/nicksnyder/go-i18n v1.10.0
 	github.com/olekukonko/tablewriter v0.0.2 // indirect
 	github.com/paganotoni/sendgrid-sender v1.0.5
 	github.com/pkg/errors v0.8.1
@@ -43,6 +46,7 @@ require (
 	github.com/ssor/bom v0.0.0-20170718123548-6386211fdfcf // indirect
 	github.com/stretchr/testify v1.4.0
 	github.com/vektah/gqlparser v1.1.2
+	golang.org/x/crypto v0.0.0-20200302210943-78000ba7a073
 	golang.org/x/image v0.0.0-20191214001246-9130b4cfad52
 	golang.org/x/oauth2 v0.0.0-20190604053449-0f29369cfe45
 	jaytaylor.com/html2text v0.0.0-20190408195923-01ec452cbe43
@@ -0,0 +1,88 @@
+package gqlgen
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/models"
+)
+
+// EmailDelivery returns the email delivery resolver. It is required by GraphQL
+func (r *Resolver) EmailDelivery() EmailDeliveryResolver {
+	return &emailDeliveryResolver{r}
+}
+
+type emailDeliveryResolver struct{ *Resolver }
+
+// ID resolves the `ID` property of the email delivery query. It provides the UUID instead of the autoincrement ID.
+func (r *emailDeliveryResolver) ID(ctx context.Context, obj *models.OutboxMessage) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+	return obj.UUID.String(), nil
+}
+
+// User resolves the `user` property of the email delivery query
+func (r *emailDeliveryResolver) User(ctx context.Context, obj *models.OutboxMessage) (*models.User, error) {
+	if obj == nil {
+		return nil, nil
+	}
+
+	user, err := obj.GetUser()
+	if err != nil {
+		return nil, domain.ReportError(ctx, err, "GetEmailDeliveryUser")
+	}
+
+	return user, nil
+}
+
+// LastError resolves the `lastError` property of the email delivery query
+func (r *emailDeliveryResolver) LastError(ctx context.Context, obj *models.OutboxMessage) (*string, error) {
+	if obj == nil {
+		return nil, nil
+	}
+
+	return models.GetStringFromNullsString(obj.LastError), nil
+}
+
+// SentAt resolves the `sentAt` property of the email delivery query
+func (r *emailDeliveryResolver) SentAt(ctx context.Context, obj *models.OutboxMessage) (*time.Time, error) {
+	if obj == nil || !obj.SentAt.Valid {
+		return nil, nil
+	}
+
+	return &obj.SentAt.Time, nil
+}
+
+// EmailDeliveries resolves the `emailDeliveries` query by getting one page of outbox messages. Only Super Admins are
+// authorized.
+func (r *queryResolver) EmailDeliveries(ctx context.Context, userID *string, template *string,
+	status *models.OutboxMessageStatus, page *int, perPage *int) ([]models.OutboxMessage, error) {
+
+	currentUser := models.CurrentUser(ctx)
+	if currentUser.AdminRole != models.UserAdminRoleSuperAdmin {
+		err := errors.New("insufficient permissions")
+		extras := map[string]interface{}{
+			"role": currentUser.AdminRole,
+		}
+		return nil, domain.ReportError(ctx, err, "EmailDeliveries.Unauthorized", extras)
+	}
+
+	var user *models.User
+	if userID != nil {
+		user = &models.User{}
+		if err := user.FindByUUID(*userID); err != nil {
+			return nil, domain.ReportError(ctx, err, "EmailDeliveries.UserNotFound")
+		}
+	}
+
+	p, pp := getPagination(page, perPage)
+	var deliveries models.OutboxMessages
+	if err := deliveries.FindForStatusQuery(user, template, status, p, pp); err != nil {
+		return nil, domain.ReportError(ctx, err, "EmailDeliveries")
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,76 @@
+package gqlgen
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/models"
+	"github.com/silinternational/wecarry-api/notifications"
+)
+
+// EmailTemplatePreviews resolves the `emailTemplatePreviews` query by rendering each message template, or only the
+// requested template, with sample data. Only Super Admins are authorized.
+func (r *queryResolver) EmailTemplatePreviews(ctx context.Context, template *string,
+	language *PreferredLanguage) ([]notifications.TemplatePreview, error) {
+
+	currentUser := models.CurrentUser(ctx)
+	if currentUser.AdminRole != models.UserAdminRoleSuperAdmin {
+		err := errors.New("insufficient permissions")
+		extras := map[string]interface{}{
+			"role": currentUser.AdminRole,
+		}
+		return nil, domain.ReportError(ctx, err, "EmailTemplatePreviews.Unauthorized", extras)
+	}
+
+	templates := domain.MessageTemplates
+	if template != nil {
+		templates = []string{*template}
+	}
+
+	previews := make([]notifications.TemplatePreview, len(templates))
+	for i, t := range templates {
+		preview, err := notifications.PreviewTemplate(t, previewLanguage(language))
+		if err != nil {
+			extras := map[string]interface{}{
+				"template": t,
+			}
+			return nil, domain.ReportError(ctx, err, "EmailTemplatePreviews", extras)
+		}
+		previews[i] = preview
+	}
+
+	return previews, nil
+}
+
+// SendTestEmail resolves the `sendTestEmail` mutation by rendering a message template with sample data and sending it
+// to the auth user. Only Super Admins are authorized.
+func (r *mutationResolver) SendTestEmail(ctx context.Context, template string,
+	language *PreferredLanguage) (*notifications.TemplatePreview, error) {
+
+	currentUser := models.CurrentUser(ctx)
+	extras := map[string]interface{}{
+		"template": template,
+		"role":     currentUser.AdminRole,
+	}
+	if currentUser.AdminRole != models.UserAdminRoleSuperAdmin {
+		err := errors.New("insufficient permissions")
+		return nil, domain.ReportError(ctx, err, "SendTestEmail.Unauthorized", extras)
+	}
+
+	preview, err := notifications.SendTemplatePreview(template, previewLanguage(language), currentUser)
+	if err != nil {
+		return nil, domain.ReportError(ctx, err, "SendTestEmail", extras)
+	}
+
+	return &preview, nil
+}
+
+// previewLanguage converts the optional GraphQL language to a user preference language, defaulting to English
+func previewLanguage(language *PreferredLanguage) string {
+	if language == nil {
+		return domain.UserPreferenceLanguageEnglish
+	}
+	return strings.ToLower(language.String())
+}
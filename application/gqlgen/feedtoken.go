@@ -0,0 +1,100 @@
+package gqlgen
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/models"
+)
+
+// FeedToken returns the feed token resolver. It is required by GraphQL
+func (r *Resolver) FeedToken() FeedTokenResolver {
+	return &feedTokenResolver{r}
+}
+
+type feedTokenResolver struct{ *Resolver }
+
+// ID resolves the `ID` property of the feed token query. It provides the UUID instead of the autoincrement ID.
+func (r *feedTokenResolver) ID(ctx context.Context, obj *models.FeedToken) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+	return obj.UUID.String(), nil
+}
+
+// Token resolves the `token` property of the feed token query. It is only available on a newly created token.
+func (r *feedTokenResolver) Token(ctx context.Context, obj *models.FeedToken) (*string, error) {
+	if obj == nil || obj.Token == "" {
+		return nil, nil
+	}
+	return &obj.Token, nil
+}
+
+// LastUsedAt resolves the `lastUsedAt` property of the feed token query
+func (r *feedTokenResolver) LastUsedAt(ctx context.Context, obj *models.FeedToken) (*time.Time, error) {
+	if obj == nil || !obj.LastUsedAt.Valid {
+		return nil, nil
+	}
+	return &obj.LastUsedAt.Time, nil
+}
+
+// MyFeedTokens resolves the `myFeedTokens` query by getting a list of the current user's feed tokens
+func (r *queryResolver) MyFeedTokens(ctx context.Context) ([]models.FeedToken, error) {
+	currentUser := models.CurrentUser(ctx)
+
+	var tokens models.FeedTokens
+	if err := tokens.FindByUser(currentUser); err != nil {
+		extras := map[string]interface{}{
+			"user": currentUser.UUID,
+		}
+		return nil, domain.ReportError(ctx, err, "MyFeedTokens", extras)
+	}
+
+	return tokens, nil
+}
+
+// CreateFeedToken resolves the `createFeedToken` mutation. The new token is only ever provided in this response.
+func (r *mutationResolver) CreateFeedToken(ctx context.Context) (*models.FeedToken, error) {
+	currentUser := models.CurrentUser(ctx)
+
+	token := models.FeedToken{UserID: currentUser.ID}
+	if err := token.Create(); err != nil {
+		extras := map[string]interface{}{
+			"user": currentUser.UUID,
+		}
+		return nil, domain.ReportError(ctx, err, "CreateFeedToken", extras)
+	}
+
+	return &token, nil
+}
+
+// RevokeFeedToken resolves the `revokeFeedToken` mutation, and returns the current user's remaining feed tokens
+func (r *mutationResolver) RevokeFeedToken(ctx context.Context, id string) ([]models.FeedToken, error) {
+	currentUser := models.CurrentUser(ctx)
+	extras := map[string]interface{}{
+		"user": currentUser.UUID,
+	}
+
+	var token models.FeedToken
+	if err := token.FindByUUID(id); err != nil {
+		return nil, domain.ReportError(ctx, err, "RevokeFeedToken.NotFound", extras)
+	}
+
+	if token.UserID != currentUser.ID {
+		err := errors.New("user attempted to revoke another user's feed token")
+		return nil, domain.ReportError(ctx, err, "RevokeFeedToken.NotFound", extras)
+	}
+
+	if err := token.Destroy(); err != nil {
+		return nil, domain.ReportError(ctx, err, "RevokeFeedToken", extras)
+	}
+
+	var tokens models.FeedTokens
+	if err := tokens.FindByUser(currentUser); err != nil {
+		return nil, domain.ReportError(ctx, err, "MyFeedTokens", extras)
+	}
+
+	return tokens, nil
+}
@@ -3,6 +3,7 @@ package gqlgen
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/gobuffalo/nulls"
@@ -33,6 +34,18 @@ func setOptionalFloatField(input *float64, output *nulls.Float64) {
 	*output = nulls.Float64{}
 }
 
+// convertOptionalDate converts an optional yyyy-mm-dd date string to a nulls.Time
+func convertOptionalDate(input *string) (nulls.Time, error) {
+	if input == nil {
+		return nulls.Time{}, nil
+	}
+	date, err := domain.ConvertStringPtrToDate(input)
+	if err != nil {
+		return nulls.Time{}, err
+	}
+	return nulls.NewTime(date), nil
+}
+
 func convertOptionalLocation(input *LocationInput) *models.Location {
 	if input != nil {
 		l := convertLocation(*input)
@@ -53,6 +66,29 @@ func convertLocation(input LocationInput) models.Location {
 	return l
 }
 
+const notificationPreferenceKeyPrefix = "notify_"
+
+func convertNotificationPreferencesInput(input []NotificationPreferenceInput) models.NotificationPreferences {
+	prefs := models.NotificationPreferences{}
+	for _, p := range input {
+		key := notificationPreferenceKeyPrefix + strings.ToLower(p.Event.String())
+		prefs[key] = strings.ToLower(p.Channel.String())
+	}
+	return prefs
+}
+
+func convertNotificationPreferences(prefs models.NotificationPreferences) []NotificationPreference {
+	converted := make([]NotificationPreference, 0, len(prefs))
+	for key, channel := range prefs {
+		converted = append(converted, NotificationPreference{
+			Event:   NotificationEvent(strings.ToUpper(strings.TrimPrefix(key, notificationPreferenceKeyPrefix))),
+			Channel: NotificationChannel(strings.ToUpper(channel)),
+		})
+	}
+	sort.Slice(converted, func(i, j int) bool { return converted[i].Event < converted[j].Event })
+	return converted
+}
+
 func convertUserPreferencesToStandardPreferences(input *UpdateUserPreferencesInput) (models.StandardPreferences, error) {
 	if input == nil {
 		return models.StandardPreferences{}, nil
@@ -83,5 +119,48 @@ func convertUserPreferencesToStandardPreferences(input *UpdateUserPreferencesInp
 		stPrefs.WeightUnit = unit
 	}
 
+	if input.Digest != nil {
+		digest := strings.ToLower(fmt.Sprintf("%v", *input.Digest))
+		if !domain.IsDigestAllowed(digest) {
+			return models.StandardPreferences{}, errors.New("user preference digest not allowed ... " + digest)
+		}
+		stPrefs.Digest = digest
+	}
+
+	if input.QuietHoursStart != nil {
+		if !domain.IsQuietHoursTimeAllowed(*input.QuietHoursStart) {
+			return models.StandardPreferences{}, errors.New("user preference quiet hours start not allowed ... " +
+				*input.QuietHoursStart)
+		}
+		stPrefs.QuietHoursStart = *input.QuietHoursStart
+	}
+
+	if input.QuietHoursEnd != nil {
+		if !domain.IsQuietHoursTimeAllowed(*input.QuietHoursEnd) {
+			return models.StandardPreferences{}, errors.New("user preference quiet hours end not allowed ... " +
+				*input.QuietHoursEnd)
+		}
+		stPrefs.QuietHoursEnd = *input.QuietHoursEnd
+	}
+
 	return stPrefs, nil
 }
+
+// getPagination converts optional pagination parameters to a page number, starting at 1, and a page size, limited to
+// domain.MaxPageSize
+func getPagination(page, perPage *int) (int, int) {
+	p := 1
+	if page != nil && *page > 1 {
+		p = *page
+	}
+
+	pp := domain.DefaultPageSize
+	if perPage != nil && *perPage > 0 {
+		pp = *perPage
+	}
+	if pp > domain.MaxPageSize {
+		pp = domain.MaxPageSize
+	}
+
+	return p, pp
+}
@@ -2,9 +2,12 @@ package gqlgen
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/gobuffalo/nulls"
+
 	"github.com/silinternational/wecarry-api/dataloader"
 	"github.com/silinternational/wecarry-api/domain"
 	"github.com/silinternational/wecarry-api/models"
@@ -108,8 +111,9 @@ func (r *meetingResolver) Requests(ctx context.Context, obj *models.Meeting) ([]
 	if obj == nil {
 		return nil, nil
 	}
-	requests, err := obj.Requests()
-	if err != nil {
+	var requests models.Requests
+	filter := models.RequestFilterParams{MeetingID: &obj.ID}
+	if err := requests.FindByUser(ctx, models.CurrentUser(ctx), filter); err != nil {
 		return nil, domain.ReportError(ctx, err, "Meeting.Requests")
 	}
 	return requests, nil
@@ -137,10 +141,6 @@ func (r *meetingResolver) Participants(ctx context.Context, obj *models.Meeting)
 	return participants, nil
 }
 
-func (r *meetingResolver) Visibility(ctx context.Context, obj *models.Meeting) (MeetingVisibility, error) {
-	return MeetingVisibilityAll, nil
-}
-
 func (r *meetingResolver) Organizers(ctx context.Context, obj *models.Meeting) ([]PublicProfile, error) {
 	if obj == nil {
 		return nil, nil
@@ -152,25 +152,74 @@ func (r *meetingResolver) Organizers(ctx context.Context, obj *models.Meeting) (
 	return getPublicProfiles(ctx, users), nil
 }
 
-// Meetings resolves the `meetings` query by getting a list of meetings that have an
-// end date in the future
-func (r *queryResolver) Meetings(ctx context.Context, endAfter, endBefore, startAfter, startBefore *string) ([]models.Meeting, error) {
+// Announcements resolves the `announcements` property of the meeting query
+func (r *meetingResolver) Announcements(ctx context.Context, obj *models.Meeting) ([]models.MeetingAnnouncement,
+	error) {
+
+	if obj == nil {
+		return nil, nil
+	}
+	announcements, err := obj.Announcements(domain.GetBuffaloContext(ctx))
+	if err != nil {
+		return nil, domain.ReportError(ctx, err, "GetMeetingAnnouncements")
+	}
+	return announcements, nil
+}
+
+// Meetings resolves the `meetings` query by getting one page of meetings that match the given filters
+func (r *queryResolver) Meetings(ctx context.Context, endAfter, endBefore, startAfter, startBefore, searchText *string,
+	location *LocationInput, page, perPage *int) ([]models.Meeting, error) {
+
+	filter := models.MeetingFilterParams{
+		SearchText: searchText,
+		Location:   convertOptionalLocation(location),
+	}
+
+	dates := []struct {
+		input  *string
+		filter **time.Time
+	}{
+		{endAfter, &filter.EndAfter},
+		{endBefore, &filter.EndBefore},
+		{startAfter, &filter.StartAfter},
+		{startBefore, &filter.StartBefore},
+	}
+	for _, d := range dates {
+		date, err := convertOptionalDate(d.input)
+		if err != nil {
+			return nil, domain.ReportError(ctx, err, "GetMeetings.Date")
+		}
+		if date.Valid {
+			t := date.Time
+			*d.filter = &t
+		}
+	}
+
+	p, pp := getPagination(page, perPage)
 	meetings := models.Meetings{}
-	if err := meetings.FindOnOrAfterDate(time.Now()); err != nil {
-		extras := map[string]interface{}{}
-		return nil, domain.ReportError(ctx, err, "GetMeetings", extras)
+	if err := meetings.FindByFilter(models.CurrentUser(ctx), filter, p, pp); err != nil {
+		return nil, domain.ReportError(ctx, err, "GetMeetings")
 	}
 
 	return meetings, nil
 }
 
-// RecentMeetings resolves the `recentMeetings` query by getting a list of meetings that have an
-// end date in the last <domain.RecentMeetingDelay> time period
+// recentMeetingsDays is the number of days before today in which the meetings listed by `recentMeetings` ended
+const recentMeetingsDays = 30
+
+// RecentMeetings resolves the deprecated `recentMeetings` query by getting the meetings that ended in the past
+// recentMeetingsDays days
 func (r *queryResolver) RecentMeetings(ctx context.Context) ([]models.Meeting, error) {
+	today := time.Now()
+	endAfter := today.AddDate(0, 0, -recentMeetingsDays-1)
+	filter := models.MeetingFilterParams{
+		EndAfter:  &endAfter,
+		EndBefore: &today,
+	}
+
 	meetings := models.Meetings{}
-	if err := meetings.FindRecent(time.Now()); err != nil {
-		extras := map[string]interface{}{}
-		return nil, domain.ReportError(ctx, err, "GetRecentMeetings", extras)
+	if err := meetings.FindByFilter(models.CurrentUser(ctx), filter, 1, domain.MaxPageSize); err != nil {
+		return nil, domain.ReportError(ctx, err, "GetRecentMeetings")
 	}
 
 	return meetings, nil
@@ -187,6 +236,15 @@ func (r *queryResolver) Meeting(ctx context.Context, id *string) (*models.Meetin
 		return &models.Meeting{}, domain.ReportError(ctx, err, "GetMeeting", extras)
 	}
 
+	cUser := models.CurrentUser(ctx)
+	if !cUser.CanViewMeeting(domain.GetBuffaloContext(ctx), meeting) {
+		extras := map[string]interface{}{
+			"user": cUser.UUID,
+		}
+		return &models.Meeting{}, domain.ReportError(ctx, errors.New("meeting is not visible to user"),
+			"GetMeeting", extras)
+	}
+
 	return &meeting, nil
 }
 
@@ -202,9 +260,18 @@ func convertGqlMeetingInputToDBMeeting(ctx context.Context, input meetingInput,
 		}
 	} else {
 		meeting.CreatedByID = currentUser.ID
+
+		orgs, err := currentUser.GetOrganizations()
+		if err != nil {
+			return meeting, err
+		}
+		if len(orgs) > 0 {
+			meeting.OrganizationID = nulls.NewInt(orgs[0].ID)
+		}
 	}
 
 	setStringField(input.Name, &meeting.Name)
+	meeting.Visibility = input.Visibility
 	meeting.Description = models.ConvertStringPtrToNullsString(input.Description)
 	meeting.MoreInfoURL = models.ConvertStringPtrToNullsString(input.MoreInfoURL)
 
@@ -247,7 +314,7 @@ type meetingInput struct {
 	MoreInfoURL *string
 	ImageFileID *string
 	Location    *LocationInput
-	Visibility  MeetingVisibility
+	Visibility  models.MeetingVisibility
 }
 
 // CreateMeeting resolves the `createMeeting` mutation.
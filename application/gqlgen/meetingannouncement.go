@@ -0,0 +1,88 @@
+package gqlgen
+
+import (
+	"context"
+	"errors"
+
+	"github.com/silinternational/wecarry-api/dataloader"
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/models"
+)
+
+// MeetingAnnouncement returns the meeting announcement resolver. It is required by GraphQL
+func (r *Resolver) MeetingAnnouncement() MeetingAnnouncementResolver {
+	return &meetingAnnouncementResolver{r}
+}
+
+type meetingAnnouncementResolver struct{ *Resolver }
+
+// ID resolves the `id` property of the meeting announcement query
+func (r *meetingAnnouncementResolver) ID(ctx context.Context, obj *models.MeetingAnnouncement) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+	return obj.UUID.String(), nil
+}
+
+// Meeting resolves the `meeting` property of the meeting announcement query
+func (r *meetingAnnouncementResolver) Meeting(ctx context.Context, obj *models.MeetingAnnouncement) (*models.Meeting,
+	error) {
+
+	if obj == nil {
+		return &models.Meeting{}, nil
+	}
+
+	meeting, err := obj.Meeting()
+	if err != nil {
+		return &models.Meeting{}, domain.ReportError(ctx, err, "GetMeetingAnnouncementMeeting")
+	}
+
+	return &meeting, nil
+}
+
+// Author resolves the `author` property of the meeting announcement query
+func (r *meetingAnnouncementResolver) Author(ctx context.Context, obj *models.MeetingAnnouncement) (*PublicProfile,
+	error) {
+
+	if obj == nil {
+		return &PublicProfile{}, nil
+	}
+
+	author, err := dataloader.For(ctx).UsersByID.Load(obj.AuthorID)
+	if err != nil {
+		return &PublicProfile{}, domain.ReportError(ctx, err, "GetMeetingAnnouncementAuthor")
+	}
+
+	return getPublicProfile(ctx, author), nil
+}
+
+// CreateMeetingAnnouncement implements the `createMeetingAnnouncement` mutation
+func (r *mutationResolver) CreateMeetingAnnouncement(ctx context.Context, input CreateMeetingAnnouncementInput) (
+	*models.MeetingAnnouncement, error) {
+
+	cUser := models.CurrentUser(ctx)
+	extras := map[string]interface{}{
+		"user": cUser.UUID,
+	}
+
+	var meeting models.Meeting
+	if err := meeting.FindByUUID(input.MeetingID); err != nil {
+		return nil, domain.ReportError(ctx, err, "CreateMeetingAnnouncement.FindMeeting", extras)
+	}
+
+	if !cUser.CanCreateMeetingAnnouncement(domain.GetBuffaloContext(ctx), meeting) {
+		err := errors.New("insufficient permissions")
+		return nil, domain.ReportError(ctx, err, "CreateMeetingAnnouncement.Unauthorized", extras)
+	}
+
+	announcement := models.MeetingAnnouncement{
+		MeetingID: meeting.ID,
+		AuthorID:  cUser.ID,
+		Content:   input.Content,
+	}
+	if err := announcement.Create(); err != nil {
+		return nil, domain.ReportError(ctx, err, "CreateMeetingAnnouncement", extras)
+	}
+
+	return &announcement, nil
+}
@@ -0,0 +1,108 @@
+package gqlgen
+
+import (
+	"context"
+	"errors"
+
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/models"
+)
+
+// MeetingHandoff returns the meeting handoff resolver. It is required by GraphQL
+func (r *Resolver) MeetingHandoff() MeetingHandoffResolver {
+	return &meetingHandoffResolver{r}
+}
+
+type meetingHandoffResolver struct{ *Resolver }
+
+// Provider resolves the `provider` property of the meeting handoff query
+func (r *meetingHandoffResolver) Provider(ctx context.Context, obj *models.MeetingHandoff) (*PublicProfile, error) {
+	if obj == nil {
+		return &PublicProfile{}, nil
+	}
+	return getPublicProfile(ctx, &obj.Provider), nil
+}
+
+// Receiver resolves the `receiver` property of the meeting handoff query
+func (r *meetingHandoffResolver) Receiver(ctx context.Context, obj *models.MeetingHandoff) (*PublicProfile, error) {
+	if obj == nil {
+		return &PublicProfile{}, nil
+	}
+	return getPublicProfile(ctx, &obj.Receiver), nil
+}
+
+// Requests resolves the `requests` property of the meeting handoff query
+func (r *meetingHandoffResolver) Requests(ctx context.Context, obj *models.MeetingHandoff) ([]models.Request, error) {
+	if obj == nil {
+		return nil, nil
+	}
+	return obj.Requests, nil
+}
+
+// MeetingExchange resolves the `meetingExchange` query
+func (r *queryResolver) MeetingExchange(ctx context.Context, meetingID string) ([]models.MeetingHandoff, error) {
+	cUser := models.CurrentUser(ctx)
+	extras := map[string]interface{}{
+		"user": cUser.UUID,
+	}
+
+	var meeting models.Meeting
+	if err := meeting.FindByUUID(meetingID); err != nil {
+		return nil, domain.ReportError(ctx, err, "GetMeetingExchange.FindMeeting", extras)
+	}
+
+	if !cUser.CanManageMeetingExchange(domain.GetBuffaloContext(ctx), meeting) {
+		err := errors.New("insufficient permissions")
+		return nil, domain.ReportError(ctx, err, "GetMeetingExchange.Unauthorized", extras)
+	}
+
+	handoffs, err := meeting.Handoffs()
+	if err != nil {
+		return nil, domain.ReportError(ctx, err, "GetMeetingExchange", extras)
+	}
+
+	return handoffs, nil
+}
+
+// CompleteMeetingHandoffs implements the `completeMeetingHandoffs` mutation
+func (r *mutationResolver) CompleteMeetingHandoffs(ctx context.Context, input CompleteMeetingHandoffsInput) (
+	[]MeetingHandoffResult, error) {
+
+	cUser := models.CurrentUser(ctx)
+	extras := map[string]interface{}{
+		"user": cUser.UUID,
+	}
+
+	var meeting models.Meeting
+	if err := meeting.FindByUUID(input.MeetingID); err != nil {
+		return nil, domain.ReportError(ctx, err, "CompleteMeetingHandoffs.FindMeeting", extras)
+	}
+
+	if !cUser.CanManageMeetingExchange(domain.GetBuffaloContext(ctx), meeting) {
+		err := errors.New("insufficient permissions")
+		return nil, domain.ReportError(ctx, err, "CompleteMeetingHandoffs.Unauthorized", extras)
+	}
+
+	results := make([]MeetingHandoffResult, len(input.Codes))
+	for i, code := range input.Codes {
+		results[i].Code = code
+
+		request, err := meeting.CompleteHandoff(code)
+		if err == nil {
+			results[i].Request = &request
+			continue
+		}
+
+		key := "CompleteMeetingHandoffs"
+		switch err {
+		case models.ErrHandoffCodeNotFound:
+			key = "CompleteMeetingHandoffs.CodeNotFound"
+		case models.ErrHandoffNotPending:
+			key = "CompleteMeetingHandoffs.NotPending"
+		}
+		msg := domain.ReportError(ctx, err, key, extras).Error()
+		results[i].Error = &msg
+	}
+
+	return results, nil
+}
@@ -11,13 +11,34 @@ import (
 	"github.com/silinternational/wecarry-api/models"
 )
 
+// Input object for `completeMeetingHandoffs`
+type CompleteMeetingHandoffsInput struct {
+	// ID of the `Meeting`
+	MeetingID string `json:"meetingID"`
+	// Handoff codes of the requests, as scanned or typed. Case, spaces and dashes are ignored.
+	Codes []string `json:"codes"`
+}
+
+type ConfirmPhoneCodeInput struct {
+	// verification code received by text message
+	Code string `json:"code"`
+}
+
+// Input object for `createMeetingAnnouncement`
+type CreateMeetingAnnouncementInput struct {
+	// ID of the `Meeting`
+	MeetingID string `json:"meetingID"`
+	// announcement content
+	Content string `json:"content"`
+}
+
 // Input object for `createMeetingInvites`
 type CreateMeetingInvitesInput struct {
 	// ID of the `Meeting`
 	MeetingID string `json:"meetingID"`
 	// Email addresses of the invitees. Duplicate values are ignored.
 	Emails []string `json:"emails"`
-	// NOT YET IMPLEMENTED -- Send email invites. Default is 'false', do not send any emails.
+	// Email an invitation to each invitee that has not been sent one. Default is `true`.
 	SendEmail *bool `json:"sendEmail"`
 }
 
@@ -28,7 +49,7 @@ type CreateMeetingParticipantInput struct {
 	// Secret code from the `MeetingInvite` or invite code from the `Meeting`. If the `Meeting` is not `INVITE_ONLY`,
 	// the code may be omitted.
 	Code *string `json:"code"`
-	// NOT YET IMPLEMENTED -- Add as a `Meeting` Organizer. Authenticated `User` must be authorized [definition TBD] to do this.
+	// Ignored. Use `addMeetingOrganizer` to make a participant an organizer of the `Meeting`.
 	IsOrganizer *bool `json:"isOrganizer"`
 }
 
@@ -73,6 +94,24 @@ type CreateOrganizationTrustInput struct {
 	SecondaryID string `json:"secondaryID"`
 }
 
+type CreateOrganizationWebhookInput struct {
+	// ID of the Organization that owns the webhook
+	OrganizationID string `json:"organizationID"`
+	// https URL to which events are posted, limited to 1024 characters
+	URL string `json:"url"`
+	// events to send to the webhook, at least one
+	Events []models.WebhookEvent `json:"events"`
+}
+
+type CreatePushSubscriptionInput struct {
+	// push service URL, from the browser's `PushSubscription.endpoint`. Only the push services of the supported browsers are accepted.
+	Endpoint string `json:"endpoint"`
+	// user agent public key (URL-safe base64), from the browser's `PushSubscription.getKey('p256dh')`
+	P256dh string `json:"p256dh"`
+	// authentication secret (URL-safe base64), from the browser's `PushSubscription.getKey('auth')`
+	Auth string `json:"auth"`
+}
+
 // Specify a Geographic location
 type LocationInput struct {
 	// Human-friendly description, e.g. 'Los Angeles, CA, USA'
@@ -85,6 +124,43 @@ type LocationInput struct {
 	Longitude *float64 `json:"longitude"`
 }
 
+type MarkNotificationsReadInput struct {
+	// IDs of the notifications to mark as read. If omitted or empty, all notifications are marked as read.
+	Ids []string `json:"ids"`
+}
+
+// Result of completing the handoff of one code given to `completeMeetingHandoffs`
+type MeetingHandoffResult struct {
+	// The handoff code as given in the input
+	Code string `json:"code"`
+	// The completed request, or null if the handoff could not be completed
+	Request *models.Request `json:"request"`
+	// Reason the handoff could not be completed, or null on success
+	Error *string `json:"error"`
+}
+
+// Input object for `addMeetingOrganizer` and `removeMeetingOrganizer`
+type MeetingOrganizerInput struct {
+	// ID of the `Meeting`
+	MeetingID string `json:"meetingID"`
+	// ID of the `User` to add or remove as an organizer
+	UserID string `json:"userID"`
+}
+
+type NotificationPreference struct {
+	// type of event
+	Event NotificationEvent `json:"event"`
+	// channel by which the user is notified of the event
+	Channel NotificationChannel `json:"channel"`
+}
+
+type NotificationPreferenceInput struct {
+	// type of event
+	Event NotificationEvent `json:"event"`
+	// channel by which the user is to be notified of the event
+	Channel NotificationChannel `json:"channel"`
+}
+
 // User fields that can safely be visible to any user in the system
 type PublicProfile struct {
 	// unique identifier for the User, the same value as in the `User` type
@@ -125,11 +201,34 @@ type RemoveOrganizationTrustInput struct {
 	SecondaryID string `json:"secondaryID"`
 }
 
+type RemoveOrganizationWebhookInput struct {
+	// unique identifier for the webhook to be removed
+	ID string `json:"id"`
+}
+
+type RemovePushSubscriptionInput struct {
+	// push service URL of the subscription to remove
+	Endpoint string `json:"endpoint"`
+}
+
 type RemoveWatchInput struct {
 	// unique identifier for the Watch to be removed
 	ID string `json:"id"`
 }
 
+// Input object for `resendMeetingInvite`
+type ResendMeetingInviteInput struct {
+	// ID of the `Meeting`
+	MeetingID string `json:"meetingID"`
+	// Email address of the invitee
+	Email string `json:"email"`
+}
+
+type SendPhoneCodeInput struct {
+	// mobile phone number in E.164 format, e.g. +15555550100
+	PhoneNumber string `json:"phoneNumber"`
+}
+
 type SetThreadLastViewedAtInput struct {
 	ThreadID string    `json:"threadID"`
 	Time     time.Time `json:"time"`
@@ -150,6 +249,17 @@ type UpdateOrganizationInput struct {
 	LogoFileID *string `json:"logoFileID"`
 }
 
+type UpdateOrganizationWebhookInput struct {
+	// unique identifier for the webhook to be updated
+	ID string `json:"id"`
+	// https URL to which events are posted. If omitted, the URL is not changed.
+	URL *string `json:"url"`
+	// events to send to the webhook. If omitted, the events are not changed.
+	Events []models.WebhookEvent `json:"events"`
+	// deliveries are suspended while the webhook is inactive. If omitted, the active state is not changed.
+	Active *bool `json:"active"`
+}
+
 type UpdateRequestStatusInput struct {
 	// ID of the request to update
 	ID string `json:"id"`
@@ -171,6 +281,9 @@ type UpdateUserInput struct {
 	Location *LocationInput `json:"location"`
 	// New user preferences. If `null` no changes are made.
 	Preferences *UpdateUserPreferencesInput `json:"preferences"`
+	// New notification preferences. If `null` no changes are made. Types of events not included in the list are left
+	// unchanged.
+	NotificationPreferences []NotificationPreferenceInput `json:"notificationPreferences"`
 }
 
 type UpdateUserPreferencesInput struct {
@@ -180,55 +293,193 @@ type UpdateUserPreferencesInput struct {
 	TimeZone *string `json:"timeZone"`
 	// weight unit-- if omitted, the preference is set to the App default
 	WeightUnit *PreferredWeightUnit `json:"weightUnit"`
+	// digest frequency -- if omitted, the preference is set to the App default (IMMEDIATE)
+	Digest *DigestFrequency `json:"digest"`
+	// start of the quiet hours in 24-hour HH:MM format, e.g. 22:00 -- if omitted, or if `quietHoursEnd` is
+	// omitted, there are no quiet hours
+	QuietHoursStart *string `json:"quietHoursStart"`
+	// end of the quiet hours in 24-hour HH:MM format, e.g. 07:00
+	QuietHoursEnd *string `json:"quietHoursEnd"`
 }
 
-// Visibility for Meetings (Events), determines who can see a `Meeting`.
-type MeetingVisibility string
+// How often a user is notified of new requests
+type DigestFrequency string
 
 const (
-	// Visible to invitees and all app users
-	MeetingVisibilityAll MeetingVisibility = "ALL"
-	// Visible to invitees and members of the `Meeting` organization and affiliated organizations
-	MeetingVisibilityTrusted MeetingVisibility = "TRUSTED"
-	// Visible to invitees and members of the `Meeting` organization
-	MeetingVisibilityOrganization MeetingVisibility = "ORGANIZATION"
-	// Visible only to invitees
-	MeetingVisibilityInviteOnly MeetingVisibility = "INVITE_ONLY"
+	// one notification for each new request
+	DigestFrequencyImmediate DigestFrequency = "IMMEDIATE"
+	// one summary of new requests each day
+	DigestFrequencyDaily DigestFrequency = "DAILY"
+	// one summary of new requests each week
+	DigestFrequencyWeekly DigestFrequency = "WEEKLY"
 )
 
-var AllMeetingVisibility = []MeetingVisibility{
-	MeetingVisibilityAll,
-	MeetingVisibilityTrusted,
-	MeetingVisibilityOrganization,
-	MeetingVisibilityInviteOnly,
+var AllDigestFrequency = []DigestFrequency{
+	DigestFrequencyImmediate,
+	DigestFrequencyDaily,
+	DigestFrequencyWeekly,
 }
 
-func (e MeetingVisibility) IsValid() bool {
+func (e DigestFrequency) IsValid() bool {
+	switch e {
+	case DigestFrequencyImmediate, DigestFrequencyDaily, DigestFrequencyWeekly:
+		return true
+	}
+	return false
+}
+
+func (e DigestFrequency) String() string {
+	return string(e)
+}
+
+func (e *DigestFrequency) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DigestFrequency(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DigestFrequency", str)
+	}
+	return nil
+}
+
+func (e DigestFrequency) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Channel by which a user is notified of an event
+type NotificationChannel string
+
+const (
+	// notify by email
+	NotificationChannelEmail NotificationChannel = "EMAIL"
+	// notify by mobile text message, if the user has a verified phone number and the event has a text version, otherwise
+	// by email
+	NotificationChannelMobile NotificationChannel = "MOBILE"
+	// notify only within the app
+	NotificationChannelInApp NotificationChannel = "IN_APP"
+	// notify by Web Push notification to the user's subscribed browsers
+	NotificationChannelPush NotificationChannel = "PUSH"
+	// do not notify
+	NotificationChannelNone NotificationChannel = "NONE"
+)
+
+var AllNotificationChannel = []NotificationChannel{
+	NotificationChannelEmail,
+	NotificationChannelMobile,
+	NotificationChannelInApp,
+	NotificationChannelPush,
+	NotificationChannelNone,
+}
+
+func (e NotificationChannel) IsValid() bool {
+	switch e {
+	case NotificationChannelEmail, NotificationChannelMobile, NotificationChannelInApp, NotificationChannelPush, NotificationChannelNone:
+		return true
+	}
+	return false
+}
+
+func (e NotificationChannel) String() string {
+	return string(e)
+}
+
+func (e *NotificationChannel) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotificationChannel(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotificationChannel", str)
+	}
+	return nil
+}
+
+func (e NotificationChannel) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Types of events for which a user can choose a notification channel
+type NotificationEvent string
+
+const (
+	// a new request near the user's location
+	NotificationEventNewRequest NotificationEvent = "NEW_REQUEST"
+	// a new request matching one of the user's watches
+	NotificationEventWatchMatch NotificationEvent = "WATCH_MATCH"
+	// a new message in one of the user's threads
+	NotificationEventNewMessage NotificationEvent = "NEW_MESSAGE"
+	// an offer to fulfill one of the user's requests
+	NotificationEventOfferReceived NotificationEvent = "OFFER_RECEIVED"
+	// the user's offer was not accepted
+	NotificationEventOfferRejected NotificationEvent = "OFFER_REJECTED"
+	// an offer to fulfill one of the user's requests was withdrawn
+	NotificationEventOfferRetracted NotificationEvent = "OFFER_RETRACTED"
+	// the user's offer was accepted
+	NotificationEventRequestAccepted NotificationEvent = "REQUEST_ACCEPTED"
+	// the user's request was delivered
+	NotificationEventRequestDelivered NotificationEvent = "REQUEST_DELIVERED"
+	// a request delivered by the user was received
+	NotificationEventRequestReceived NotificationEvent = "REQUEST_RECEIVED"
+	// a delivery of the user's request was undone
+	NotificationEventRequestNotDelivered NotificationEvent = "REQUEST_NOT_DELIVERED"
+	// a request delivered by the user was marked as not received
+	NotificationEventRequestNotReceived NotificationEvent = "REQUEST_NOT_RECEIVED"
+	// a request the user accepted was reopened
+	NotificationEventRequestReopened NotificationEvent = "REQUEST_REOPENED"
+	// a request the user accepted was removed
+	NotificationEventRequestRemoved NotificationEvent = "REQUEST_REMOVED"
+	// an organizer posted an announcement to a meeting the user participates in
+	NotificationEventMeetingAnnouncement NotificationEvent = "MEETING_ANNOUNCEMENT"
+)
+
+var AllNotificationEvent = []NotificationEvent{
+	NotificationEventNewRequest,
+	NotificationEventWatchMatch,
+	NotificationEventNewMessage,
+	NotificationEventOfferReceived,
+	NotificationEventOfferRejected,
+	NotificationEventOfferRetracted,
+	NotificationEventRequestAccepted,
+	NotificationEventRequestDelivered,
+	NotificationEventRequestReceived,
+	NotificationEventRequestNotDelivered,
+	NotificationEventRequestNotReceived,
+	NotificationEventRequestReopened,
+	NotificationEventRequestRemoved,
+	NotificationEventMeetingAnnouncement,
+}
+
+func (e NotificationEvent) IsValid() bool {
 	switch e {
-	case MeetingVisibilityAll, MeetingVisibilityTrusted, MeetingVisibilityOrganization, MeetingVisibilityInviteOnly:
+	case NotificationEventNewRequest, NotificationEventWatchMatch, NotificationEventNewMessage, NotificationEventOfferReceived, NotificationEventOfferRejected, NotificationEventOfferRetracted, NotificationEventRequestAccepted, NotificationEventRequestDelivered, NotificationEventRequestReceived, NotificationEventRequestNotDelivered, NotificationEventRequestNotReceived, NotificationEventRequestReopened, NotificationEventRequestRemoved, NotificationEventMeetingAnnouncement:
 		return true
 	}
 	return false
 }
 
-func (e MeetingVisibility) String() string {
+func (e NotificationEvent) String() string {
 	return string(e)
 }
 
-func (e *MeetingVisibility) UnmarshalGQL(v interface{}) error {
+func (e *NotificationEvent) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = MeetingVisibility(str)
+	*e = NotificationEvent(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid MeetingVisibility", str)
+		return fmt.Errorf("%s is not a valid NotificationEvent", str)
 	}
 	return nil
 }
 
-func (e MeetingVisibility) MarshalGQL(w io.Writer) {
+func (e NotificationEvent) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
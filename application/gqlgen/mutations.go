@@ -266,17 +266,24 @@ func (r *mutationResolver) CreateMeetingInvites(ctx context.Context, input Creat
 		return nil, domain.ReportError(ctx, err, "CreateMeetingInvite.Unauthorized")
 	}
 
-	inv := models.MeetingInvite{
-		MeetingID: m.ID,
-		InviterID: cUser.ID,
-	}
+	sendEmail := input.SendEmail == nil || *input.SendEmail
 
 	badEmails := make([]string, 0)
 	for _, email := range input.Emails {
-		inv.Email = email
+		inv := models.MeetingInvite{
+			MeetingID: m.ID,
+			InviterID: cUser.ID,
+			Email:     email,
+		}
 		if err := inv.Create(); err != nil {
 			badEmails = append(badEmails, email)
 			domain.ErrLogger.Printf("error creating meeting invite for email '%s', %s", email, err)
+			continue
+		}
+		if sendEmail && inv.SendCount == 0 {
+			if err := inv.Send(); err != nil {
+				domain.ErrLogger.Printf("error sending meeting invite for email '%s', %s", email, err)
+			}
 		}
 	}
 	if len(badEmails) > 0 {
@@ -315,6 +322,44 @@ func (r *mutationResolver) RemoveMeetingInvite(ctx context.Context, input Remove
 	return invites, nil
 }
 
+// ResendMeetingInvite implements the `resendMeetingInvite` mutation
+func (r *mutationResolver) ResendMeetingInvite(ctx context.Context, input ResendMeetingInviteInput) (
+	*models.MeetingInvite, error) {
+
+	cUser := models.CurrentUser(ctx)
+	extras := map[string]interface{}{
+		"user": cUser.UUID,
+	}
+
+	var meeting models.Meeting
+	if err := meeting.FindByUUID(input.MeetingID); err != nil {
+		return nil, domain.ReportError(ctx, err, "ResendMeetingInvite.FindMeeting", extras)
+	}
+
+	c := domain.GetBuffaloContext(ctx)
+	if !cUser.CanCreateMeetingInvite(c, meeting) {
+		err := errors.New("insufficient permissions")
+		return nil, domain.ReportError(ctx, err, "ResendMeetingInvite.Unauthorized", extras)
+	}
+
+	var invite models.MeetingInvite
+	if err := invite.FindByMeetingIDAndEmail(meeting.ID, input.Email); err != nil {
+		return nil, domain.ReportError(ctx, err, "ResendMeetingInvite.NotFound", extras)
+	}
+
+	if err := invite.Send(); err != nil {
+		switch err {
+		case models.ErrMeetingInviteRateLimited:
+			return nil, domain.ReportError(ctx, err, "ResendMeetingInvite.RateLimited", extras)
+		case models.ErrMeetingInviteBounced:
+			return nil, domain.ReportError(ctx, err, "ResendMeetingInvite.Bounced", extras)
+		}
+		return nil, domain.ReportError(ctx, err, "ResendMeetingInvite", extras)
+	}
+
+	return &invite, nil
+}
+
 // CreateMeetingParticipant implements the `createMeetingParticipant` mutation
 func (r *mutationResolver) CreateMeetingParticipant(ctx context.Context, input CreateMeetingParticipantInput) (
 	*models.MeetingParticipant, error) {
@@ -355,3 +400,79 @@ func (r *mutationResolver) RemoveMeetingParticipant(ctx context.Context, input R
 	}
 	return participants, nil
 }
+
+// AddMeetingOrganizer implements the `addMeetingOrganizer` mutation
+func (r *mutationResolver) AddMeetingOrganizer(ctx context.Context, input MeetingOrganizerInput) ([]PublicProfile, error) {
+	cUser := models.CurrentUser(ctx)
+	extras := map[string]interface{}{
+		"user": cUser.UUID,
+	}
+
+	var meeting models.Meeting
+	if err := meeting.FindByUUID(input.MeetingID); err != nil {
+		return nil, domain.ReportError(ctx, err, "AddMeetingOrganizer.FindMeeting", extras)
+	}
+
+	c := domain.GetBuffaloContext(ctx)
+	if !cUser.CanManageMeetingOrganizers(c, meeting) {
+		err := errors.New("insufficient permissions")
+		return nil, domain.ReportError(ctx, err, "AddMeetingOrganizer.Unauthorized", extras)
+	}
+
+	var user models.User
+	if err := user.FindByUUID(input.UserID); err != nil {
+		return nil, domain.ReportError(ctx, err, "AddMeetingOrganizer.FindUser", extras)
+	}
+
+	if err := meeting.AddOrganizer(c, user, cUser); err != nil {
+		if err == models.ErrMeetingNotVisible {
+			return nil, domain.ReportError(ctx, err, "AddMeetingOrganizer.NotVisible", extras)
+		}
+		return nil, domain.ReportError(ctx, err, "AddMeetingOrganizer", extras)
+	}
+
+	organizers, err := meeting.Organizers(c)
+	if err != nil {
+		return nil, domain.ReportError(ctx, err, "AddMeetingOrganizer.ListOrganizers", extras)
+	}
+	return getPublicProfiles(ctx, organizers), nil
+}
+
+// RemoveMeetingOrganizer implements the `removeMeetingOrganizer` mutation
+func (r *mutationResolver) RemoveMeetingOrganizer(ctx context.Context, input MeetingOrganizerInput) ([]PublicProfile,
+	error) {
+
+	cUser := models.CurrentUser(ctx)
+	extras := map[string]interface{}{
+		"user": cUser.UUID,
+	}
+
+	var meeting models.Meeting
+	if err := meeting.FindByUUID(input.MeetingID); err != nil {
+		return nil, domain.ReportError(ctx, err, "RemoveMeetingOrganizer.FindMeeting", extras)
+	}
+
+	c := domain.GetBuffaloContext(ctx)
+	if !cUser.CanManageMeetingOrganizers(c, meeting) {
+		err := errors.New("insufficient permissions")
+		return nil, domain.ReportError(ctx, err, "RemoveMeetingOrganizer.Unauthorized", extras)
+	}
+
+	var user models.User
+	if err := user.FindByUUID(input.UserID); err != nil {
+		return nil, domain.ReportError(ctx, err, "RemoveMeetingOrganizer.FindUser", extras)
+	}
+
+	if err := meeting.RemoveOrganizer(user); err != nil {
+		if err == models.ErrLastMeetingOrganizer {
+			return nil, domain.ReportError(ctx, err, "RemoveMeetingOrganizer.LastOrganizer", extras)
+		}
+		return nil, domain.ReportError(ctx, err, "RemoveMeetingOrganizer", extras)
+	}
+
+	organizers, err := meeting.Organizers(c)
+	if err != nil {
+		return nil, domain.ReportError(ctx, err, "RemoveMeetingOrganizer.ListOrganizers", extras)
+	}
+	return getPublicProfiles(ctx, organizers), nil
+}
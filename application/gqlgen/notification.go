@@ -0,0 +1,127 @@
+package gqlgen
+
+import (
+	"context"
+	"time"
+
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/models"
+)
+
+// Notification returns the notification resolver. It is required by GraphQL
+func (r *Resolver) Notification() NotificationResolver {
+	return &notificationResolver{r}
+}
+
+type notificationResolver struct{ *Resolver }
+
+// ID resolves the `ID` property of the notification query. It provides the UUID instead of the autoincrement ID.
+func (r *notificationResolver) ID(ctx context.Context, obj *models.Notification) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+	return obj.UUID.String(), nil
+}
+
+// Request resolves the `request` property of the notification query
+func (r *notificationResolver) Request(ctx context.Context, obj *models.Notification) (*models.Request, error) {
+	if obj == nil {
+		return nil, nil
+	}
+
+	request, err := obj.GetRequest()
+	if err != nil {
+		return nil, domain.ReportError(ctx, err, "GetNotificationRequest")
+	}
+
+	return request, nil
+}
+
+// Thread resolves the `thread` property of the notification query
+func (r *notificationResolver) Thread(ctx context.Context, obj *models.Notification) (*models.Thread, error) {
+	if obj == nil {
+		return nil, nil
+	}
+
+	thread, err := obj.GetThread()
+	if err != nil {
+		return nil, domain.ReportError(ctx, err, "GetNotificationThread")
+	}
+
+	return thread, nil
+}
+
+// Meeting resolves the `meeting` property of the notification query
+func (r *notificationResolver) Meeting(ctx context.Context, obj *models.Notification) (*models.Meeting, error) {
+	if obj == nil {
+		return nil, nil
+	}
+
+	meeting, err := obj.GetMeeting()
+	if err != nil {
+		return nil, domain.ReportError(ctx, err, "GetNotificationMeeting")
+	}
+
+	return meeting, nil
+}
+
+// ReadAt resolves the `readAt` property of the notification query
+func (r *notificationResolver) ReadAt(ctx context.Context, obj *models.Notification) (*time.Time, error) {
+	if obj == nil || !obj.ReadAt.Valid {
+		return nil, nil
+	}
+
+	return &obj.ReadAt.Time, nil
+}
+
+// MyNotifications resolves the `myNotifications` query by getting one page of the current user's notifications
+func (r *queryResolver) MyNotifications(ctx context.Context, page *int, perPage *int, unreadOnly *bool) ([]models.Notification, error) {
+	currentUser := models.CurrentUser(ctx)
+	p, pp := getPagination(page, perPage)
+
+	notifications := models.Notifications{}
+	if err := notifications.FindByUser(currentUser, unreadOnly != nil && *unreadOnly, p, pp); err != nil {
+		extras := map[string]interface{}{
+			"user": currentUser.UUID,
+		}
+		return nil, domain.ReportError(ctx, err, "MyNotifications", extras)
+	}
+
+	return notifications, nil
+}
+
+// UnreadNotificationCount resolves the `unreadNotificationCount` query
+func (r *queryResolver) UnreadNotificationCount(ctx context.Context) (int, error) {
+	currentUser := models.CurrentUser(ctx)
+
+	var notifications models.Notifications
+	count, err := notifications.UnreadCount(currentUser)
+	if err != nil {
+		extras := map[string]interface{}{
+			"user": currentUser.UUID,
+		}
+		return 0, domain.ReportError(ctx, err, "UnreadNotificationCount", extras)
+	}
+
+	return count, nil
+}
+
+// MarkNotificationsRead marks the current user's notifications as read and returns the number that remain unread
+func (r *mutationResolver) MarkNotificationsRead(ctx context.Context, input MarkNotificationsReadInput) (int, error) {
+	currentUser := models.CurrentUser(ctx)
+	extras := map[string]interface{}{
+		"user": currentUser.UUID,
+	}
+
+	var notifications models.Notifications
+	if err := notifications.MarkRead(currentUser, input.Ids); err != nil {
+		return 0, domain.ReportError(ctx, err, "MarkNotificationsRead", extras)
+	}
+
+	count, err := notifications.UnreadCount(currentUser)
+	if err != nil {
+		return 0, domain.ReportError(ctx, err, "UnreadNotificationCount", extras)
+	}
+
+	return count, nil
+}
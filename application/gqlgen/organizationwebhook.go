@@ -0,0 +1,227 @@
+package gqlgen
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gobuffalo/nulls"
+
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/models"
+)
+
+// OrganizationWebhook returns the organization webhook resolver. It is required by GraphQL
+func (r *Resolver) OrganizationWebhook() OrganizationWebhookResolver {
+	return &organizationWebhookResolver{r}
+}
+
+type organizationWebhookResolver struct{ *Resolver }
+
+// ID resolves the `ID` property of the webhook. It provides the UUID instead of the autoincrement ID.
+func (r *organizationWebhookResolver) ID(ctx context.Context, obj *models.OrganizationWebhook) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+	return obj.UUID.String(), nil
+}
+
+// Events resolves the `events` property of the webhook
+func (r *organizationWebhookResolver) Events(ctx context.Context, obj *models.OrganizationWebhook) (
+	[]models.WebhookEvent, error) {
+
+	if obj == nil {
+		return nil, nil
+	}
+	return obj.GetEvents(), nil
+}
+
+// Deliveries resolves the `deliveries` property of the webhook, getting one page of its delivery log. Access to the
+// webhook is authorized by the Organization `webhooks` resolver and the webhook mutations.
+func (r *organizationWebhookResolver) Deliveries(ctx context.Context, obj *models.OrganizationWebhook,
+	status *models.WebhookDeliveryStatus, page *int, perPage *int) ([]models.WebhookDelivery, error) {
+
+	if obj == nil {
+		return nil, nil
+	}
+
+	p, pp := getPagination(page, perPage)
+	deliveries, err := obj.Deliveries(status, p, pp)
+	if err != nil {
+		return nil, domain.ReportError(ctx, err, "GetOrganizationWebhookDeliveries")
+	}
+
+	return deliveries, nil
+}
+
+// WebhookDelivery returns the webhook delivery resolver. It is required by GraphQL
+func (r *Resolver) WebhookDelivery() WebhookDeliveryResolver {
+	return &webhookDeliveryResolver{r}
+}
+
+type webhookDeliveryResolver struct{ *Resolver }
+
+// ID resolves the `ID` property of the webhook delivery. It provides the UUID instead of the autoincrement ID.
+func (r *webhookDeliveryResolver) ID(ctx context.Context, obj *models.WebhookDelivery) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+	return obj.UUID.String(), nil
+}
+
+// ResponseStatus resolves the `responseStatus` property of the webhook delivery
+func (r *webhookDeliveryResolver) ResponseStatus(ctx context.Context, obj *models.WebhookDelivery) (*int, error) {
+	if obj == nil || !obj.ResponseStatus.Valid {
+		return nil, nil
+	}
+	return &obj.ResponseStatus.Int, nil
+}
+
+// LastError resolves the `lastError` property of the webhook delivery
+func (r *webhookDeliveryResolver) LastError(ctx context.Context, obj *models.WebhookDelivery) (*string, error) {
+	if obj == nil {
+		return nil, nil
+	}
+	return models.GetStringFromNullsString(obj.LastError), nil
+}
+
+// DeliveredAt resolves the `deliveredAt` property of the webhook delivery
+func (r *webhookDeliveryResolver) DeliveredAt(ctx context.Context, obj *models.WebhookDelivery) (*time.Time, error) {
+	if obj == nil || !obj.DeliveredAt.Valid {
+		return nil, nil
+	}
+	return &obj.DeliveredAt.Time, nil
+}
+
+// Webhooks resolves the `webhooks` property of the organization. Only users who can edit the organization are
+// authorized, since the webhooks include their signing secrets.
+func (r *organizationResolver) Webhooks(ctx context.Context, obj *models.Organization) (
+	[]models.OrganizationWebhook, error) {
+
+	if obj == nil {
+		return nil, nil
+	}
+
+	cUser := models.CurrentUser(ctx)
+	if !cUser.CanEditOrganization(obj.ID) {
+		extras := map[string]interface{}{
+			"user": cUser.UUID,
+		}
+		err := errors.New("insufficient permissions")
+		return nil, domain.ReportError(ctx, err, "GetOrganizationWebhooks.Unauthorized", extras)
+	}
+
+	webhooks, err := obj.Webhooks()
+	if err != nil {
+		return nil, domain.ReportError(ctx, err, "GetOrganizationWebhooks")
+	}
+
+	return webhooks, nil
+}
+
+// CreateOrganizationWebhook is the resolver for the `createOrganizationWebhook` mutation
+func (r *mutationResolver) CreateOrganizationWebhook(ctx context.Context, input CreateOrganizationWebhookInput) (
+	*models.OrganizationWebhook, error) {
+
+	cUser := models.CurrentUser(ctx)
+	extras := map[string]interface{}{
+		"user": cUser.UUID,
+	}
+
+	var org models.Organization
+	if err := org.FindByUUID(input.OrganizationID); err != nil {
+		return nil, domain.ReportError(ctx, err, "CreateOrganizationWebhook.NotFound", extras)
+	}
+
+	if !cUser.CanEditOrganization(org.ID) {
+		err := errors.New("insufficient permissions")
+		return nil, domain.ReportError(ctx, err, "CreateOrganizationWebhook.Unauthorized", extras)
+	}
+
+	webhook := models.OrganizationWebhook{
+		OrganizationID: org.ID,
+		URL:            input.URL,
+		Active:         true,
+		CreatedByID:    nulls.NewInt(cUser.ID),
+	}
+	webhook.SetEvents(input.Events)
+
+	if err := webhook.Create(); err != nil {
+		return nil, domain.ReportError(ctx, err, "CreateOrganizationWebhook", extras)
+	}
+
+	return &webhook, nil
+}
+
+// UpdateOrganizationWebhook is the resolver for the `updateOrganizationWebhook` mutation
+func (r *mutationResolver) UpdateOrganizationWebhook(ctx context.Context, input UpdateOrganizationWebhookInput) (
+	*models.OrganizationWebhook, error) {
+
+	cUser := models.CurrentUser(ctx)
+	extras := map[string]interface{}{
+		"user": cUser.UUID,
+	}
+
+	var webhook models.OrganizationWebhook
+	if err := webhook.FindByUUID(input.ID); err != nil {
+		return nil, domain.ReportError(ctx, err, "UpdateOrganizationWebhook.NotFound", extras)
+	}
+
+	if !cUser.CanEditOrganization(webhook.OrganizationID) {
+		err := errors.New("insufficient permissions")
+		return nil, domain.ReportError(ctx, err, "UpdateOrganizationWebhook.Unauthorized", extras)
+	}
+
+	if input.URL != nil {
+		webhook.URL = *input.URL
+	}
+	if input.Events != nil {
+		webhook.SetEvents(input.Events)
+	}
+	if input.Active != nil {
+		webhook.Active = *input.Active
+	}
+
+	if err := webhook.Update(); err != nil {
+		return nil, domain.ReportError(ctx, err, "UpdateOrganizationWebhook", extras)
+	}
+
+	return &webhook, nil
+}
+
+// RemoveOrganizationWebhook is the resolver for the `removeOrganizationWebhook` mutation
+func (r *mutationResolver) RemoveOrganizationWebhook(ctx context.Context, input RemoveOrganizationWebhookInput) (
+	[]models.OrganizationWebhook, error) {
+
+	cUser := models.CurrentUser(ctx)
+	extras := map[string]interface{}{
+		"user": cUser.UUID,
+	}
+
+	var webhook models.OrganizationWebhook
+	if err := webhook.FindByUUID(input.ID); err != nil {
+		return nil, domain.ReportError(ctx, err, "RemoveOrganizationWebhook.NotFound", extras)
+	}
+
+	if !cUser.CanEditOrganization(webhook.OrganizationID) {
+		err := errors.New("insufficient permissions")
+		return nil, domain.ReportError(ctx, err, "RemoveOrganizationWebhook.Unauthorized", extras)
+	}
+
+	if err := webhook.Destroy(); err != nil {
+		return nil, domain.ReportError(ctx, err, "RemoveOrganizationWebhook", extras)
+	}
+
+	org, err := webhook.GetOrganization()
+	if err != nil {
+		return nil, domain.ReportError(ctx, err, "RemoveOrganizationWebhook", extras)
+	}
+
+	webhooks, err := org.Webhooks()
+	if err != nil {
+		// don't return an error since the remove operation succeeded
+		_ = domain.ReportError(ctx, err, "", extras)
+	}
+
+	return webhooks, nil
+}
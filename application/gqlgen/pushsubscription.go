@@ -0,0 +1,73 @@
+package gqlgen
+
+import (
+	"context"
+	"errors"
+
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/models"
+)
+
+// PushSubscription returns the push subscription resolver. It is required by GraphQL
+func (r *Resolver) PushSubscription() PushSubscriptionResolver {
+	return &pushSubscriptionResolver{r}
+}
+
+type pushSubscriptionResolver struct{ *Resolver }
+
+// ID resolves the `ID` property of the push subscription query. It provides the UUID instead of the autoincrement ID.
+func (r *pushSubscriptionResolver) ID(ctx context.Context, obj *models.PushSubscription) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+	return obj.UUID.String(), nil
+}
+
+// VapidPublicKey resolves the `vapidPublicKey` query
+func (r *queryResolver) VapidPublicKey(ctx context.Context) (string, error) {
+	return domain.Env.VapidPublicKey, nil
+}
+
+// CreatePushSubscription registers a browser's push subscription for the current user
+func (r *mutationResolver) CreatePushSubscription(ctx context.Context, input CreatePushSubscriptionInput) (*models.PushSubscription, error) {
+	currentUser := models.CurrentUser(ctx)
+	extras := map[string]interface{}{
+		"user": currentUser.UUID,
+	}
+
+	var subscription models.PushSubscription
+	if err := subscription.Register(currentUser, input.Endpoint, input.P256dh, input.Auth); err != nil {
+		return nil, domain.ReportError(ctx, err, "CreatePushSubscription", extras)
+	}
+
+	return &subscription, nil
+}
+
+// RemovePushSubscription removes one of the current user's push subscriptions and returns the remaining subscriptions
+func (r *mutationResolver) RemovePushSubscription(ctx context.Context, input RemovePushSubscriptionInput) ([]models.PushSubscription, error) {
+	currentUser := models.CurrentUser(ctx)
+	extras := map[string]interface{}{
+		"user": currentUser.UUID,
+	}
+
+	var subscription models.PushSubscription
+	if err := subscription.FindByEndpoint(input.Endpoint); err != nil {
+		return nil, domain.ReportError(ctx, err, "RemovePushSubscription.NotFound", extras)
+	}
+
+	if subscription.UserID != currentUser.ID {
+		err := errors.New("user attempted to remove another user's push subscription")
+		return nil, domain.ReportError(ctx, err, "RemovePushSubscription.NotFound", extras)
+	}
+
+	if err := subscription.Destroy(); err != nil {
+		return nil, domain.ReportError(ctx, err, "RemovePushSubscription", extras)
+	}
+
+	var subscriptions models.PushSubscriptions
+	if err := subscriptions.FindByUser(currentUser); err != nil {
+		return nil, domain.ReportError(ctx, err, "MyPushSubscriptions", extras)
+	}
+
+	return subscriptions, nil
+}
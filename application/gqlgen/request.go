@@ -271,6 +271,32 @@ func (r *requestResolver) Meeting(ctx context.Context, obj *models.Request) (*mo
 	return meeting, nil
 }
 
+// HandoffCode resolves the `handoffCode` property of the request query, generating a code if the request has none
+func (r *requestResolver) HandoffCode(ctx context.Context, obj *models.Request) (*string, error) {
+	if obj == nil {
+		return nil, nil
+	}
+
+	if !obj.MeetingID.Valid {
+		return nil, nil
+	}
+
+	if obj.Status != models.RequestStatusAccepted && obj.Status != models.RequestStatusDelivered {
+		return nil, nil
+	}
+
+	cUser := models.CurrentUser(ctx)
+	if !cUser.CanViewRequestHandoffCode(*obj) {
+		return nil, nil
+	}
+
+	code, err := obj.GetHandoffCode()
+	if err != nil {
+		return nil, domain.ReportError(ctx, err, "GetRequestHandoffCode")
+	}
+	return &code, nil
+}
+
 // IsEditable indicates whether the user is allowed to edit the request
 func (r *requestResolver) IsEditable(ctx context.Context, obj *models.Request) (bool, error) {
 	if obj == nil {
@@ -4,6 +4,7 @@ package gqlgen
 
 import (
 	"context"
+	"time"
 
 	"github.com/silinternational/wecarry-api/domain"
 	"github.com/silinternational/wecarry-api/models"
@@ -38,6 +39,15 @@ func (m *meetingInviteResolver) AvatarURL(ctx context.Context, obj *models.Meeti
 	return obj.AvatarURL(), nil
 }
 
+// SentAt resolves the `sentAt` property of the MeetingInvite query
+func (m *meetingInviteResolver) SentAt(ctx context.Context, obj *models.MeetingInvite) (*time.Time, error) {
+	if obj == nil || !obj.SentAt.Valid {
+		return nil, nil
+	}
+
+	return &obj.SentAt.Time, nil
+}
+
 func (m *meetingInviteResolver) Meeting(ctx context.Context, obj *models.MeetingInvite) (*models.Meeting, error) {
 	if obj == nil {
 		return nil, nil
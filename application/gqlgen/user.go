@@ -140,6 +140,47 @@ func (r *userResolver) Preferences(ctx context.Context, obj *models.User) (*mode
 	return &standardPrefs, nil
 }
 
+// NotificationPreferences resolves the `notificationPreferences` property of the user query
+func (r *userResolver) NotificationPreferences(ctx context.Context, obj *models.User) ([]NotificationPreference, error) {
+	if obj == nil {
+		return nil, nil
+	}
+
+	prefs, err := obj.GetNotificationPreferences()
+	if err != nil {
+		return nil, domain.ReportError(ctx, err, "GetUserNotificationPreferences")
+	}
+
+	return convertNotificationPreferences(prefs), nil
+}
+
+// PhoneNumber resolves the `phoneNumber` property of the user query
+func (r *userResolver) PhoneNumber(ctx context.Context, obj *models.User) (*string, error) {
+	if obj == nil {
+		return nil, nil
+	}
+
+	return models.GetStringFromNullsString(obj.PhoneNumber), nil
+}
+
+// EmailProblem resolves the `emailProblem` property of the user query, flagging a user whose email address bounced or
+// complained
+func (r *userResolver) EmailProblem(ctx context.Context, obj *models.User) (*models.EmailSuppressionReason, error) {
+	if obj == nil {
+		return nil, nil
+	}
+
+	suppression, err := obj.GetEmailSuppression()
+	if err != nil {
+		return nil, domain.ReportError(ctx, err, "GetUserEmailProblem")
+	}
+	if suppression == nil {
+		return nil, nil
+	}
+
+	return &suppression.Reason, nil
+}
+
 // Users retrieves a list of users
 func (r *queryResolver) Users(ctx context.Context) ([]models.User, error) {
 	currentUser := models.CurrentUser(ctx)
@@ -245,6 +286,13 @@ func (r *mutationResolver) UpdateUser(ctx context.Context, input UpdateUserInput
 		}
 	}
 
+	if input.NotificationPreferences != nil {
+		prefs := convertNotificationPreferencesInput(input.NotificationPreferences)
+		if _, err = user.UpdateNotificationPreferences(prefs); err != nil {
+			return &models.User{}, domain.ReportError(ctx, err, "UpdateUser.NotificationPreferences")
+		}
+	}
+
 	if err = user.Save(); err != nil {
 		if strings.Contains(err.Error(), "Nickname must have a visible character") {
 			return &models.User{}, domain.ReportError(ctx, err, "UpdateUser.InvisibleNickname")
@@ -305,3 +353,58 @@ func (u userPreferencesResolver) WeightUnit(ctx context.Context, obj *models.Sta
 	unit := PreferredWeightUnit(strings.ToUpper(obj.WeightUnit))
 	return &unit, nil
 }
+
+func (u userPreferencesResolver) Digest(ctx context.Context, obj *models.StandardPreferences) (*DigestFrequency, error) {
+	if obj.Digest == "" {
+		return nil, nil
+	}
+	digest := DigestFrequency(strings.ToUpper(obj.Digest))
+	return &digest, nil
+}
+
+// SendPhoneCode sends a verification code to a new phone number for the current user
+func (r *mutationResolver) SendPhoneCode(ctx context.Context, input SendPhoneCodeInput) (*models.User, error) {
+	user := models.CurrentUser(ctx)
+	extras := map[string]interface{}{
+		"user": user.UUID,
+	}
+
+	if !domain.IsPhoneNumberValid(input.PhoneNumber) {
+		err := errors.New("invalid phone number " + input.PhoneNumber)
+		return nil, domain.ReportError(ctx, err, "SendPhoneCode.InvalidPhoneNumber", extras)
+	}
+
+	if err := user.SendPhoneCode(input.PhoneNumber); err != nil {
+		return nil, domain.ReportError(ctx, err, "SendPhoneCode", extras)
+	}
+
+	return &user, nil
+}
+
+// ConfirmPhoneCode confirms the verification code sent to the current user and saves the new phone number
+func (r *mutationResolver) ConfirmPhoneCode(ctx context.Context, input ConfirmPhoneCodeInput) (*models.User, error) {
+	user := models.CurrentUser(ctx)
+	extras := map[string]interface{}{
+		"user": user.UUID,
+	}
+
+	if err := user.ConfirmPhoneCode(input.Code); err != nil {
+		return nil, domain.ReportError(ctx, err, "ConfirmPhoneCode", extras)
+	}
+
+	return &user, nil
+}
+
+// RemovePhoneNumber removes the current user's phone number
+func (r *mutationResolver) RemovePhoneNumber(ctx context.Context) (*models.User, error) {
+	user := models.CurrentUser(ctx)
+	extras := map[string]interface{}{
+		"user": user.UUID,
+	}
+
+	if err := user.RemovePhoneNumber(); err != nil {
+		return nil, domain.ReportError(ctx, err, "RemovePhoneNumber", extras)
+	}
+
+	return &user, nil
+}
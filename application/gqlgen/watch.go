@@ -3,6 +3,7 @@ package gqlgen
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/gobuffalo/nulls"
 
@@ -79,6 +80,88 @@ func (r *watchResolver) SearchText(ctx context.Context, obj *models.Watch) (*str
 	return &obj.SearchText.String, nil
 }
 
+// Radius resolves the `radius` property of the watch query
+func (r *watchResolver) Radius(ctx context.Context, obj *models.Watch) (*int, error) {
+	if obj == nil || !obj.RadiusKm.Valid {
+		return nil, nil
+	}
+
+	return &obj.RadiusKm.Int, nil
+}
+
+// NeededAfter resolves the `neededAfter` property of the watch query, converting a nulls.Time to a *string
+func (r *watchResolver) NeededAfter(ctx context.Context, obj *models.Watch) (*string, error) {
+	if obj == nil {
+		return nil, nil
+	}
+
+	return models.GetStringFromNullsTime(obj.NeededAfter), nil
+}
+
+// NeededBefore resolves the `neededBefore` property of the watch query, converting a nulls.Time to a *string
+func (r *watchResolver) NeededBefore(ctx context.Context, obj *models.Watch) (*string, error) {
+	if obj == nil {
+		return nil, nil
+	}
+
+	return models.GetStringFromNullsTime(obj.NeededBefore), nil
+}
+
+// Kilograms resolves the `kilograms` property of the watch query as a pointer to a float64
+func (r *watchResolver) Kilograms(ctx context.Context, obj *models.Watch) (*float64, error) {
+	if obj == nil || !obj.Kilograms.Valid {
+		return nil, nil
+	}
+
+	return &obj.Kilograms.Float64, nil
+}
+
+// ExpiresOn resolves the `expiresOn` property of the watch query, converting a nulls.Time to a *string
+func (r *watchResolver) ExpiresOn(ctx context.Context, obj *models.Watch) (*string, error) {
+	if obj == nil {
+		return nil, nil
+	}
+
+	return models.GetStringFromNullsTime(obj.ExpiresOn), nil
+}
+
+// IsActive resolves the `isActive` property of the watch query
+func (r *watchResolver) IsActive(ctx context.Context, obj *models.Watch) (bool, error) {
+	if obj == nil {
+		return false, nil
+	}
+
+	return obj.IsActive(time.Now()), nil
+}
+
+// MatchingRequests resolves the `matchingRequests` property of the watch query by getting one page of the open
+// requests that match the watch. Only the watch owner is authorized.
+func (r *watchResolver) MatchingRequests(ctx context.Context, obj *models.Watch, page *int,
+	perPage *int) ([]models.Request, error) {
+
+	if obj == nil {
+		return nil, nil
+	}
+
+	currentUser := models.CurrentUser(ctx)
+	extras := map[string]interface{}{
+		"user":  currentUser.UUID,
+		"watch": obj.UUID,
+	}
+	if currentUser.ID != obj.OwnerID {
+		return nil, domain.ReportError(ctx, errors.New("user attempted to get matches of non-owned Watch"),
+			"GetWatchMatchingRequests.NotFound", extras)
+	}
+
+	p, pp := getPagination(page, perPage)
+	var requests models.Requests
+	if err := requests.FindByWatch(ctx, *obj, p, pp); err != nil {
+		return nil, domain.ReportError(ctx, err, "GetWatchMatchingRequests", extras)
+	}
+
+	return requests, nil
+}
+
 // MyWatches resolves the `myWatches` query by getting a list of Watches owned by the current user
 func (r *queryResolver) MyWatches(ctx context.Context) ([]models.Watch, error) {
 	watches := models.Watches{}
@@ -117,6 +200,35 @@ func convertWatchInput(ctx context.Context, input watchInput, currentUser models
 		watch.Size = &s
 	}
 
+	if input.Radius == nil {
+		watch.RadiusKm = nulls.Int{}
+	} else {
+		watch.RadiusKm = nulls.NewInt(*input.Radius)
+	}
+
+	if input.LocationMatch == nil {
+		watch.LocationMatch = models.WatchLocationMatchNear
+	} else {
+		watch.LocationMatch = *input.LocationMatch
+	}
+
+	var err error
+	if watch.NeededAfter, err = convertOptionalDate(input.NeededAfter); err != nil {
+		return watch, err
+	}
+	if watch.NeededBefore, err = convertOptionalDate(input.NeededBefore); err != nil {
+		return watch, err
+	}
+	setOptionalFloatField(input.Kilograms, &watch.Kilograms)
+
+	if watch.ExpiresOn, err = convertOptionalDate(input.ExpiresOn); err != nil {
+		return watch, err
+	}
+	// a watch that is updated with a later expiration date, or none, is active again
+	if !watch.IsExpired(time.Now()) {
+		watch.DeactivatedAt = nulls.Time{}
+	}
+
 	if input.MeetingID == nil || *input.MeetingID == "" {
 		watch.MeetingID = nulls.Int{}
 	} else {
@@ -131,13 +243,20 @@ func convertWatchInput(ctx context.Context, input watchInput, currentUser models
 }
 
 type watchInput struct {
-	ID          *string
-	Name        string
-	Destination *LocationInput
-	Origin      *LocationInput
-	MeetingID   *string
-	SearchText  *string
-	Size        *models.RequestSize
+	ID            *string
+	Name          string
+	Destination   *LocationInput
+	Origin        *LocationInput
+	MeetingID     *string
+	SearchText    *string
+	Size          *models.RequestSize
+	Radius        *int
+	LocationMatch *models.WatchLocationMatch
+	NeededAfter   *string
+	NeededBefore  *string
+	Kilograms     *float64
+	ExpiresOn     *string
+	SendSummary   *bool
 }
 
 // CreateWatch resolves the `createWatch` mutation.
@@ -172,6 +291,10 @@ func (r *mutationResolver) CreateWatch(ctx context.Context, input watchInput) (*
 		return &models.Watch{}, domain.ReportError(ctx, err, "CreateWatch", extras)
 	}
 
+	if input.SendSummary != nil && *input.SendSummary {
+		watch.SendSummary()
+	}
+
 	return &watch, nil
 }
 
@@ -3,18 +3,24 @@ package job
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/gobuffalo/buffalo/worker"
 	"github.com/silinternational/wecarry-api/domain"
 	"github.com/silinternational/wecarry-api/models"
 	"github.com/silinternational/wecarry-api/notifications"
+	"github.com/silinternational/wecarry-api/webhooks"
 )
 
 const (
 	NewThreadMessage = "new_thread_message"
 	FileCleanup      = "file_cleanup"
 	TokenCleanup     = "token_cleanup"
+	Digest           = "digest"
+	Outbox           = "outbox"
+	Webhooks         = "webhooks"
+	WatchExpiration  = "watch_expiration"
 )
 
 var w worker.Worker
@@ -23,6 +29,10 @@ var handlers = map[string]func(worker.Args) error{
 	NewThreadMessage: newThreadMessageHandler,
 	FileCleanup:      fileCleanupHandler,
 	TokenCleanup:     tokenCleanupHandler,
+	Digest:           digestHandler,
+	Outbox:           outboxHandler,
+	Webhooks:         webhooksHandler,
+	WatchExpiration:  watchExpirationHandler,
 }
 
 func init() {
@@ -61,9 +71,12 @@ func newThreadMessageHandler(args worker.Args) error {
 			"requestTitle":   requestTitle,
 			"messageContent": m.Content,
 			"sentByNickname": m.SentBy.Nickname,
+			"sentAt":         m.CreatedAt,
 			"threadURL":      domain.GetThreadUIURL(m.Thread.UUID.String()),
 		},
 		FromEmail: domain.EmailFromAddress(&m.SentBy.Nickname),
+		RequestID: m.Thread.RequestID,
+		ThreadID:  m.ThreadID,
 	}
 
 	var lastErr error
@@ -85,8 +98,10 @@ func newThreadMessageHandler(args worker.Args) error {
 
 		msg.ToName = p.GetRealName()
 		msg.ToEmail = p.Email
-		msg.Subject = domain.GetTranslatedSubject(p.GetLanguagePreference(),
-			"Email.Subject.Message.Created",
+		msg.ToUserID = p.ID
+		msg.Language = p.GetLanguagePreference()
+		msg.IdempotencyKey = fmt.Sprintf("%s:%d:%d", template, m.ID, p.ID)
+		msg.Subject = notifications.TranslateSubject(msg.Language, template,
 			map[string]string{"sentByNickname": m.SentBy.Nickname, "requestTitle": requestTitle})
 
 		if err := notifications.Send(msg); err != nil {
@@ -125,6 +140,208 @@ func tokenCleanupHandler(args worker.Args) error {
 	return nil
 }
 
+// outboxHandler retries delivery of queued emails and delivers the notifications held for quiet hours
+func outboxHandler(args worker.Args) error {
+	sent, failed, err := notifications.DeliverOutbox()
+	if err != nil {
+		return fmt.Errorf("outbox delivery failed with error, %s", err)
+	}
+
+	if sent > 0 || failed > 0 {
+		domain.Logger.Printf("Delivered %d queued messages, %d failed", sent, failed)
+	}
+	return nil
+}
+
+// webhooksHandler retries delivery of queued webhook payloads
+func webhooksHandler(args worker.Args) error {
+	sent, failed, err := webhooks.DeliverDue()
+	if err != nil {
+		return fmt.Errorf("webhook delivery failed with error, %s", err)
+	}
+
+	if sent > 0 || failed > 0 {
+		domain.Logger.Printf("Delivered %d queued webhook payloads, %d failed", sent, failed)
+	}
+	return nil
+}
+
+// watchExpirationHandler deactivates the watches that have expired and notifies their owners
+func watchExpirationHandler(args worker.Args) error {
+	var watches models.Watches
+	if err := watches.FindExpired(time.Now()); err != nil {
+		return fmt.Errorf("failed to find expired watches, %s", err)
+	}
+
+	var lastErr error
+	for i := range watches {
+		if err := watches[i].Deactivate(); err != nil {
+			domain.ErrLogger.Printf("error deactivating watch %s, %s", watches[i].UUID, err)
+			lastErr = err
+			continue
+		}
+
+		if err := sendWatchExpired(watches[i]); err != nil {
+			domain.ErrLogger.Printf("error sending watch %s expiration notification, %s", watches[i].UUID, err)
+			lastErr = err
+		}
+	}
+
+	if len(watches) > 0 {
+		domain.Logger.Printf("Deactivated %d expired watches", len(watches))
+	}
+	return lastErr
+}
+
+// sendWatchExpired tells the owner of a watch that it has expired
+func sendWatchExpired(watch models.Watch) error {
+	owner, err := watch.GetOwner()
+	if err != nil {
+		return err
+	}
+
+	language := owner.GetLanguagePreference()
+	template := domain.MessageTemplateWatchExpired
+	msg := notifications.Message{
+		Template: template,
+		Data: map[string]interface{}{
+			"appName":        domain.Env.AppName,
+			"uiURL":          domain.Env.UIURL,
+			"watchName":      watch.Name,
+			"watchExpiresOn": watch.ExpiresOn.Time.Format(domain.DateFormat),
+		},
+		ToName:    owner.GetRealName(),
+		ToEmail:   owner.Email,
+		ToUserID:  owner.ID,
+		Language:  language,
+		FromEmail: domain.EmailFromAddress(nil),
+		Subject: notifications.TranslateSubject(language, template,
+			map[string]string{"watchName": watch.Name}),
+		IdempotencyKey: fmt.Sprintf("%s:%d:%s", template, watch.ID, watch.ExpiresOn.Time.Format(domain.DateFormat)),
+	}
+
+	return notifications.Send(msg)
+}
+
+// digestRequest is the data for one request in a digest message
+type digestRequest struct {
+	Title       string
+	URL         string
+	Destination string
+	WatchMatch  bool
+}
+
+// digestHandler sends the queued new request notifications to each user whose daily or weekly digest is due
+func digestHandler(args worker.Args) error {
+	var users models.Users
+	if err := users.FindWithDigestItems(); err != nil {
+		return fmt.Errorf("failed to find users with digest items, %s", err)
+	}
+
+	now := time.Now()
+	var lastErr error
+	for _, user := range users {
+		prefs, err := user.GetPreferences()
+		if err != nil {
+			domain.ErrLogger.Printf("digestHandler error, %s", err)
+			lastErr = err
+			continue
+		}
+
+		if !isDigestDue(prefs, now) {
+			continue
+		}
+
+		if err := sendDigest(user, prefs); err != nil {
+			domain.ErrLogger.Printf("error sending digest to user %s, %s", user.UUID, err)
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// isDigestDue determines whether a user's digest is due at the given time. Digests are sent at DigestHour in the
+// user's time zone, either daily or on DigestWeekday. Items queued before the user switched back to immediate
+// notifications are sent right away.
+func isDigestDue(prefs models.StandardPreferences, t time.Time) bool {
+	if prefs.Digest == "" || prefs.Digest == domain.UserPreferenceDigestImmediate {
+		return true
+	}
+
+	localTime := t.In(prefs.GetLocation())
+	if localTime.Hour() != domain.DigestHour {
+		return false
+	}
+
+	if prefs.Digest == domain.UserPreferenceDigestWeekly {
+		return localTime.Weekday() == domain.DigestWeekday
+	}
+
+	return true
+}
+
+// sendDigest sends one message listing all of the open requests queued for the user's digest, then removes the
+// queued items
+func sendDigest(user models.User, prefs models.StandardPreferences) error {
+	var items models.DigestItems
+	if err := items.FindByUser(user); err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	requests := make([]digestRequest, 0, len(items))
+	for _, item := range items {
+		if item.Request.Status != models.RequestStatusOpen {
+			continue
+		}
+
+		destination := ""
+		if dest, err := item.Request.GetDestination(); err == nil && dest != nil {
+			destination = dest.Description
+		}
+
+		requests = append(requests, digestRequest{
+			Title:       item.Request.Title,
+			URL:         domain.GetRequestUIURL(item.Request.UUID.String()),
+			Destination: destination,
+			WatchMatch:  item.PreferenceKey == domain.UserPreferenceKeyNotifyWatchMatch,
+		})
+	}
+
+	if len(requests) > 0 {
+		language := prefs.Language
+		if language == "" {
+			language = domain.UserPreferenceLanguageEnglish
+		}
+
+		msg := notifications.Message{
+			Template: domain.MessageTemplateDigest,
+			Data: map[string]interface{}{
+				"appName":  domain.Env.AppName,
+				"uiURL":    domain.Env.UIURL,
+				"requests": requests,
+			},
+			ToName:    user.GetRealName(),
+			ToEmail:   user.Email,
+			ToUserID:  user.ID,
+			Language:  language,
+			FromEmail: domain.EmailFromAddress(nil),
+			Subject: notifications.TranslateSubject(language, domain.MessageTemplateDigest,
+				map[string]string{"count": strconv.Itoa(len(requests))}),
+			IdempotencyKey: fmt.Sprintf("%s:%d:%d", domain.MessageTemplateDigest, user.ID, items[len(items)-1].ID),
+		}
+
+		if err := notifications.Send(msg); err != nil {
+			return err
+		}
+	}
+
+	return items.RemoveForUser(user, items[len(items)-1].ID)
+}
+
 // SubmitDelayed enqueues a new Worker job for the given handler. Arguments can be provided in `args`.
 func SubmitDelayed(handler string, delay time.Duration, args map[string]interface{}) error {
 	job := worker.Job{
@@ -3,6 +3,8 @@ package job
 import (
 	"time"
 
+	"github.com/gobuffalo/nulls"
+
 	"github.com/silinternational/wecarry-api/domain"
 	"github.com/silinternational/wecarry-api/internal/test"
 	"github.com/silinternational/wecarry-api/models"
@@ -176,3 +178,89 @@ func CreateFixtures_TestNewThreadMessageHandler(js *JobSuite) MessageFixtures {
 		Threads:  threads,
 	}
 }
+
+type DigestFixtures struct {
+	models.Users
+	models.Requests
+}
+
+func CreateFixtures_TestDigestHandler(js *JobSuite) DigestFixtures {
+	users := test.CreateUserFixtures(js.DB, 3).Users
+	requests := test.CreateRequestFixtures(js.DB, 2, false)
+
+	// User2's digest is not due at the time the test is run
+	timeZone := "UTC"
+	if time.Now().UTC().Hour() == domain.DigestHour {
+		timeZone = "Etc/GMT+1"
+	}
+
+	preferences := models.UserPreferences{
+		{UserID: users[2].ID, Key: domain.UserPreferenceKeyDigest, Value: domain.UserPreferenceDigestDaily},
+		{UserID: users[2].ID, Key: domain.UserPreferenceKeyTimeZone, Value: timeZone},
+	}
+	for i := range preferences {
+		preferences[i].UUID = domain.GetUUID()
+		createFixture(js, &preferences[i])
+	}
+
+	items := models.DigestItems{
+		{UserID: users[1].ID, RequestID: requests[0].ID, PreferenceKey: domain.UserPreferenceKeyNotifyNewRequest},
+		{UserID: users[1].ID, RequestID: requests[1].ID, PreferenceKey: domain.UserPreferenceKeyNotifyWatchMatch},
+		{UserID: users[2].ID, RequestID: requests[0].ID, PreferenceKey: domain.UserPreferenceKeyNotifyNewRequest},
+	}
+	for i := range items {
+		createFixture(js, &items[i])
+	}
+
+	return DigestFixtures{Users: users, Requests: requests}
+}
+
+type WatchExpirationFixtures struct {
+	models.Users
+	models.Watches
+}
+
+func CreateFixtures_TestWatchExpirationHandler(js *JobSuite) WatchExpirationFixtures {
+	users := test.CreateUserFixtures(js.DB, 2).Users
+
+	yesterday := time.Now().UTC().AddDate(0, 0, -1)
+	watches := models.Watches{
+		{OwnerID: users[0].ID, Name: "expired", ExpiresOn: nulls.NewTime(yesterday)},
+		{OwnerID: users[1].ID, Name: "expires today", ExpiresOn: nulls.NewTime(time.Now().UTC())},
+	}
+	for i := range watches {
+		watches[i].UUID = domain.GetUUID()
+		createFixture(js, &watches[i])
+	}
+
+	return WatchExpirationFixtures{Users: users, Watches: watches}
+}
+
+// CreateFixtures_TestOutboxHandler creates an expired watch owned by a user who is in their quiet hours at the time
+// the test is run
+func CreateFixtures_TestOutboxHandler(js *JobSuite) WatchExpirationFixtures {
+	users := test.CreateUserFixtures(js.DB, 1).Users
+
+	now := time.Now().UTC()
+	preferences := models.UserPreferences{
+		{UserID: users[0].ID, Key: domain.UserPreferenceKeyTimeZone, Value: "UTC"},
+		{UserID: users[0].ID, Key: domain.UserPreferenceKeyQuietHoursStart,
+			Value: now.Add(-time.Hour).Format(domain.QuietHoursTimeFormat)},
+		{UserID: users[0].ID, Key: domain.UserPreferenceKeyQuietHoursEnd,
+			Value: now.Add(time.Hour).Format(domain.QuietHoursTimeFormat)},
+	}
+	for i := range preferences {
+		preferences[i].UUID = domain.GetUUID()
+		createFixture(js, &preferences[i])
+	}
+
+	watches := models.Watches{
+		{OwnerID: users[0].ID, Name: "expired", ExpiresOn: nulls.NewTime(now.AddDate(0, 0, -1))},
+	}
+	for i := range watches {
+		watches[i].UUID = domain.GetUUID()
+		createFixture(js, &watches[i])
+	}
+
+	return WatchExpirationFixtures{Users: users, Watches: watches}
+}
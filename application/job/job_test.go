@@ -114,3 +114,141 @@ func (js *JobSuite) TestSubmitDelayed() {
 	errLog := buf.String()
 	js.Equal("", errLog, "Got an unexpected error log entry")
 }
+
+func (js *JobSuite) TestIsDigestDue() {
+	monday := time.Date(2020, 4, 13, domain.DigestHour, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		prefs models.StandardPreferences
+		t     time.Time
+		want  bool
+	}{
+		{
+			name:  "no preference",
+			prefs: models.StandardPreferences{},
+			t:     monday.Add(time.Hour),
+			want:  true,
+		},
+		{
+			name:  "immediate",
+			prefs: models.StandardPreferences{Digest: domain.UserPreferenceDigestImmediate},
+			t:     monday.Add(time.Hour),
+			want:  true,
+		},
+		{
+			name:  "daily, at the digest hour",
+			prefs: models.StandardPreferences{Digest: domain.UserPreferenceDigestDaily},
+			t:     monday.Add(domain.DurationDay),
+			want:  true,
+		},
+		{
+			name:  "daily, not at the digest hour",
+			prefs: models.StandardPreferences{Digest: domain.UserPreferenceDigestDaily},
+			t:     monday.Add(time.Hour),
+			want:  false,
+		},
+		{
+			name:  "daily, not at the digest hour in the user's time zone",
+			prefs: models.StandardPreferences{Digest: domain.UserPreferenceDigestDaily, TimeZone: "America/New_York"},
+			t:     monday,
+			want:  false,
+		},
+		{
+			name:  "daily, at the digest hour in the user's time zone",
+			prefs: models.StandardPreferences{Digest: domain.UserPreferenceDigestDaily, TimeZone: "America/New_York"},
+			t:     monday.Add(4 * time.Hour),
+			want:  true,
+		},
+		{
+			name:  "weekly, on the digest day",
+			prefs: models.StandardPreferences{Digest: domain.UserPreferenceDigestWeekly},
+			t:     monday,
+			want:  true,
+		},
+		{
+			name:  "weekly, not on the digest day",
+			prefs: models.StandardPreferences{Digest: domain.UserPreferenceDigestWeekly},
+			t:     monday.Add(domain.DurationDay),
+			want:  false,
+		},
+	}
+	for _, test := range tests {
+		js.T().Run(test.name, func(t *testing.T) {
+			js.Equal(test.want, isDigestDue(test.prefs, test.t))
+		})
+	}
+}
+
+func (js *JobSuite) TestDigestHandler() {
+	f := CreateFixtures_TestDigestHandler(js)
+
+	notifications.TestEmailService.DeleteSentMessages()
+
+	js.NoError(digestHandler(nil))
+
+	emails := notifications.TestEmailService.GetSentMessages()
+	js.Equal(1, len(emails), "incorrect number of digests sent")
+	if len(emails) == 1 {
+		js.Equal(f.Users[1].Email, emails[0].ToEmail, "digest sent to the wrong user")
+	}
+
+	var items models.DigestItems
+	js.NoError(items.FindByUser(f.Users[1]))
+	js.Equal(0, len(items), "sent digest items were not removed")
+
+	js.NoError(items.FindByUser(f.Users[2]))
+	js.Equal(1, len(items), "digest items were removed before the digest was due")
+}
+
+func (js *JobSuite) TestWatchExpirationHandler() {
+	f := CreateFixtures_TestWatchExpirationHandler(js)
+
+	notifications.TestEmailService.DeleteSentMessages()
+
+	js.NoError(watchExpirationHandler(nil))
+
+	emails := notifications.TestEmailService.GetSentMessages()
+	js.Equal(1, len(emails), "incorrect number of expiration notifications sent")
+	if len(emails) == 1 {
+		js.Equal(f.Users[0].Email, emails[0].ToEmail, "expiration notification sent to the wrong user")
+	}
+
+	var watch models.Watch
+	js.NoError(watch.FindByUUID(f.Watches[0].UUID.String()))
+	js.True(watch.DeactivatedAt.Valid, "expired watch was not deactivated")
+
+	js.NoError(watch.FindByUUID(f.Watches[1].UUID.String()))
+	js.False(watch.DeactivatedAt.Valid, "watch was deactivated before it expired")
+
+	notifications.TestEmailService.DeleteSentMessages()
+	js.NoError(watchExpirationHandler(nil))
+	js.Equal(0, notifications.TestEmailService.GetNumberOfMessagesSent(), "expiration notification sent twice")
+}
+
+func (js *JobSuite) TestOutboxHandler_QuietHours() {
+	f := CreateFixtures_TestOutboxHandler(js)
+
+	notifications.TestEmailService.DeleteSentMessages()
+	js.NoError(watchExpirationHandler(nil))
+	js.Equal(0, notifications.TestEmailService.GetNumberOfMessagesSent(), "message sent during quiet hours")
+
+	var held models.OutboxMessage
+	js.NoError(js.DB.Where("user_id = ?", f.Users[0].ID).First(&held))
+	js.True(held.NotBefore.Valid, "held message has no NotBefore time")
+	js.Equal(models.OutboxMessageStatusQueued, held.Status, "held message is not queued")
+
+	js.NoError(outboxHandler(nil))
+	js.Equal(0, notifications.TestEmailService.GetNumberOfMessagesSent(), "message sent before the end of quiet hours")
+
+	// the quiet hours are over
+	js.NoError(js.DB.RawQuery("UPDATE outbox_messages SET next_attempt_at = ? WHERE id = ?",
+		time.Now().Add(-time.Minute), held.ID).Exec())
+
+	js.NoError(outboxHandler(nil))
+	emails := notifications.TestEmailService.GetSentMessages()
+	js.Equal(1, len(emails), "held message was not sent after quiet hours")
+	if len(emails) == 1 {
+		js.Equal(f.Users[0].Email, emails[0].ToEmail, "held message sent to the wrong user")
+	}
+}
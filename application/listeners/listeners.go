@@ -1,7 +1,10 @@
 package listeners
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"strconv"
 
 	"github.com/gobuffalo/events"
 
@@ -50,6 +53,10 @@ var apiListeners = map[string][]apiListener{
 			name:     "request-status-updated-notification",
 			listener: sendRequestStatusUpdatedNotification,
 		},
+		{
+			name:     "request-status-updated-webhook",
+			listener: requestStatusUpdatedWebhook,
+		},
 	},
 
 	domain.EventApiRequestCreated: {
@@ -57,6 +64,10 @@ var apiListeners = map[string][]apiListener{
 			name:     "request-created-notification",
 			listener: sendRequestCreatedNotifications,
 		},
+		{
+			name:     "request-created-webhook",
+			listener: requestCreatedWebhook,
+		},
 	},
 
 	domain.EventApiPotentialProviderCreated: {
@@ -64,6 +75,10 @@ var apiListeners = map[string][]apiListener{
 			name:     "potentialprovider-created-notification",
 			listener: potentialProviderCreated,
 		},
+		{
+			name:     "potentialprovider-created-webhook",
+			listener: potentialProviderCreatedWebhook,
+		},
 	},
 
 	domain.EventApiPotentialProviderSelfDestroyed: {
@@ -78,6 +93,66 @@ var apiListeners = map[string][]apiListener{
 			name:     "potentialprovider-rejected-notification",
 			listener: potentialProviderRejected,
 		},
+		{
+			name:     "potentialprovider-rejected-webhook",
+			listener: potentialProviderRejectedWebhook,
+		},
+	},
+
+	domain.EventApiPhoneCodeCreated: {
+		{
+			name:     "phone-code-created-send-code",
+			listener: phoneCodeCreated,
+		},
+	},
+
+	domain.EventApiMeetingCreated: {
+		{
+			name:     "meeting-created-webhook",
+			listener: meetingCreatedWebhook,
+		},
+	},
+
+	domain.EventApiUserOrganizationCreated: {
+		{
+			name:     "user-joined-organization-webhook",
+			listener: userJoinedOrganizationWebhook,
+		},
+	},
+
+	domain.EventApiWatchSummaryRequested: {
+		{
+			name:     "watch-summary-requested",
+			listener: watchSummaryRequested,
+		},
+	},
+
+	domain.EventApiMeetingInviteCreated: {
+		{
+			name:     "meeting-invite-created-send-invitation",
+			listener: meetingInviteCreated,
+		},
+	},
+
+	domain.EventApiMeetingInviteResent: {
+		{
+			name:     "meeting-invite-resent-send-invitation",
+			listener: meetingInviteResent,
+		},
+	},
+
+	domain.EventApiMeetingOrganizerAdded: {
+		{
+			name:     "meeting-organizer-added-notification",
+			listener: meetingOrganizerAdded,
+		},
+	},
+
+	domain.EventApiMeetingAnnouncementCreated: {
+		{
+			name:     "meeting-announcement-created-notifications",
+			listener: meetingAnnouncementCreated,
+		},
 	},
 }
 
@@ -209,9 +284,9 @@ func sendRequestCreatedNotifications(e events.Event) {
 		domain.ErrLogger.Printf("unable to find request %d from request-created event, %s", eventData.RequestID, err)
 	}
 
-	users, err := request.GetAudience()
+	users, err := request.FindInterestedUsers()
 	if err != nil {
-		domain.ErrLogger.Printf("unable to get request audience in event listener: %s", err.Error())
+		domain.ErrLogger.Printf("unable to find users interested in request in event listener: %s", err.Error())
 		return
 	}
 
@@ -308,18 +383,344 @@ func potentialProviderRejected(e events.Event) {
 	sendPotentialProviderRejectedNotification(potentialProvider, creator.Nickname, request)
 }
 
+func phoneCodeCreated(e events.Event) {
+	if e.Kind != domain.EventApiPhoneCodeCreated {
+		return
+	}
+
+	phoneNumber, ok := e.Payload[domain.ArgPhoneNumber].(string)
+	if !ok {
+		domain.ErrLogger.Printf("phone code event payload has no phone number. Event message: %s", e.Message)
+		return
+	}
+
+	code, ok := e.Payload[domain.ArgPhoneCode].(string)
+	if !ok {
+		domain.ErrLogger.Printf("phone code event payload has no code. Event message: %s", e.Message)
+		return
+	}
+
+	msg := notifications.Message{
+		Template: domain.MessageTemplatePhoneCode,
+		Data:     map[string]interface{}{"code": code},
+		ToPhone:  phoneNumber,
+		Channel:  domain.NotificationChannelMobile,
+	}
+	if err := notifications.Send(msg); err != nil {
+		domain.ErrLogger.Printf("error sending phone verification code, %s", err)
+	}
+}
+
+// summaryRequest is the data for one request in a watch summary message
+type summaryRequest struct {
+	Title       string
+	URL         string
+	Destination string
+}
+
+func watchSummaryRequested(e events.Event) {
+	if e.Kind != domain.EventApiWatchSummaryRequested {
+		return
+	}
+
+	id, ok := e.Payload[domain.ArgWatchID].(int)
+	if !ok {
+		domain.ErrLogger.Printf("watch summary event payload has no watch ID. Event message: %s", e.Message)
+		return
+	}
+
+	var watch models.Watch
+	if err := models.DB.Find(&watch, id); err != nil {
+		domain.ErrLogger.Printf("failed to find watch %d for summary, %s", id, err)
+		return
+	}
+
+	if err := sendWatchSummary(watch); err != nil {
+		domain.ErrLogger.Printf("error sending watch %s summary, %s", watch.UUID, err)
+	}
+}
+
+// sendWatchSummary sends the watch owner a list of the open requests that currently match the watch, up to the
+// maximum page size. Nothing is sent if there are no matches.
+func sendWatchSummary(watch models.Watch) error {
+	var matches models.Requests
+	if err := matches.FindByWatch(context.Background(), watch, 1, domain.MaxPageSize); err != nil {
+		return err
+	}
+	if len(matches) == 0 {
+		return nil
+	}
+
+	owner, err := watch.GetOwner()
+	if err != nil {
+		return err
+	}
+
+	requests := make([]summaryRequest, len(matches))
+	for i, request := range matches {
+		requests[i] = summaryRequest{
+			Title: request.Title,
+			URL:   domain.GetRequestUIURL(request.UUID.String()),
+		}
+		if dest, err := request.GetDestination(); err == nil && dest != nil {
+			requests[i].Destination = dest.Description
+		}
+	}
+
+	language := owner.GetLanguagePreference()
+	msg := notifications.Message{
+		Template: domain.MessageTemplateWatchSummary,
+		Data: map[string]interface{}{
+			"appName":   domain.Env.AppName,
+			"uiURL":     domain.Env.UIURL,
+			"watchName": watch.Name,
+			"requests":  requests,
+		},
+		ToName:    owner.GetRealName(),
+		ToEmail:   owner.Email,
+		ToUserID:  owner.ID,
+		Language:  language,
+		FromEmail: domain.EmailFromAddress(nil),
+		Subject: notifications.TranslateSubject(language, domain.MessageTemplateWatchSummary,
+			map[string]string{"watchName": watch.Name, "count": strconv.Itoa(len(requests))}),
+	}
+	return notifications.Send(msg)
+}
+
+func meetingInviteCreated(e events.Event) {
+	if e.Kind != domain.EventApiMeetingInviteCreated {
+		return
+	}
+	sendMeetingInviteFromEvent(e)
+}
+
+func meetingInviteResent(e events.Event) {
+	if e.Kind != domain.EventApiMeetingInviteResent {
+		return
+	}
+	sendMeetingInviteFromEvent(e)
+}
+
+func sendMeetingInviteFromEvent(e events.Event) {
+	eventData, ok := e.Payload["eventData"].(models.MeetingInviteEventData)
+	if !ok {
+		domain.ErrLogger.Printf("Meeting Invite event payload incorrect type: %T", e.Payload["eventData"])
+		return
+	}
+
+	var invite models.MeetingInvite
+	if err := invite.FindByID(eventData.MeetingInviteID); err != nil {
+		domain.ErrLogger.Printf("unable to find meeting invite %d from event, %s", eventData.MeetingInviteID, err)
+		return
+	}
+
+	if err := sendMeetingInvite(invite); err != nil {
+		domain.ErrLogger.Printf("error sending meeting invite %d, %s", invite.ID, err)
+	}
+}
+
+// sendMeetingInvite emails the invitation to the invitee, in the language of the invitee if they are already a user,
+// or else in the language of the inviter. The delivery status is recorded on the invite. No email is sent to a
+// suppressed address; the invite is marked as bounced only if the suppression is due to a bounce.
+func sendMeetingInvite(invite models.MeetingInvite) error {
+	suppression, err := models.FindEmailSuppression(invite.Email)
+	if err != nil {
+		return err
+	}
+	if suppression != nil {
+		if suppression.Reason != models.EmailSuppressionReasonBounce {
+			domain.Logger.Printf("meeting invite %d not sent, invitee address is suppressed", invite.ID)
+			return nil
+		}
+		return invite.SetStatus(models.MeetingInviteStatusBounced)
+	}
+
+	meeting, err := invite.Meeting()
+	if err != nil {
+		return err
+	}
+	inviter, err := invite.Inviter()
+	if err != nil {
+		return err
+	}
+	location, err := meeting.GetLocation()
+	if err != nil {
+		return err
+	}
+
+	language := inviter.GetLanguagePreference()
+	var invitee models.User
+	if err := invitee.FindByEmail(invite.Email); err == nil {
+		language = invitee.GetLanguagePreference()
+	}
+
+	msg := notifications.Message{
+		Template: domain.MessageTemplateMeetingInvite,
+		Data: map[string]interface{}{
+			"appName":            domain.Env.AppName,
+			"uiURL":              domain.Env.UIURL,
+			"inviterNickname":    inviter.Nickname,
+			"meetingName":        meeting.Name,
+			"meetingDescription": meeting.Description.String,
+			"meetingLocation":    location.Description,
+			"meetingStartDate":   meeting.StartDate.Format(domain.DateFormat),
+			"meetingEndDate":     meeting.EndDate.Format(domain.DateFormat),
+			"meetingMoreInfoURL": meeting.MoreInfoURL.String,
+			"inviteURL":          domain.GetInviteUIURL(invite.Secret.String()),
+		},
+		ToEmail:        invite.Email,
+		Channel:        domain.NotificationChannelEmail,
+		Language:       language,
+		FromEmail:      domain.EmailFromAddress(&inviter.Nickname),
+		IdempotencyKey: fmt.Sprintf("%s:%d:%d", domain.MessageTemplateMeetingInvite, invite.ID, invite.SendCount),
+		Subject: notifications.TranslateSubject(language, domain.MessageTemplateMeetingInvite,
+			map[string]string{"inviterNickname": inviter.Nickname, "meetingName": meeting.Name}),
+	}
+	if err := notifications.Send(msg); err != nil {
+		return err
+	}
+
+	return invite.SetStatus(models.MeetingInviteStatusSent)
+}
+
+func meetingOrganizerAdded(e events.Event) {
+	if e.Kind != domain.EventApiMeetingOrganizerAdded {
+		return
+	}
+
+	eventData, ok := e.Payload["eventData"].(models.MeetingOrganizerAddedEventData)
+	if !ok {
+		domain.ErrLogger.Printf("Meeting Organizer event payload incorrect type: %T", e.Payload["eventData"])
+		return
+	}
+
+	if err := sendMeetingOrganizerAdded(eventData); err != nil {
+		domain.ErrLogger.Printf("error notifying new organizer of meeting %d, %s", eventData.MeetingID, err)
+	}
+}
+
+// sendMeetingOrganizerAdded notifies a user that they were made an organizer of a meeting
+func sendMeetingOrganizerAdded(eventData models.MeetingOrganizerAddedEventData) error {
+	var meeting models.Meeting
+	if err := meeting.FindByID(eventData.MeetingID); err != nil {
+		return err
+	}
+	var organizer, addedBy models.User
+	if err := organizer.FindByID(eventData.UserID); err != nil {
+		return err
+	}
+	if err := addedBy.FindByID(eventData.AddedByID); err != nil {
+		return err
+	}
+
+	language := organizer.GetLanguagePreference()
+	msg := notifications.Message{
+		Template: domain.MessageTemplateMeetingOrganizerAdded,
+		Data: map[string]interface{}{
+			"appName":         domain.Env.AppName,
+			"uiURL":           domain.Env.UIURL,
+			"addedByNickname": addedBy.Nickname,
+			"meetingName":     meeting.Name,
+			"meetingURL":      domain.GetMeetingUIURL(meeting.UUID.String()),
+		},
+		ToName:    organizer.GetRealName(),
+		ToEmail:   organizer.Email,
+		ToUserID:  organizer.ID,
+		Language:  language,
+		FromEmail: domain.EmailFromAddress(nil),
+		MeetingID: meeting.ID,
+		Subject: notifications.TranslateSubject(language, domain.MessageTemplateMeetingOrganizerAdded,
+			map[string]string{"addedByNickname": addedBy.Nickname, "meetingName": meeting.Name}),
+	}
+	return notifications.Send(msg)
+}
+
+func meetingAnnouncementCreated(e events.Event) {
+	if e.Kind != domain.EventApiMeetingAnnouncementCreated {
+		return
+	}
+
+	eventData, ok := e.Payload["eventData"].(models.MeetingAnnouncementEventData)
+	if !ok {
+		domain.ErrLogger.Printf("Meeting Announcement event payload incorrect type: %T", e.Payload["eventData"])
+		return
+	}
+
+	var announcement models.MeetingAnnouncement
+	if err := announcement.FindByID(eventData.MeetingAnnouncementID); err != nil {
+		domain.ErrLogger.Printf("unable to find meeting announcement %d from event, %s",
+			eventData.MeetingAnnouncementID, err)
+		return
+	}
+
+	if err := sendMeetingAnnouncement(announcement); err != nil {
+		domain.ErrLogger.Printf("error sending meeting announcement %d, %s", announcement.ID, err)
+	}
+}
+
+// sendMeetingAnnouncement delivers the announcement to each participant of the meeting, other than its author, through
+// their preferred notification channel and in their language
+func sendMeetingAnnouncement(announcement models.MeetingAnnouncement) error {
+	meeting, err := announcement.Meeting()
+	if err != nil {
+		return err
+	}
+	author, err := announcement.Author()
+	if err != nil {
+		return err
+	}
+	participants, err := meeting.ParticipantUsers()
+	if err != nil {
+		return err
+	}
+
+	for i, participant := range participants {
+		if participant.ID == author.ID {
+			continue
+		}
+
+		language := participant.GetLanguagePreference()
+		msg := notifications.Message{
+			Template: domain.MessageTemplateMeetingAnnouncement,
+			Data: map[string]interface{}{
+				"appName":        domain.Env.AppName,
+				"uiURL":          domain.Env.UIURL,
+				"authorNickname": author.Nickname,
+				"meetingName":    meeting.Name,
+				"meetingURL":     domain.GetMeetingUIURL(meeting.UUID.String()),
+				"announcement":   announcement.Content,
+			},
+			ToName:    participant.GetRealName(),
+			ToEmail:   participant.Email,
+			ToUserID:  participant.ID,
+			Language:  language,
+			FromEmail: domain.EmailFromAddress(&author.Nickname),
+			MeetingID: meeting.ID,
+			IdempotencyKey: fmt.Sprintf("%s:%d:%d", domain.MessageTemplateMeetingAnnouncement, announcement.ID,
+				participant.ID),
+			Subject: notifications.TranslateSubject(language, domain.MessageTemplateMeetingAnnouncement,
+				map[string]string{"meetingName": meeting.Name}),
+		}
+		if err := notifications.Send(msg); err != nil {
+			domain.ErrLogger.Printf("error sending meeting announcement (%d of %d), %s", i, len(participants), err)
+		}
+	}
+	return nil
+}
+
 func sendNewUserWelcome(user models.User) error {
 	if user.Email == "" {
 		return errors.New("'To' email address is required")
 	}
 
 	language := user.GetLanguagePreference()
-	subject := domain.GetTranslatedSubject(language, "Email.Subject.Welcome", map[string]string{})
+	subject := notifications.TranslateSubject(language, domain.MessageTemplateNewUserWelcome, map[string]string{})
 
 	msg := notifications.Message{
 		Template:  domain.MessageTemplateNewUserWelcome,
 		ToName:    user.GetRealName(),
 		ToEmail:   user.Email,
+		Language:  language,
 		FromEmail: domain.EmailFromAddress(nil),
 		Subject:   subject,
 		Data: map[string]interface{}{
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/gobuffalo/events"
+	"github.com/gobuffalo/nulls"
 	"github.com/gobuffalo/suite"
 
 	"github.com/silinternational/wecarry-api/domain"
@@ -121,6 +122,185 @@ func (ms *ModelSuite) TestUserCreated() {
 
 }
 
+func (ms *ModelSuite) TestPhoneCodeCreated() {
+	e := events.Event{
+		Kind:    domain.EventApiPhoneCodeCreated,
+		Message: "Phone Code Created",
+		Payload: events.Payload{
+			domain.ArgUserID:      1,
+			domain.ArgPhoneNumber: "+15555550100",
+			domain.ArgPhoneCode:   "012345",
+		},
+	}
+
+	notifications.TestMobileService.DeleteSentMessages()
+	notifications.TestEmailService.DeleteSentMessages()
+
+	phoneCodeCreated(e)
+
+	sent := notifications.TestMobileService.GetSentMessages()
+	ms.Equal(1, len(sent), "wrong text message count")
+	ms.Equal("+15555550100", sent[0].ToPhone, "wrong phone number")
+	ms.Contains(sent[0].Body, "012345", "text message does not contain the code")
+	ms.Equal(0, notifications.TestEmailService.GetNumberOfMessagesSent(), "code should not be sent by email")
+}
+
+func (ms *ModelSuite) TestWatchSummaryRequested() {
+	users := test.CreateUserFixtures(ms.DB, 2).Users
+	test.CreateRequestFixtures(ms.DB, 2, false)
+
+	watches := models.Watches{
+		{OwnerID: users[1].ID, Name: "matches", SearchText: nulls.NewString("title")},
+		{OwnerID: users[1].ID, Name: "no matches", SearchText: nulls.NewString("nothing")},
+	}
+	for i := range watches {
+		watches[i].UUID = domain.GetUUID()
+		createFixture(ms, &watches[i])
+	}
+
+	tests := []struct {
+		name      string
+		watch     models.Watch
+		wantCount int
+	}{
+		{name: "matches", watch: watches[0], wantCount: 1},
+		{name: "no matches", watch: watches[1], wantCount: 0},
+	}
+	for _, tt := range tests {
+		ms.T().Run(tt.name, func(t *testing.T) {
+			notifications.TestEmailService.DeleteSentMessages()
+
+			watchSummaryRequested(events.Event{
+				Kind:    domain.EventApiWatchSummaryRequested,
+				Message: "Watch summary requested",
+				Payload: events.Payload{domain.ArgWatchID: tt.watch.ID},
+			})
+
+			emails := notifications.TestEmailService.GetSentMessages()
+			ms.Equal(tt.wantCount, len(emails), "wrong email count")
+			if len(emails) == 1 {
+				ms.Equal(users[1].Email, emails[0].ToEmail, "summary sent to the wrong user")
+				ms.Contains(emails[0].Subject, "2 open requests", "incorrect subject")
+			}
+		})
+	}
+}
+
+func (ms *ModelSuite) TestMeetingInviteCreated() {
+	meeting, users := createMeetingFixture(ms, 2)
+
+	invites := models.MeetingInvites{
+		{MeetingID: meeting.ID, InviterID: users[0].ID, Email: "invitee@example.com"},
+		{MeetingID: meeting.ID, InviterID: users[0].ID, Email: "bounced@example.com"},
+		{MeetingID: meeting.ID, InviterID: users[0].ID, Email: "complained@example.com"},
+	}
+	for i := range invites {
+		ms.NoError(invites[i].Create())
+	}
+
+	// the invite was already sent before the bounce or complaint was reported
+	ms.NoError(invites[1].SetStatus(models.MeetingInviteStatusSent))
+	ms.NoError(invites[2].SetStatus(models.MeetingInviteStatusSent))
+	_, err := models.SuppressEmail(invites[1].Email, models.EmailSuppressionReasonBounce, "test", "")
+	ms.NoError(err)
+	_, err = models.SuppressEmail(invites[2].Email, models.EmailSuppressionReasonComplaint, "test", "")
+	ms.NoError(err)
+
+	tests := []struct {
+		name       string
+		invite     models.MeetingInvite
+		wantStatus models.MeetingInviteStatus
+		wantCount  int
+	}{
+		{name: "sent", invite: invites[0], wantStatus: models.MeetingInviteStatusSent, wantCount: 1},
+		{name: "bounced", invite: invites[1], wantStatus: models.MeetingInviteStatusBounced, wantCount: 0},
+		{name: "complained", invite: invites[2], wantStatus: models.MeetingInviteStatusSent, wantCount: 0},
+	}
+	for _, tt := range tests {
+		ms.T().Run(tt.name, func(t *testing.T) {
+			notifications.TestEmailService.DeleteSentMessages()
+
+			meetingInviteCreated(events.Event{
+				Kind:    domain.EventApiMeetingInviteCreated,
+				Message: "Meeting Invite sent",
+				Payload: events.Payload{"eventData": models.MeetingInviteEventData{MeetingInviteID: tt.invite.ID}},
+			})
+
+			emails := notifications.TestEmailService.GetSentMessages()
+			ms.Equal(tt.wantCount, len(emails), "wrong email count")
+			if len(emails) == 1 {
+				ms.Equal(tt.invite.Email, emails[0].ToEmail, "invitation sent to the wrong address")
+				ms.Contains(emails[0].Subject, meeting.Name, "incorrect subject")
+				body := notifications.TestEmailService.GetLastBody()
+				ms.Contains(body, domain.GetInviteUIURL(tt.invite.Secret.String()), "missing invite link")
+				ms.Contains(body, meeting.MoreInfoURL.String, "missing meeting information")
+			}
+
+			var invite models.MeetingInvite
+			ms.NoError(invite.FindByID(tt.invite.ID))
+			ms.Equal(tt.wantStatus, invite.Status, "incorrect invite status")
+		})
+	}
+}
+
+func (ms *ModelSuite) TestMeetingOrganizerAdded() {
+	meeting, users := createMeetingFixture(ms, 2)
+
+	notifications.TestEmailService.DeleteSentMessages()
+
+	meetingOrganizerAdded(events.Event{
+		Kind:    domain.EventApiMeetingOrganizerAdded,
+		Message: "Meeting organizer added",
+		Payload: events.Payload{"eventData": models.MeetingOrganizerAddedEventData{
+			MeetingID: meeting.ID,
+			UserID:    users[1].ID,
+			AddedByID: users[0].ID,
+		}},
+	})
+
+	emails := notifications.TestEmailService.GetSentMessages()
+	ms.Equal(1, len(emails), "wrong email count")
+	ms.Equal(users[1].Email, emails[0].ToEmail, "notification sent to the wrong address")
+	ms.Contains(emails[0].Subject, meeting.Name, "incorrect subject")
+	ms.Contains(notifications.TestEmailService.GetLastBody(), domain.GetMeetingUIURL(meeting.UUID.String()),
+		"missing meeting link")
+}
+
+func (ms *ModelSuite) TestMeetingAnnouncementCreated() {
+	meeting, users := createMeetingFixture(ms, 3)
+	for i := range users {
+		createFixture(ms, &models.MeetingParticipant{MeetingID: meeting.ID, UserID: users[i].ID, IsOrganizer: i == 0})
+	}
+
+	announcement := models.MeetingAnnouncement{
+		UUID:      domain.GetUUID(),
+		MeetingID: meeting.ID,
+		AuthorID:  users[0].ID,
+		Content:   "The carry exchange desk is in room B at 3pm",
+	}
+	createFixture(ms, &announcement)
+
+	notifications.TestEmailService.DeleteSentMessages()
+
+	meetingAnnouncementCreated(events.Event{
+		Kind:    domain.EventApiMeetingAnnouncementCreated,
+		Message: "Meeting announcement created",
+		Payload: events.Payload{"eventData": models.MeetingAnnouncementEventData{
+			MeetingAnnouncementID: announcement.ID,
+		}},
+	})
+
+	emails := notifications.TestEmailService.GetSentMessages()
+	ms.Equal(2, len(emails), "wrong email count")
+	recipients := make([]string, len(emails))
+	for i := range emails {
+		recipients[i] = emails[i].ToEmail
+		ms.Contains(emails[i].Subject, meeting.Name, "incorrect subject")
+	}
+	ms.ElementsMatch([]string{users[1].Email, users[2].Email}, recipients, "announcement sent to the wrong users")
+	ms.Contains(notifications.TestEmailService.GetLastBody(), announcement.Content, "missing announcement content")
+}
+
 func (ms *ModelSuite) TestSendNewMessageNotification() {
 	var buf bytes.Buffer
 	domain.Logger.SetOutput(&buf)
@@ -12,6 +12,7 @@ import (
 const requestTitleKey = "requestTitle"
 
 type requestUser struct {
+	ID       int
 	Language string
 	Nickname string
 	Email    string
@@ -33,6 +34,7 @@ func getRequestUsers(request models.Request) requestUsers {
 
 	if receiver != nil {
 		recipients.Receiver = requestUser{
+			ID:       receiver.ID,
 			Language: receiver.GetLanguagePreference(),
 			Nickname: receiver.Nickname,
 			Email:    receiver.Email,
@@ -41,6 +43,7 @@ func getRequestUsers(request models.Request) requestUsers {
 
 	if provider != nil {
 		recipients.Provider = requestUser{
+			ID:       provider.ID,
 			Language: provider.GetLanguagePreference(),
 			Nickname: provider.Nickname,
 			Email:    provider.Email,
@@ -66,7 +69,10 @@ func getMessageForProvider(requestUsers requestUsers, request models.Request, te
 		Data:      data,
 		ToName:    requestUsers.Provider.Nickname,
 		ToEmail:   requestUsers.Provider.Email,
+		ToUserID:  requestUsers.Provider.ID,
+		Language:  requestUsers.Provider.Language,
 		FromEmail: domain.EmailFromAddress(nil),
+		RequestID: request.ID,
 	}
 }
 
@@ -86,7 +92,10 @@ func getMessageForReceiver(requestUsers requestUsers, request models.Request, te
 		Data:      data,
 		ToName:    requestUsers.Receiver.Nickname,
 		ToEmail:   requestUsers.Receiver.Email,
+		ToUserID:  requestUsers.Receiver.ID,
+		Language:  requestUsers.Receiver.Language,
 		FromEmail: domain.EmailFromAddress(nil),
+		RequestID: request.ID,
 	}
 }
 
@@ -105,7 +114,10 @@ func getPotentialProviderMessageForReceiver(
 		Data:      data,
 		ToName:    requester.GetRealName(),
 		ToEmail:   requester.Email,
+		ToUserID:  requester.ID,
+		Language:  requester.GetLanguagePreference(),
 		FromEmail: domain.EmailFromAddress(nil),
+		RequestID: request.ID,
 	}
 }
 
@@ -120,7 +132,7 @@ func sendNotificationRequestToProvider(params senderParams) {
 	}
 
 	msg := getMessageForProvider(requestUsers, request, template)
-	msg.Subject = domain.GetTranslatedSubject(requestUsers.Provider.Language, params.subject,
+	msg.Subject = notifications.TranslateSubject(requestUsers.Provider.Language, template,
 		map[string]string{requestTitleKey: request.Title})
 
 	if err := notifications.Send(msg); err != nil {
@@ -140,7 +152,7 @@ func sendNotificationRequestToReceiver(params senderParams) {
 	}
 
 	msg := getMessageForReceiver(requestUsers, request, template)
-	msg.Subject = domain.GetTranslatedSubject(requestUsers.Receiver.Language, params.subject,
+	msg.Subject = notifications.TranslateSubject(requestUsers.Receiver.Language, template,
 		map[string]string{requestTitleKey: request.Title})
 
 	if err := notifications.Send(msg); err != nil {
@@ -170,7 +182,9 @@ func sendNotificationRequestFromAcceptedToOpen(params senderParams) {
 
 	msg.ToName = oldProvider.GetRealName()
 	msg.ToEmail = oldProvider.Email
-	msg.Subject = domain.GetTranslatedSubject(oldProvider.GetLanguagePreference(), params.subject,
+	msg.ToUserID = oldProvider.ID
+	msg.Language = oldProvider.GetLanguagePreference()
+	msg.Subject = notifications.TranslateSubject(msg.Language, template,
 		map[string]string{requestTitleKey: request.Title})
 
 	if err := notifications.Send(msg); err != nil {
@@ -211,15 +225,16 @@ func sendRejectionToPotentialProvider(potentialProvider models.User, request mod
 		"receiverNickname": receiver.Nickname,
 	}
 
-	subject := "Email.Subject.Request.OfferRejected"
-
 	msg := notifications.Message{
 		Template:  template,
 		Data:      data,
 		ToName:    potentialProvider.GetRealName(),
 		ToEmail:   ppEmail,
+		ToUserID:  potentialProvider.ID,
+		Language:  potentialProvider.GetLanguagePreference(),
 		FromEmail: domain.EmailFromAddress(nil),
-		Subject: domain.GetTranslatedSubject(potentialProvider.GetLanguagePreference(), subject,
+		RequestID: request.ID,
+		Subject: notifications.TranslateSubject(potentialProvider.GetLanguagePreference(), template,
 			map[string]string{requestTitleKey: request.Title}),
 	}
 
@@ -261,14 +276,12 @@ func sendNotificationEmpty(params senderParams) {
 
 type senderParams struct {
 	template   string
-	subject    string
 	request    models.Request
 	pEventData models.RequestStatusEventData
 }
 
 type sender struct {
 	template string
-	subject  string
 	sender   func(senderParams) // string, string, models.Request, models.RequestStatusEventData)
 }
 
@@ -279,62 +292,50 @@ func join(s1, s2 models.RequestStatus) string {
 var statusSenders = map[string]sender{
 	join(models.RequestStatusAccepted, models.RequestStatusCompleted): sender{
 		template: domain.MessageTemplateRequestFromAcceptedToCompleted,
-		subject:  "Email.Subject.Request.FromAcceptedOrDeliveredToCompleted",
 		sender:   sendNotificationRequestFromAcceptedOrDeliveredToCompleted},
 
 	join(models.RequestStatusAccepted, models.RequestStatusDelivered): sender{
 		template: domain.MessageTemplateRequestFromAcceptedToDelivered,
-		subject:  "Email.Subject.Request.FromAcceptedToDelivered",
 		sender:   sendNotificationRequestFromAcceptedToDelivered},
 
 	join(models.RequestStatusAccepted, models.RequestStatusOpen): sender{
 		template: domain.MessageTemplateRequestFromAcceptedToOpen,
-		subject:  "Email.Subject.Request.FromAcceptedToOpen",
 		sender:   sendNotificationRequestFromAcceptedToOpen},
 
 	join(models.RequestStatusAccepted, models.RequestStatusReceived): sender{
 		template: domain.MessageTemplateRequestFromAcceptedToCompleted,
-		subject:  "Email.Subject.Request.FromAcceptedOrDeliveredToCompleted",
 		sender:   sendNotificationRequestFromAcceptedOrDeliveredToCompleted},
 
 	join(models.RequestStatusAccepted, models.RequestStatusRemoved): sender{
 		template: domain.MessageTemplateRequestFromAcceptedToRemoved,
-		subject:  "Email.Subject.Request.FromAcceptedToRemoved",
 		sender:   sendNotificationRequestFromAcceptedToRemoved},
 
 	join(models.RequestStatusCompleted, models.RequestStatusAccepted): sender{
 		template: domain.MessageTemplateRequestFromCompletedToAccepted,
-		subject:  "Email.Subject.Request.FromCompletedToAcceptedOrDelivered",
 		sender:   sendNotificationRequestFromCompletedToAcceptedOrDelivered},
 
 	join(models.RequestStatusCompleted, models.RequestStatusDelivered): sender{
 		template: domain.MessageTemplateRequestFromCompletedToDelivered,
-		subject:  "Email.Subject.Request.FromCompletedToAcceptedOrDelivered",
 		sender:   sendNotificationRequestFromCompletedToAcceptedOrDelivered},
 
 	join(models.RequestStatusCompleted, models.RequestStatusReceived): sender{
 		template: domain.MessageTemplateRequestFromCompletedToReceived,
-		subject:  "",
 		sender:   sendNotificationEmpty},
 
 	join(models.RequestStatusDelivered, models.RequestStatusAccepted): sender{
 		template: domain.MessageTemplateRequestFromDeliveredToAccepted,
-		subject:  "Email.Subject.Request.FromDeliveredToAccepted",
 		sender:   sendNotificationRequestFromDeliveredToAccepted},
 
 	join(models.RequestStatusDelivered, models.RequestStatusCompleted): sender{
 		template: domain.MessageTemplateRequestFromDeliveredToCompleted,
-		subject:  "Email.Subject.Request.FromAcceptedOrDeliveredToCompleted",
 		sender:   sendNotificationRequestFromAcceptedOrDeliveredToCompleted},
 
 	join(models.RequestStatusOpen, models.RequestStatusAccepted): sender{
 		template: domain.MessageTemplateRequestFromOpenToAccepted,
-		subject:  "Email.Subject.Request.FromOpenToAccepted",
 		sender:   sendNotificationRequestFromOpenToAccepted},
 
 	join(models.RequestStatusReceived, models.RequestStatusCompleted): sender{
 		template: domain.MessageTemplateRequestFromReceivedToCompleted,
-		subject:  "",
 		sender:   sendNotificationEmpty},
 }
 
@@ -350,7 +351,6 @@ func requestStatusUpdatedNotifications(request models.Request, eData models.Requ
 
 	params := senderParams{
 		template:   notifications.GetEmailTemplate(sender.template),
-		subject:    sender.subject,
 		request:    request,
 		pEventData: eData,
 	}
@@ -358,20 +358,30 @@ func requestStatusUpdatedNotifications(request models.Request, eData models.Requ
 	sender.sender(params)
 }
 
-func sendNewRequestNotifications(request models.Request, users models.Users) {
-	for i, user := range users {
-		if !user.WantsRequestNotification(request) {
+// sendNewRequestNotifications notifies each interested user of a new request, or queues the request for the user's
+// email digest
+func sendNewRequestNotifications(request models.Request, users []models.InterestedUser) {
+	for i, interested := range users {
+		user, preferenceKey := interested.User, interested.PreferenceKey
+
+		if user.GetDigestPreference() != domain.UserPreferenceDigestImmediate &&
+			user.GetNotificationChannel(preferenceKey) == domain.NotificationChannelEmail {
+			item := models.DigestItem{UserID: user.ID, RequestID: request.ID, PreferenceKey: preferenceKey}
+			if err := item.Create(); err != nil {
+				domain.ErrLogger.Printf("error queueing request created notification for digest (%d of %d), %s",
+					i, len(users), err)
+			}
 			continue
 		}
 
-		if err := sendNewRequestNotification(user, request); err != nil {
+		if err := sendNewRequestNotification(user, request, preferenceKey); err != nil {
 			domain.ErrLogger.Printf("error sending request created notification (%d of %d), %s",
 				i, len(users), err)
 		}
 	}
 }
 
-func sendNewRequestNotification(user models.User, request models.Request) error {
+func sendNewRequestNotification(user models.User, request models.Request, preferenceKey string) error {
 	if user.Email == "" {
 		return errors.New("'To' email address is required")
 	}
@@ -391,12 +401,17 @@ func sendNewRequestNotification(user models.User, request models.Request) error
 	}
 
 	msg := notifications.Message{
-		Subject: domain.GetTranslatedSubject(user.GetLanguagePreference(),
-			"Email.Subject.NewRequest", map[string]string{}),
-		Template:  domain.MessageTemplateNewRequest,
-		ToName:    user.GetRealName(),
-		ToEmail:   user.Email,
-		FromEmail: domain.EmailFromAddress(nil),
+		Subject: notifications.TranslateSubject(user.GetLanguagePreference(), domain.MessageTemplateNewRequest,
+			map[string]string{}),
+		Template:       domain.MessageTemplateNewRequest,
+		ToName:         user.GetRealName(),
+		ToEmail:        user.Email,
+		ToUserID:       user.ID,
+		Language:       user.GetLanguagePreference(),
+		PreferenceKey:  preferenceKey,
+		FromEmail:      domain.EmailFromAddress(nil),
+		RequestID:      request.ID,
+		IdempotencyKey: fmt.Sprintf("%s:%d:%d", domain.MessageTemplateNewRequest, request.ID, user.ID),
 		Data: map[string]interface{}{
 			"appName":            domain.Env.AppName,
 			"uiURL":              domain.Env.UIURL,
@@ -413,8 +428,7 @@ func sendNewRequestNotification(user models.User, request models.Request) error
 func sendPotentialProviderCreatedNotification(providerNickname string, requester models.User, request models.Request) error {
 	template := domain.MessageTemplatePotentialProviderCreated
 	msg := getPotentialProviderMessageForReceiver(requester, providerNickname, template, request)
-	msg.Subject = domain.GetTranslatedSubject(requester.GetLanguagePreference(),
-		"Email.Subject.Request.NewOffer", map[string]string{})
+	msg.Subject = notifications.TranslateSubject(requester.GetLanguagePreference(), template, map[string]string{})
 
 	return notifications.Send(msg)
 }
@@ -422,19 +436,21 @@ func sendPotentialProviderCreatedNotification(providerNickname string, requester
 func sendPotentialProviderSelfDestroyedNotification(providerNickname string, requester models.User, request models.Request) error {
 	template := domain.MessageTemplatePotentialProviderSelfDestroyed
 	msg := getPotentialProviderMessageForReceiver(requester, providerNickname, template, request)
-	msg.Subject = domain.GetTranslatedSubject(requester.GetLanguagePreference(),
-		"Email.Subject.Request.OfferRetracted", map[string]string{})
+	msg.Subject = notifications.TranslateSubject(requester.GetLanguagePreference(), template, map[string]string{})
 	return notifications.Send(msg)
 }
 
 func sendPotentialProviderRejectedNotification(provider models.User, requester string, request models.Request) error {
 	msg := notifications.Message{
-		Subject: domain.GetTranslatedSubject(provider.GetLanguagePreference(),
-			"Email.Subject.Request.OfferRejected", map[string]string{}),
+		Subject: notifications.TranslateSubject(provider.GetLanguagePreference(),
+			domain.MessageTemplatePotentialProviderRejected, map[string]string{}),
 		Template:  domain.MessageTemplatePotentialProviderRejected,
 		ToName:    provider.GetRealName(),
 		ToEmail:   provider.Email,
+		ToUserID:  provider.ID,
+		Language:  provider.GetLanguagePreference(),
 		FromEmail: domain.EmailFromAddress(nil),
+		RequestID: request.ID,
 		Data: map[string]interface{}{
 			"appName":          domain.Env.AppName,
 			"uiURL":            domain.Env.UIURL,
@@ -2,6 +2,7 @@ package listeners
 
 import (
 	"testing"
+	"time"
 
 	"github.com/gobuffalo/nulls"
 
@@ -183,6 +184,24 @@ func createFixturesForTestSendNewRequestNotifications(ms *ModelSuite) orgUserReq
 	}
 }
 
+// createMeetingFixture creates nUsers users and a meeting created by the first of them
+func createMeetingFixture(ms *ModelSuite, nUsers int) (models.Meeting, models.Users) {
+	users := test.CreateUserFixtures(ms.DB, nUsers).Users
+	location := test.CreateLocationFixtures(ms.DB, 1)[0]
+
+	meeting := models.Meeting{
+		UUID:        domain.GetUUID(),
+		CreatedByID: users[0].ID,
+		Name:        "Conference",
+		LocationID:  location.ID,
+		StartDate:   time.Now(),
+		EndDate:     time.Now().Add(domain.DurationWeek),
+		MoreInfoURL: nulls.NewString("https://example.com/conference"),
+	}
+	createFixture(ms, &meeting)
+	return meeting, users
+}
+
 func createFixture(ms *ModelSuite, f interface{}) {
 	err := ms.DB.Create(f)
 	if err != nil {
@@ -263,7 +263,6 @@ func (ms *ModelSuite) TestSendNotificationRequestFromStatus() {
 
 			params := senderParams{
 				template:   getT(nextT.template),
-				subject:    "test subject",
 				request:    nextT.request,
 				pEventData: nextT.eventData,
 			}
@@ -311,7 +310,7 @@ func (ms *ModelSuite) TestSendNewRequestNotification() {
 		ms.T().Run(nextT.name, func(t *testing.T) {
 			notifications.TestEmailService.DeleteSentMessages()
 
-			err := sendNewRequestNotification(nextT.user, nextT.request)
+			err := sendNewRequestNotification(nextT.user, nextT.request, domain.UserPreferenceKeyNotifyNewRequest)
 			if nextT.wantErr != "" {
 				ms.Error(err)
 				ms.Contains(err.Error(), nextT.wantErr)
@@ -342,7 +341,7 @@ func (ms *ModelSuite) TestSendNewRequestNotifications() {
 	tests := []struct {
 		name           string
 		request        models.Request
-		users          models.Users
+		users          []models.InterestedUser
 		wantEmailCount int
 	}{
 		{
@@ -353,19 +352,19 @@ func (ms *ModelSuite) TestSendNewRequestNotifications() {
 		{
 			name:    "two users",
 			request: f.requests[0],
-			users: models.Users{
-				f.users[1],
-				f.users[2],
+			users: []models.InterestedUser{
+				{User: f.users[1], PreferenceKey: domain.UserPreferenceKeyNotifyNewRequest},
+				{User: f.users[2], PreferenceKey: domain.UserPreferenceKeyNotifyWatchMatch},
 			},
 			wantEmailCount: 2,
 		},
 		{
 			name:    "blank in the middle",
 			request: f.requests[0],
-			users: models.Users{
-				f.users[1],
-				{Email: ""},
-				f.users[2],
+			users: []models.InterestedUser{
+				{User: f.users[1], PreferenceKey: domain.UserPreferenceKeyNotifyNewRequest},
+				{User: models.User{Email: ""}, PreferenceKey: domain.UserPreferenceKeyNotifyNewRequest},
+				{User: f.users[2], PreferenceKey: domain.UserPreferenceKeyNotifyNewRequest},
 			},
 			wantEmailCount: 2,
 		},
@@ -380,12 +379,12 @@ func (ms *ModelSuite) TestSendNewRequestNotifications() {
 			ms.Equal(test.wantEmailCount, emailCount, "wrong email count")
 
 			toAddresses := notifications.TestEmailService.GetAllToAddresses()
-			for _, user := range test.users {
-				if user.Email == "" {
+			for _, interested := range test.users {
+				if interested.User.Email == "" {
 					continue
 				}
 
-				ms.Contains(toAddresses, user.Email, "did not find user address %s", user.Email)
+				ms.Contains(toAddresses, interested.User.Email, "did not find user address %s", interested.User.Email)
 			}
 		})
 	}
@@ -493,7 +492,6 @@ func (ms *ModelSuite) TestSendNotificationRequestFromOpenToAccepted() {
 
 	params := senderParams{
 		template:   domain.MessageTemplateRequestFromOpenToAccepted,
-		subject:    "Email.Subject.Request.FromOpenToAccepted",
 		request:    request,
 		pEventData: eData,
 	}
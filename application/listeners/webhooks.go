@@ -0,0 +1,181 @@
+package listeners
+
+import (
+	"github.com/gobuffalo/events"
+
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/models"
+	"github.com/silinternational/wecarry-api/webhooks"
+)
+
+func requestCreatedWebhook(e events.Event) {
+	if e.Kind != domain.EventApiRequestCreated {
+		return
+	}
+
+	eventData, ok := e.Payload["eventData"].(models.RequestCreatedEventData)
+	if !ok {
+		domain.ErrLogger.Printf("Request Created event payload incorrect type: %T", e.Payload["eventData"])
+		return
+	}
+
+	dispatchRequestWebhook(models.WebhookEventRequestCreated, eventData.RequestID, "")
+}
+
+func requestStatusUpdatedWebhook(e events.Event) {
+	if e.Kind != domain.EventApiRequestStatusUpdated {
+		return
+	}
+
+	eventData, ok := e.Payload["eventData"].(models.RequestStatusEventData)
+	if !ok {
+		domain.ErrLogger.Printf("Request Status Updated event payload incorrect type: %T", e.Payload["eventData"])
+		return
+	}
+
+	dispatchRequestWebhook(models.WebhookEventRequestStatusUpdated, eventData.RequestID, eventData.OldStatus)
+}
+
+// dispatchRequestWebhook sends a request event to the webhooks of the organizations that can see the request
+func dispatchRequestWebhook(event models.WebhookEvent, requestID int, oldStatus models.RequestStatus) {
+	var request models.Request
+	if err := request.FindByID(requestID); err != nil {
+		domain.ErrLogger.Printf("unable to find request %d for %s webhook, %s", requestID, event, err)
+		return
+	}
+
+	data, err := webhooks.NewRequestData(request)
+	if err != nil {
+		domain.ErrLogger.Printf("unable to create %s webhook payload, %s", event, err)
+		return
+	}
+	data.OldStatus = oldStatus
+
+	if err := webhooks.Dispatch(event, data, request.IsVisibleToOrganization); err != nil {
+		domain.ErrLogger.Printf("error dispatching %s webhook, %s", event, err)
+	}
+}
+
+func potentialProviderCreatedWebhook(e events.Event) {
+	if e.Kind != domain.EventApiPotentialProviderCreated {
+		return
+	}
+
+	dispatchPotentialProviderWebhook(models.WebhookEventPotentialProviderCreated, e)
+}
+
+func potentialProviderRejectedWebhook(e events.Event) {
+	if e.Kind != domain.EventApiPotentialProviderRejected {
+		return
+	}
+
+	dispatchPotentialProviderWebhook(models.WebhookEventPotentialProviderRejected, e)
+}
+
+// dispatchPotentialProviderWebhook sends a potential provider event to the webhooks of the organizations that can see
+// the request
+func dispatchPotentialProviderWebhook(event models.WebhookEvent, e events.Event) {
+	eventData, ok := e.Payload["eventData"].(models.PotentialProviderEventData)
+	if !ok {
+		domain.ErrLogger.Printf("PotentialProvider event payload incorrect type: %T", e.Payload["eventData"])
+		return
+	}
+
+	var request models.Request
+	if err := request.FindByID(eventData.RequestID); err != nil {
+		domain.ErrLogger.Printf("unable to find request %d for %s webhook, %s", eventData.RequestID, event, err)
+		return
+	}
+
+	var provider models.User
+	if err := provider.FindByID(eventData.UserID); err != nil {
+		domain.ErrLogger.Printf("unable to find user %d for %s webhook, %s", eventData.UserID, event, err)
+		return
+	}
+
+	requestData, err := webhooks.NewRequestData(request)
+	if err != nil {
+		domain.ErrLogger.Printf("unable to create %s webhook payload, %s", event, err)
+		return
+	}
+
+	data := webhooks.PotentialProviderData{
+		Request:  requestData,
+		Provider: webhooks.NewUserData(provider),
+	}
+	if err := webhooks.Dispatch(event, data, request.IsVisibleToOrganization); err != nil {
+		domain.ErrLogger.Printf("error dispatching %s webhook, %s", event, err)
+	}
+}
+
+func meetingCreatedWebhook(e events.Event) {
+	if e.Kind != domain.EventApiMeetingCreated {
+		return
+	}
+
+	eventData, ok := e.Payload["eventData"].(models.MeetingCreatedEventData)
+	if !ok {
+		domain.ErrLogger.Printf("Meeting Created event payload incorrect type: %T", e.Payload["eventData"])
+		return
+	}
+
+	var meeting models.Meeting
+	if err := meeting.FindByID(eventData.MeetingID); err != nil {
+		domain.ErrLogger.Printf("unable to find meeting %d for webhook, %s", eventData.MeetingID, err)
+		return
+	}
+
+	creator, err := meeting.GetCreator()
+	if err != nil {
+		domain.ErrLogger.Printf("unable to find creator of meeting %d for webhook, %s", meeting.ID, err)
+		return
+	}
+
+	data, err := webhooks.NewMeetingData(meeting)
+	if err != nil {
+		domain.ErrLogger.Printf("unable to create meeting webhook payload, %s", err)
+		return
+	}
+
+	// only the organizations of the creator are notified, and only if the meeting is visible to them
+	orgIDs := creator.GetOrgIDs()
+	isVisibleCreatorOrg := func(orgID int) (bool, error) {
+		for _, id := range orgIDs {
+			if id == orgID {
+				return meeting.IsVisibleToOrganization(orgID)
+			}
+		}
+		return false, nil
+	}
+
+	if err := webhooks.Dispatch(models.WebhookEventMeetingCreated, data, isVisibleCreatorOrg); err != nil {
+		domain.ErrLogger.Printf("error dispatching meeting webhook, %s", err)
+	}
+}
+
+func userJoinedOrganizationWebhook(e events.Event) {
+	if e.Kind != domain.EventApiUserOrganizationCreated {
+		return
+	}
+
+	eventData, ok := e.Payload["eventData"].(models.UserOrganizationEventData)
+	if !ok {
+		domain.ErrLogger.Printf("UserOrganization event payload incorrect type: %T", e.Payload["eventData"])
+		return
+	}
+
+	var user models.User
+	if err := user.FindByID(eventData.UserID); err != nil {
+		domain.ErrLogger.Printf("unable to find user %d for webhook, %s", eventData.UserID, err)
+		return
+	}
+
+	data := webhooks.UserJoinedOrganizationData{User: webhooks.NewUserData(user)}
+	isJoinedOrg := func(orgID int) (bool, error) {
+		return orgID == eventData.OrganizationID, nil
+	}
+
+	if err := webhooks.Dispatch(models.WebhookEventUserJoinedOrganization, data, isJoinedOrg); err != nil {
+		domain.ErrLogger.Printf("error dispatching user joined organization webhook, %s", err)
+	}
+}
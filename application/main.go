@@ -14,6 +14,7 @@ import (
 
 	"github.com/silinternational/wecarry-api/actions"
 	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/notifications"
 )
 
 var GitCommitHash string
@@ -32,6 +33,11 @@ func main() {
 	rollbar.SetCodeVersion(GitCommitHash)
 	rollbar.SetServerRoot(domain.Env.RollbarServerRoot)
 
+	if err := notifications.CheckConfig(); err != nil {
+		domain.ErrLogger.Printf(err.Error())
+		os.Exit(1)
+	}
+
 	srv, err := getServer()
 	if err != nil {
 		domain.ErrLogger.Printf(err.Error())
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gobuffalo/pop"
+	"github.com/gobuffalo/validate"
+	"github.com/gobuffalo/validate/validators"
+)
+
+// DigestItem is a new request notification queued for the user's next digest
+type DigestItem struct {
+	ID            int       `json:"id" db:"id"`
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
+	UserID        int       `json:"user_id" db:"user_id"`
+	RequestID     int       `json:"request_id" db:"request_id"`
+	PreferenceKey string    `json:"preference_key" db:"preference_key"`
+	Request       Request   `belongs_to:"requests"`
+}
+
+// String can be helpful for serializing the model
+func (d DigestItem) String() string {
+	jd, _ := json.Marshal(d)
+	return string(jd)
+}
+
+// DigestItems is merely for convenience and brevity
+type DigestItems []DigestItem
+
+// String can be helpful for serializing the model
+func (d DigestItems) String() string {
+	jd, _ := json.Marshal(d)
+	return string(jd)
+}
+
+// Validate gets run every time you call a "pop.Validate*" (pop.ValidateAndSave, pop.ValidateAndCreate, pop.ValidateAndUpdate) method.
+func (d *DigestItem) Validate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.Validate(
+		&validators.IntIsPresent{Field: d.UserID, Name: "UserID"},
+		&validators.IntIsPresent{Field: d.RequestID, Name: "RequestID"},
+		&validators.StringIsPresent{Field: d.PreferenceKey, Name: "PreferenceKey"},
+	), nil
+}
+
+// ValidateCreate gets run every time you call "pop.ValidateAndCreate" method.
+func (d *DigestItem) ValidateCreate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.NewErrors(), nil
+}
+
+// ValidateUpdate gets run every time you call "pop.ValidateAndUpdate" method.
+func (d *DigestItem) ValidateUpdate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.NewErrors(), nil
+}
+
+// Create stores the DigestItem data as a new record in the database, unless the request is already queued for the
+// user's digest.
+func (d *DigestItem) Create() error {
+	var c Count
+	err := DB.RawQuery("SELECT COUNT(*) FROM digest_items WHERE user_id = ? AND request_id = ?",
+		d.UserID, d.RequestID).First(&c)
+	if err != nil {
+		return err
+	}
+	if c.N > 0 {
+		return nil
+	}
+
+	return create(d)
+}
+
+// FindByUser loads all of the given user's queued digest items, oldest first, along with their requests
+func (d *DigestItems) FindByUser(user User) error {
+	return DB.Eager("Request").Where("user_id = ?", user.ID).Order("id asc").All(d)
+}
+
+// RemoveForUser deletes the given user's digest items up to and including the one with the given ID
+func (d *DigestItems) RemoveForUser(user User, lastID int) error {
+	return DB.RawQuery("DELETE FROM digest_items WHERE user_id = ? AND id <= ?", user.ID, lastID).Exec()
+}
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
+func (ms *ModelSuite) TestDigestItem_Create() {
+	t := ms.T()
+
+	users := createUserFixtures(ms.DB, 2).Users
+	requests := createRequestFixtures(ms.DB, 2, false)
+
+	tests := []struct {
+		name    string
+		item    DigestItem
+		wantN   int
+		wantErr bool
+	}{
+		{
+			name:  "new item",
+			item:  DigestItem{UserID: users[1].ID, RequestID: requests[0].ID, PreferenceKey: domain.UserPreferenceKeyNotifyNewRequest},
+			wantN: 1,
+		},
+		{
+			name:  "request already queued",
+			item:  DigestItem{UserID: users[1].ID, RequestID: requests[0].ID, PreferenceKey: domain.UserPreferenceKeyNotifyWatchMatch},
+			wantN: 1,
+		},
+		{
+			name:  "another request",
+			item:  DigestItem{UserID: users[1].ID, RequestID: requests[1].ID, PreferenceKey: domain.UserPreferenceKeyNotifyWatchMatch},
+			wantN: 2,
+		},
+		{
+			name:    "missing preference key",
+			item:    DigestItem{UserID: users[0].ID, RequestID: requests[1].ID},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.item.Create()
+			if test.wantErr {
+				ms.Error(err)
+				return
+			}
+			ms.NoError(err)
+
+			var items DigestItems
+			ms.NoError(items.FindByUser(users[1]))
+			ms.Equal(test.wantN, len(items), "incorrect number of digest items")
+		})
+	}
+}
+
+func (ms *ModelSuite) TestDigestItems_RemoveForUser() {
+	users := createUserFixtures(ms.DB, 2).Users
+	requests := createRequestFixtures(ms.DB, 3, false)
+
+	items := DigestItems{
+		{UserID: users[1].ID, RequestID: requests[0].ID, PreferenceKey: domain.UserPreferenceKeyNotifyNewRequest},
+		{UserID: users[1].ID, RequestID: requests[1].ID, PreferenceKey: domain.UserPreferenceKeyNotifyNewRequest},
+		{UserID: users[1].ID, RequestID: requests[2].ID, PreferenceKey: domain.UserPreferenceKeyNotifyNewRequest},
+		{UserID: users[0].ID, RequestID: requests[0].ID, PreferenceKey: domain.UserPreferenceKeyNotifyNewRequest},
+	}
+	for i := range items {
+		createFixture(ms, &items[i])
+	}
+
+	var d DigestItems
+	ms.NoError(d.RemoveForUser(users[1], items[1].ID))
+
+	ms.NoError(d.FindByUser(users[1]))
+	ms.Equal(1, len(d), "incorrect number of digest items remaining")
+	ms.Equal(items[2].ID, d[0].ID, "wrong digest item remaining")
+
+	ms.NoError(d.FindByUser(users[0]))
+	ms.Equal(1, len(d), "another user's digest items were removed")
+}
@@ -0,0 +1,189 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gobuffalo/nulls"
+	"github.com/gobuffalo/pop"
+	"github.com/gobuffalo/validate"
+	"github.com/gobuffalo/validate/validators"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
+type EmailSuppressionReason string
+
+const (
+	EmailSuppressionReasonBounce    EmailSuppressionReason = "BOUNCE"
+	EmailSuppressionReasonComplaint EmailSuppressionReason = "COMPLAINT"
+)
+
+func (e EmailSuppressionReason) IsValid() bool {
+	switch e {
+	case EmailSuppressionReasonBounce, EmailSuppressionReasonComplaint:
+		return true
+	}
+	return false
+}
+
+func (e EmailSuppressionReason) String() string {
+	return string(e)
+}
+
+func (e *EmailSuppressionReason) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EmailSuppressionReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EmailSuppressionReason", str)
+	}
+	return nil
+}
+
+func (e EmailSuppressionReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// EmailSuppression is an email address that no longer receives email from the App, because mail to it bounced
+// permanently or its owner reported it as spam
+type EmailSuppression struct {
+	ID        int                    `json:"id" db:"id"`
+	CreatedAt time.Time              `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time              `json:"updated_at" db:"updated_at"`
+	Email     string                 `json:"email" db:"email"`
+	UserID    nulls.Int              `json:"user_id" db:"user_id"`
+	Reason    EmailSuppressionReason `json:"reason" db:"reason"`
+	Source    string                 `json:"source" db:"source"`
+	Details   nulls.String           `json:"details" db:"details"`
+}
+
+// String can be helpful for serializing the model
+func (e EmailSuppression) String() string {
+	je, _ := json.Marshal(e)
+	return string(je)
+}
+
+// EmailSuppressions is used for methods that operate on lists of objects
+type EmailSuppressions []EmailSuppression
+
+// String can be helpful for serializing the model
+func (e EmailSuppressions) String() string {
+	je, _ := json.Marshal(e)
+	return string(je)
+}
+
+// Validate gets run every time you call a "pop.Validate*" (pop.ValidateAndSave, pop.ValidateAndCreate, pop.ValidateAndUpdate) method.
+func (e *EmailSuppression) Validate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.Validate(
+		&validators.EmailIsPresent{Field: e.Email, Name: "Email"},
+		&validators.StringIsPresent{Field: e.Source, Name: "Source"},
+		&emailSuppressionReasonValidator{Field: e.Reason, Name: "Reason"},
+	), nil
+}
+
+type emailSuppressionReasonValidator struct {
+	Name    string
+	Field   EmailSuppressionReason
+	Message string
+}
+
+func (v *emailSuppressionReasonValidator) IsValid(errors *validate.Errors) {
+	if v.Field.IsValid() {
+		return
+	}
+	v.Message = fmt.Sprintf("%s is not a valid email suppression reason", v.Field)
+	errors.Add(validators.GenerateKey(v.Name), v.Message)
+}
+
+// ValidateCreate gets run every time you call "pop.ValidateAndCreate" method.
+func (e *EmailSuppression) ValidateCreate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.NewErrors(), nil
+}
+
+// ValidateUpdate gets run every time you call "pop.ValidateAndUpdate" method.
+func (e *EmailSuppression) ValidateUpdate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.NewErrors(), nil
+}
+
+// normalizeEmail returns the form of an email address used for suppression lookups
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// SuppressEmail stops future email to the given address, recording the reason reported by the email service. If the
+// address is already suppressed, the record is updated with the latest report. The address is linked to the user
+// that has it, if any. Meeting invites to an address that bounced are marked as bounced.
+func SuppressEmail(email string, reason EmailSuppressionReason, source, details string) (EmailSuppression, error) {
+	var s EmailSuppression
+	email = normalizeEmail(email)
+
+	err := DB.Where("email = ?", email).First(&s)
+	if domain.IsOtherThanNoRows(err) {
+		return s, fmt.Errorf("error finding email suppression, %s", err)
+	}
+	isNew := err != nil
+
+	s.Email = email
+	s.Reason = reason
+	s.Source = source
+	s.Details = nulls.String{}
+	if details != "" {
+		s.Details = nulls.NewString(details)
+	}
+
+	var user User
+	err = DB.Where("lower(email) = ?", email).Order("id asc").First(&user)
+	if domain.IsOtherThanNoRows(err) {
+		return s, fmt.Errorf("error finding user with suppressed email, %s", err)
+	}
+	if err == nil {
+		s.UserID = nulls.NewInt(user.ID)
+	}
+
+	if reason == EmailSuppressionReasonBounce {
+		if err := markMeetingInvitesBounced(email); err != nil {
+			return s, fmt.Errorf("error marking meeting invites bounced, %s", err)
+		}
+	}
+
+	if isNew {
+		return s, create(&s)
+	}
+	return s, update(&s)
+}
+
+// IsEmailSuppressed returns true if email is no longer sent to the given address
+func IsEmailSuppressed(email string) (bool, error) {
+	n, err := DB.Where("email = ?", normalizeEmail(email)).Count(&EmailSuppression{})
+	if err != nil {
+		return false, fmt.Errorf("error checking email suppression, %s", err)
+	}
+	return n > 0, nil
+}
+
+// FindEmailSuppression returns the suppression of the given email address, or nil if email is being sent to it
+func FindEmailSuppression(email string) (*EmailSuppression, error) {
+	var s EmailSuppression
+	err := DB.Where("email = ?", normalizeEmail(email)).First(&s)
+	if domain.IsOtherThanNoRows(err) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, nil
+	}
+	return &s, nil
+}
+
+// GetEmailSuppression returns the suppression of the user's current email address, or nil if email is being sent to
+// it. The suppression no longer applies once the user's email address changes.
+func (u *User) GetEmailSuppression() (*EmailSuppression, error) {
+	return FindEmailSuppression(u.Email)
+}
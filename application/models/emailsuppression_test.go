@@ -0,0 +1,68 @@
+package models
+
+import (
+	"errors"
+	"strings"
+)
+
+func (ms *ModelSuite) TestSuppressEmail() {
+	users := createUserFixtures(ms.DB, 2).Users
+
+	s, err := SuppressEmail(strings.ToUpper(users[0].Email), EmailSuppressionReasonBounce, "ses", "General 550")
+	ms.NoError(err)
+	ms.Equal(strings.ToLower(users[0].Email), s.Email, "email should be normalized")
+	ms.Equal(users[0].ID, s.UserID.Int, "suppression was not linked to the user")
+	ms.Equal("General 550", s.Details.String)
+
+	again, err := SuppressEmail(users[0].Email, EmailSuppressionReasonComplaint, "sendgrid", "")
+	ms.NoError(err)
+	ms.Equal(s.ID, again.ID, "a second report should update the existing suppression")
+	ms.Equal(EmailSuppressionReasonComplaint, again.Reason)
+	ms.False(again.Details.Valid, "details should be replaced")
+
+	unknown, err := SuppressEmail("nobody@example.com", EmailSuppressionReasonBounce, "ses", "")
+	ms.NoError(err)
+	ms.False(unknown.UserID.Valid, "address without a user should not be linked")
+
+	_, err = SuppressEmail("nobody@example.com", EmailSuppressionReason("DEFERRED"), "ses", "")
+	ms.Error(err, "invalid reason was accepted")
+
+	suppressed, err := IsEmailSuppressed(" " + strings.ToUpper(users[0].Email))
+	ms.NoError(err)
+	ms.True(suppressed, "suppressed address was not found")
+
+	suppressed, err = IsEmailSuppressed(users[1].Email)
+	ms.NoError(err)
+	ms.False(suppressed, "address should not be suppressed")
+}
+
+func (ms *ModelSuite) TestUser_GetEmailSuppression() {
+	users := createUserFixtures(ms.DB, 2).Users
+	_, err := SuppressEmail(users[0].Email, EmailSuppressionReasonBounce, "ses", "")
+	ms.NoError(err)
+
+	got, err := users[0].GetEmailSuppression()
+	ms.NoError(err)
+	ms.NotNil(got, "user should be flagged")
+	ms.Equal(EmailSuppressionReasonBounce, got.Reason)
+
+	got, err = users[1].GetEmailSuppression()
+	ms.NoError(err)
+	ms.Nil(got, "user should not be flagged")
+
+	users[0].Email = "updated@example.com"
+	ms.NoError(users[0].Save())
+	got, err = users[0].GetEmailSuppression()
+	ms.NoError(err)
+	ms.Nil(got, "flag should clear when the email address changes")
+}
+
+func (ms *ModelSuite) TestOutboxMessage_Abandon() {
+	users := createUserFixtures(ms.DB, 2).Users
+	messages := createOutboxMessageFixtures(ms, users)
+
+	ms.NoError(messages[0].Abandon(errors.New("recipient address is suppressed")))
+	ms.Equal(OutboxMessageStatusDead, messages[0].Status)
+	ms.Equal(0, messages[0].Attempts, "abandoning is not a delivery attempt")
+	ms.Equal("recipient address is suppressed", messages[0].LastError.String)
+}
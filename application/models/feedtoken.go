@@ -0,0 +1,135 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gobuffalo/nulls"
+	"github.com/gobuffalo/pop"
+	"github.com/gobuffalo/validate"
+	"github.com/gobuffalo/validate/validators"
+	"github.com/gofrs/uuid"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
+// ErrInvalidFeedToken is returned for a feed token that does not exist or has been revoked
+var ErrInvalidFeedToken = errors.New("invalid feed token")
+
+// FeedToken authenticates a user's RSS, Atom and JSON feeds, which are read by feed readers that cannot log in. Only a
+// hash of the token is stored. A user may have several tokens, e.g. one per feed reader, and may revoke any of them.
+type FeedToken struct {
+	ID         int        `json:"id" db:"id"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at" db:"updated_at"`
+	UUID       uuid.UUID  `json:"uuid" db:"uuid"`
+	UserID     int        `json:"user_id" db:"user_id"`
+	TokenHash  string     `json:"-" db:"token_hash"`
+	LastUsedAt nulls.Time `json:"last_used_at" db:"last_used_at"`
+
+	// Token is the plain token, which is only available when the FeedToken is created
+	Token string `json:"-" db:"-"`
+}
+
+// String can be helpful for serializing the model
+func (f FeedToken) String() string {
+	jf, _ := json.Marshal(f)
+	return string(jf)
+}
+
+// FeedTokens is used for methods that operate on lists of objects
+type FeedTokens []FeedToken
+
+// String can be helpful for serializing the model
+func (f FeedTokens) String() string {
+	jf, _ := json.Marshal(f)
+	return string(jf)
+}
+
+// Validate gets run every time you call a "pop.Validate*" (pop.ValidateAndSave, pop.ValidateAndCreate, pop.ValidateAndUpdate) method.
+func (f *FeedToken) Validate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.Validate(
+		&validators.UUIDIsPresent{Field: f.UUID, Name: "UUID"},
+		&validators.IntIsPresent{Field: f.UserID, Name: "UserID"},
+		&validators.StringIsPresent{Field: f.TokenHash, Name: "TokenHash"},
+	), nil
+}
+
+// ValidateCreate gets run every time you call "pop.ValidateAndCreate" method.
+func (f *FeedToken) ValidateCreate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.NewErrors(), nil
+}
+
+// ValidateUpdate gets run every time you call "pop.ValidateAndUpdate" method.
+func (f *FeedToken) ValidateUpdate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.NewErrors(), nil
+}
+
+// Create generates a new token and stores its hash as a new record in the database. The plain token is available in
+// `Token` until the FeedToken is reloaded.
+func (f *FeedToken) Create() error {
+	token, err := getRandomToken()
+	if err != nil {
+		return fmt.Errorf("error creating feed token, %s", err)
+	}
+	f.Token = token
+	f.TokenHash = HashClientIdAccessToken(token)
+
+	return create(f)
+}
+
+// Destroy revokes the feed token
+func (f *FeedToken) Destroy() error {
+	return DB.Destroy(f)
+}
+
+// FindByUUID loads from DB the FeedToken record identified by the given UUID
+func (f *FeedToken) FindByUUID(id string) error {
+	if id == "" {
+		return errors.New("error: feed token uuid must not be blank")
+	}
+
+	if err := DB.Where("uuid = ?", id).First(f); err != nil {
+		return fmt.Errorf("error finding feed token by uuid: %s", err.Error())
+	}
+
+	return nil
+}
+
+// FindByToken loads the FeedToken matching the given plain token. ErrInvalidFeedToken is returned if there is none.
+func (f *FeedToken) FindByToken(token string) error {
+	if token == "" {
+		return ErrInvalidFeedToken
+	}
+
+	if err := DB.Where("token_hash = ?", HashClientIdAccessToken(token)).First(f); err != nil {
+		if domain.IsOtherThanNoRows(err) {
+			return fmt.Errorf("error finding feed token, %s", err)
+		}
+		return ErrInvalidFeedToken
+	}
+
+	return nil
+}
+
+// FindByUser returns all of the given user's feed tokens, newest first
+func (f *FeedTokens) FindByUser(user User) error {
+	return DB.Where("user_id = ?", user.ID).Order("created_at desc").All(f)
+}
+
+// GetUser returns the user whose feeds are authenticated by the token
+func (f *FeedToken) GetUser() (User, error) {
+	var user User
+	if err := DB.Find(&user, f.UserID); err != nil {
+		return user, err
+	}
+	return user, nil
+}
+
+// UpdateLastUsedAt records the time the token was last used to read a feed
+func (f *FeedToken) UpdateLastUsedAt(t time.Time) error {
+	f.LastUsedAt = nulls.NewTime(t)
+	return DB.RawQuery("UPDATE feed_tokens SET last_used_at = ? WHERE id = ?", t, f.ID).Exec()
+}
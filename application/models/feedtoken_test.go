@@ -0,0 +1,78 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
+func (ms *ModelSuite) TestFeedToken_Validate() {
+	t := ms.T()
+	tests := []struct {
+		name     string
+		token    FeedToken
+		wantErr  bool
+		errField string
+	}{
+		{
+			name:  "minimum",
+			token: FeedToken{UUID: domain.GetUUID(), UserID: 1, TokenHash: "hash"},
+		},
+		{
+			name:     "missing user_id",
+			token:    FeedToken{UUID: domain.GetUUID(), TokenHash: "hash"},
+			wantErr:  true,
+			errField: "user_id",
+		},
+		{
+			name:     "missing token_hash",
+			token:    FeedToken{UUID: domain.GetUUID(), UserID: 1},
+			wantErr:  true,
+			errField: "token_hash",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			vErr, _ := test.token.Validate(DB)
+			if test.wantErr {
+				ms.True(len(vErr.Get(test.errField)) > 0, "Expected an error on field %v, but got none (errors: %v)",
+					test.errField, vErr.Errors)
+				return
+			}
+			ms.False(vErr.HasAny(), "Unexpected error: %v", vErr)
+		})
+	}
+}
+
+func (ms *ModelSuite) TestFeedToken_FindByToken() {
+	users := createUserFixtures(ms.DB, 2).Users
+
+	tokens := FeedTokens{{UserID: users[0].ID}, {UserID: users[0].ID}, {UserID: users[1].ID}}
+	for i := range tokens {
+		ms.NoError(tokens[i].Create())
+		ms.NotEmpty(tokens[i].Token, "plain token was not generated")
+		ms.NotEqual(tokens[i].Token, tokens[i].TokenHash, "plain token was stored")
+	}
+
+	var found FeedToken
+	ms.NoError(found.FindByToken(tokens[1].Token))
+	ms.Equal(tokens[1].ID, found.ID, "wrong feed token found")
+	ms.Empty(found.Token, "plain token should not be available after loading")
+
+	ms.Equal(ErrInvalidFeedToken, found.FindByToken(""), "empty token should be invalid")
+	ms.Equal(ErrInvalidFeedToken, found.FindByToken("bad token"), "unknown token should be invalid")
+
+	var userTokens FeedTokens
+	ms.NoError(userTokens.FindByUser(users[0]))
+	ms.Equal(2, len(userTokens), "incorrect number of user's feed tokens")
+
+	ms.NoError(tokens[1].Destroy())
+	ms.Equal(ErrInvalidFeedToken, found.FindByToken(tokens[1].Token), "revoked token should be invalid")
+
+	now := time.Now()
+	ms.NoError(tokens[0].UpdateLastUsedAt(now))
+	ms.NoError(found.FindByUUID(tokens[0].UUID.String()))
+	ms.True(found.LastUsedAt.Valid, "last used time was not recorded")
+	ms.WithinDuration(now, found.LastUsedAt.Time, time.Second, "incorrect last used time")
+}
@@ -113,8 +113,37 @@ func (l *Location) DistanceKm(loc2 Location) float64 {
 
 // IsNear answers the question "Are these two locations near each other?"
 func (l *Location) IsNear(loc2 Location) bool {
+	return l.IsWithin(loc2, domain.DefaultProximityDistanceKm)
+}
+
+// IsWithin answers the question "Is the distance between these two locations less than the given distance?"
+func (l *Location) IsWithin(loc2 Location, km float64) bool {
 	d := l.DistanceKm(loc2)
-	return !math.IsNaN(d) && d < domain.DefaultProximityDistanceKm
+	return !math.IsNaN(d) && d < km
+}
+
+// nearCondition returns an SQL condition that is true if the location in the table with the given alias is within
+// the given distance of loc. The distance is an SQL expression, in km. It is calculated with the same formula as
+// DistanceKm, so that the result is the same as IsWithin. If loc has no coordinates, the condition is always false.
+// The argument of asin is limited to 1 to avoid a database error where rounding gives an out of range value for
+// antipodal points, which DistanceKm reports as NaN, i.e. not near.
+func nearCondition(alias string, loc Location, km string) (string, []interface{}) {
+	if !loc.Latitude.Valid || !loc.Longitude.Valid {
+		return "FALSE", nil
+	}
+
+	lat := alias + ".latitude::float8"
+	lon := alias + ".longitude::float8"
+	p := "(pi() / 180)"
+	condition := fmt.Sprintf("12742 * asin(least(1, sqrt(0.5 - cos((? - %[1]s) * %[3]s) / 2 + "+
+		"cos(%[1]s * %[3]s) * cos(? * %[3]s) * (1 - cos((? - %[2]s) * %[3]s)) / 2))) < %[4]s", lat, lon, p, km)
+
+	args := []interface{}{
+		loc.Latitude.Float64,
+		loc.Latitude.Float64,
+		loc.Longitude.Float64,
+	}
+	return condition, args
 }
 
 // FindByIDs finds all Locations associated with the given IDs and loads them from the database
@@ -3,9 +3,13 @@ package models
 import (
 	"encoding/json"
 	"fmt"
+	"io"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gobuffalo/buffalo"
+	"github.com/gobuffalo/events"
 	"github.com/gobuffalo/nulls"
 	"github.com/gobuffalo/pop"
 	"github.com/gobuffalo/validate"
@@ -16,6 +20,46 @@ import (
 	"github.com/silinternational/wecarry-api/domain"
 )
 
+// MeetingVisibility determines which users can see a Meeting, in addition to its creator, organizers, participants and
+// invitees
+type MeetingVisibility string
+
+const (
+	MeetingVisibilityAll          MeetingVisibility = "ALL"
+	MeetingVisibilityTrusted      MeetingVisibility = "TRUSTED"
+	MeetingVisibilityOrganization MeetingVisibility = "ORGANIZATION"
+	MeetingVisibilityInviteOnly   MeetingVisibility = "INVITE_ONLY"
+)
+
+func (e MeetingVisibility) IsValid() bool {
+	switch e {
+	case MeetingVisibilityAll, MeetingVisibilityTrusted, MeetingVisibilityOrganization, MeetingVisibilityInviteOnly:
+		return true
+	}
+	return false
+}
+
+func (e MeetingVisibility) String() string {
+	return string(e)
+}
+
+func (e *MeetingVisibility) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MeetingVisibility(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MeetingVisibility", str)
+	}
+	return nil
+}
+
+func (e MeetingVisibility) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // Meeting represents an event where people gather together from different locations
 type Meeting struct {
 	ID          int          `json:"id" db:"id"`
@@ -32,6 +76,13 @@ type Meeting struct {
 	FileID      nulls.Int    `json:"file_id" db:"file_id"`
 	LocationID  int          `json:"location_id" db:"location_id"`
 
+	// OrganizationID is the organization of the meeting creator, used for TRUSTED and ORGANIZATION visibility
+	OrganizationID nulls.Int         `json:"organization_id" db:"organization_id"`
+	Visibility     MeetingVisibility `json:"visibility" db:"visibility"`
+
+	// Sequence is the revision number of the meeting in calendars. It is incremented by Update.
+	Sequence int `json:"sequence" db:"sequence"`
+
 	ImgFile  *File    `belongs_to:"files" fk_id:"FileID"`
 	Location Location `belongs_to:"locations"`
 }
@@ -62,6 +113,7 @@ func (m *Meeting) Validate(tx *pop.Connection) (*validate.Errors, error) {
 		&validators.IntIsPresent{Field: m.CreatedByID, Name: "CreatedByID"},
 		&validators.IntIsPresent{Field: m.LocationID, Name: "LocationID"},
 		&dateValidator{StartDate: m.StartDate, EndDate: m.EndDate, Name: "Dates"},
+		&meetingVisibilityValidator{Visibility: m.Visibility, Name: "Visibility"},
 	), nil
 }
 
@@ -96,6 +148,29 @@ func (v *dateValidator) IsValid(errors *validate.Errors) {
 	errors.Add(validators.GenerateKey(v.Name), v.Message)
 }
 
+type meetingVisibilityValidator struct {
+	Name       string
+	Visibility MeetingVisibility
+	Message    string
+}
+
+// IsValid accepts an empty value, which is replaced by MeetingVisibilityAll when the meeting is saved
+func (v *meetingVisibilityValidator) IsValid(errors *validate.Errors) {
+	if v.Visibility == "" || v.Visibility.IsValid() {
+		return
+	}
+	v.Message = fmt.Sprintf("%s is not a valid meeting visibility", v.Visibility)
+	errors.Add(validators.GenerateKey(v.Name), v.Message)
+}
+
+// BeforeSave sets the default visibility
+func (m *Meeting) BeforeSave(tx *pop.Connection) error {
+	if m.Visibility == "" {
+		m.Visibility = MeetingVisibilityAll
+	}
+	return nil
+}
+
 // FindByUUID finds a meeting by the UUID field and loads its CreatedBy field
 func (m *Meeting) FindByUUID(uuid string) error {
 	if uuid == "" {
@@ -109,6 +184,19 @@ func (m *Meeting) FindByUUID(uuid string) error {
 	return nil
 }
 
+// FindByID finds a meeting by the ID field
+func (m *Meeting) FindByID(id int) error {
+	if id <= 0 {
+		return errors.New("error finding meeting: id must be a positive number")
+	}
+
+	if err := DB.Find(m, id); err != nil {
+		return fmt.Errorf("error finding meeting by id: %v, ... %v", id, err.Error())
+	}
+
+	return nil
+}
+
 func getOrdered(m *Meetings, q *pop.Query) error {
 	return q.Order("start_date asc").All(m)
 }
@@ -127,39 +215,69 @@ func (m *Meetings) FindOnDate(timeInFocus time.Time) error {
 	return nil
 }
 
-// FindOnOrAfterDate finds the meetings that have an EndDate on or after the timeInFocus-date
-func (m *Meetings) FindOnOrAfterDate(timeInFocus time.Time) error {
+// MeetingFilterParams are optional filters for Meetings.FindByFilter. Dates are compared by day.
+type MeetingFilterParams struct {
+	EndAfter    *time.Time
+	EndBefore   *time.Time
+	StartAfter  *time.Time
+	StartBefore *time.Time
+	SearchText  *string
 
-	date := timeInFocus.Format(domain.DateTimeFormat)
-
-	if err := getOrdered(m, DB.Where("end_date >= ?", date)); err != nil {
-		return fmt.Errorf("error finding meeting with end_date before %s ... %s", date, err.Error())
-	}
+	// Location limits the meetings to those with a location near the given location
+	Location *Location
+}
 
-	return nil
+// hasDates returns true if any of the date filters is given
+func (f MeetingFilterParams) hasDates() bool {
+	return f.EndAfter != nil || f.EndBefore != nil || f.StartAfter != nil || f.StartBefore != nil
 }
 
-// FindAfterDate finds the meetings that have a StartDate after the timeInFocus-date
-func (m *Meetings) FindAfterDate(timeInFocus time.Time) error {
-	date := timeInFocus.Format(domain.DateTimeFormat)
+// FindByFilter finds one page of the meetings visible to the user, ordered by start date, that match all of the given
+// filters. If no date filter is given, only meetings with an EndDate of today or later are included. `page` starts at 1.
+func (m *Meetings) FindByFilter(user User, filter MeetingFilterParams, page, perPage int) error {
+	visible, args := meetingVisibleCondition("m", user)
+	where := []string{visible}
 
-	if err := getOrdered(m, DB.Where("start_date > ?", date)); err != nil {
-		return fmt.Errorf("error finding meeting with start_date after %s ... %s", date, err.Error())
+	dateConditions := []struct {
+		date      *time.Time
+		condition string
+	}{
+		{filter.EndAfter, "m.end_date > ?::date"},
+		{filter.EndBefore, "m.end_date < ?::date"},
+		{filter.StartAfter, "m.start_date > ?::date"},
+		{filter.StartBefore, "m.start_date < ?::date"},
+	}
+	for _, d := range dateConditions {
+		if d.date != nil {
+			where = append(where, d.condition)
+			args = append(args, d.date.Format(domain.DateFormat))
+		}
+	}
+	if !filter.hasDates() {
+		where = append(where, "m.end_date >= ?::date")
+		args = append(args, time.Now().Format(domain.DateFormat))
 	}
 
-	return nil
-}
+	if filter.SearchText != nil {
+		// strpos rather than LIKE, so that wildcard characters in the search text are matched literally
+		where = append(where, "(strpos(LOWER(m.name), ?) > 0 OR strpos(LOWER(coalesce(m.description, '')), ?) > 0)")
+		text := strings.ToLower(*filter.SearchText)
+		args = append(args, text, text)
+	}
+
+	if filter.Location != nil {
+		near, nearArgs := nearCondition("l", *filter.Location, strconv.Itoa(domain.DefaultProximityDistanceKm))
+		where = append(where, near)
+		args = append(args, nearArgs...)
+	}
 
-// FindRecent finds the meetings that have an EndDate within the past <domain.RecentMeetingDelay> days
-// before timeInFocus-date (not inclusive)
-func (m *Meetings) FindRecent(timeInFocus time.Time) error {
-	yesterday := timeInFocus.Add(-domain.DurationDay).Format(domain.DateTimeFormat)
-	recentDate := timeInFocus.Add(-domain.RecentMeetingDelay)
-	where := "end_date between ? and ?"
+	q := "SELECT m.* FROM meetings m JOIN locations l ON l.id = m.location_id WHERE " + strings.Join(where, " AND ") +
+		" ORDER BY m.start_date ASC, m.id ASC LIMIT ? OFFSET ?"
+	args = append(args, perPage, (page-1)*perPage)
 
-	if err := getOrdered(m, DB.Where(where, recentDate, yesterday)); err != nil {
-		return fmt.Errorf("error finding meeting with end_date between %s and %s ... %s",
-			recentDate, yesterday, err.Error())
+	*m = Meetings{}
+	if err := DB.RawQuery(q, args...).All(m); err != nil {
+		return fmt.Errorf("error finding meetings, %s", err)
 	}
 
 	return nil
@@ -170,7 +288,8 @@ func (m *Meeting) FindByInviteCode(code string) error {
 		return errors.New("error finding meeting: invite_code must not be blank")
 	}
 
-	if err := DB.Where("invite_code = ?", code).First(m); err != nil {
+	if err := DB.Where("invite_code = ? OR id IN (SELECT meeting_id FROM meeting_invites WHERE secret = ?)",
+		code, code).First(m); err != nil {
 		return fmt.Errorf("error finding meeting by invite_code: %s", err.Error())
 	}
 
@@ -223,13 +342,34 @@ func (m *Meeting) GetLocation() (Location, error) {
 	return location, nil
 }
 
+// MeetingCreatedEventData is the event payload for a new meeting
+type MeetingCreatedEventData struct {
+	MeetingID int
+}
+
 // Create stores the Meeting data as a new record in the database.
 func (m *Meeting) Create() error {
 	return create(m)
 }
 
-// Update writes the Meeting data to an existing database record.
+// AfterCreate is called by Pop after successful creation of the record
+func (m *Meeting) AfterCreate(tx *pop.Connection) error {
+	e := events.Event{
+		Kind:    domain.EventApiMeetingCreated,
+		Message: "Meeting created",
+		Payload: events.Payload{"eventData": MeetingCreatedEventData{
+			MeetingID: m.ID,
+		}},
+	}
+
+	emitEvent(e)
+	return nil
+}
+
+// Update writes the Meeting data to an existing database record, and increments the calendar sequence number so that
+// calendar clients pick up the change.
 func (m *Meeting) Update() error {
+	m.Sequence++
 	return update(m)
 }
 
@@ -272,7 +412,7 @@ func (m *Meeting) Invites(ctx buffalo.Context) (MeetingInvites, error) {
 		return i, nil
 	}
 	currentUser := CurrentUser(ctx)
-	if currentUser.ID != m.CreatedByID && !m.isOrganizer(ctx, currentUser.ID) && !currentUser.isSuperAdmin() {
+	if !currentUser.canManageMeeting(ctx, *m) {
 		return i, nil
 	}
 	if err := DB.Where("meeting_id = ?", m.ID).All(&i); err != nil {
@@ -289,7 +429,7 @@ func (m *Meeting) Participants(ctx buffalo.Context) (MeetingParticipants, error)
 		return p, nil
 	}
 	currentUser := CurrentUser(ctx)
-	if currentUser.ID != m.CreatedByID && !m.isOrganizer(ctx, currentUser.ID) && !currentUser.isSuperAdmin() {
+	if !currentUser.canManageMeeting(ctx, *m) {
 		return p, DB.Where("user_id = ? AND meeting_id = ?", currentUser.ID, m.ID).All(&p)
 	}
 	if err := DB.Where("meeting_id = ?", m.ID).All(&p); err != nil {
@@ -317,6 +457,40 @@ func (m *Meeting) Organizers(ctx buffalo.Context) (Users, error) {
 	return u, nil
 }
 
+// ParticipantUsers returns the users who are participants in this Meeting, e.g. for delivering announcements. No
+// authorization is checked.
+func (m *Meeting) ParticipantUsers() (Users, error) {
+	var u Users
+	if err := DB.
+		Where("meeting_participants.meeting_id = ?", m.ID).
+		Join("meeting_participants", "meeting_participants.user_id = users.id").
+		All(&u); err != nil {
+
+		return u, err
+	}
+	return u, nil
+}
+
+// Announcements returns the announcements posted to this Meeting, newest first. Only the meeting creator and its
+// participants, including organizers, can see the announcements; an empty list is returned for anyone else.
+func (m *Meeting) Announcements(ctx buffalo.Context) (MeetingAnnouncements, error) {
+	a := MeetingAnnouncements{}
+	currentUser := CurrentUser(ctx)
+	if currentUser.ID != m.CreatedByID {
+		var participant MeetingParticipant
+		if err := participant.FindByMeetingIDAndUserID(m.ID, currentUser.ID); err != nil {
+			if domain.IsOtherThanNoRows(err) {
+				return a, err
+			}
+			return a, nil
+		}
+	}
+	if err := DB.Where("meeting_id = ?", m.ID).Order("created_at desc, id desc").All(&a); err != nil {
+		return a, fmt.Errorf("error getting announcements for meeting id %v, %s", m.ID, err)
+	}
+	return a, nil
+}
+
 func (m *Meeting) RemoveInvite(ctx buffalo.Context, email string) error {
 	var invite MeetingInvite
 	if err := invite.FindByMeetingIDAndEmail(m.ID, email); err != nil {
@@ -337,6 +511,83 @@ func (m *Meeting) RemoveParticipant(ctx buffalo.Context, userUUID string) error
 	return participant.Destroy()
 }
 
+// ErrLastMeetingOrganizer is returned by Meeting.RemoveOrganizer if the user is the only organizer of the meeting
+var ErrLastMeetingOrganizer = errors.New("cannot remove the last organizer of a meeting")
+
+// ErrMeetingNotVisible is returned by Meeting.AddOrganizer if the user cannot see the meeting
+var ErrMeetingNotVisible = errors.New("meeting is not visible to the user")
+
+// MeetingOrganizerAddedEventData is the event payload for a new meeting organizer
+type MeetingOrganizerAddedEventData struct {
+	MeetingID int
+	UserID    int
+	AddedByID int
+}
+
+// AddOrganizer makes the user an organizer of the meeting, adding them as a participant if necessary, and emits an
+// event to notify them. Adding an existing organizer has no effect. A user who is not yet a participant must be able
+// to see the meeting, otherwise ErrMeetingNotVisible is returned.
+func (m *Meeting) AddOrganizer(ctx buffalo.Context, user, addedBy User) error {
+	var participant MeetingParticipant
+	if err := participant.FindByMeetingIDAndUserID(m.ID, user.ID); domain.IsOtherThanNoRows(err) {
+		return err
+	}
+	if participant.IsOrganizer {
+		return nil
+	}
+	if participant.ID == 0 && !user.CanViewMeeting(ctx, *m) {
+		return ErrMeetingNotVisible
+	}
+
+	participant.IsOrganizer = true
+	if participant.ID == 0 {
+		participant.MeetingID = m.ID
+		participant.UserID = user.ID
+		if err := DB.Create(&participant); err != nil {
+			return err
+		}
+	} else if err := DB.UpdateColumns(&participant, "is_organizer", "updated_at"); err != nil {
+		return err
+	}
+
+	e := events.Event{
+		Kind:    domain.EventApiMeetingOrganizerAdded,
+		Message: "Meeting organizer added",
+		Payload: events.Payload{"eventData": MeetingOrganizerAddedEventData{
+			MeetingID: m.ID,
+			UserID:    user.ID,
+			AddedByID: addedBy.ID,
+		}},
+	}
+	emitEvent(e)
+	return nil
+}
+
+// RemoveOrganizer removes the organizer role from the user, who remains a participant of the meeting. The last
+// organizer cannot be removed.
+func (m *Meeting) RemoveOrganizer(user User) error {
+	var participant MeetingParticipant
+	if err := participant.FindByMeetingIDAndUserID(m.ID, user.ID); err != nil || !participant.IsOrganizer {
+		return fmt.Errorf("user %s is not an organizer of meeting %s", user.UUID, m.UUID)
+	}
+
+	// Lock the meeting's organizer rows while counting them, so that two organizers removed at the same time cannot
+	// both see the other as remaining
+	n, err := DB.RawQuery(`UPDATE meeting_participants SET is_organizer = false, updated_at = ?
+		WHERE id = ? AND is_organizer = true AND (
+			SELECT COUNT(*) FROM (
+				SELECT id FROM meeting_participants WHERE meeting_id = ? AND is_organizer = true FOR UPDATE
+			) organizers
+		) > 1`, time.Now(), participant.ID, m.ID).ExecWithCount()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrLastMeetingOrganizer
+	}
+	return nil
+}
+
 func (m *Meeting) IsCodeValid(code string) bool {
 	if m.InviteCode.Valid && m.InviteCode.UUID.String() == code {
 		return true
@@ -357,8 +608,71 @@ func (m *Meeting) isOrganizer(ctx buffalo.Context, userID int) bool {
 	return false
 }
 
+// isVisible returns true if the meeting is visible to the given user under the meeting visibility rules
 func (m *Meeting) isVisible(ctx buffalo.Context, userID int) bool {
-	return true
+	var user User
+	if err := DB.Find(&user, userID); err != nil {
+		domain.Error(ctx, fmt.Sprintf("isVisible() error finding user %d, %s", userID, err))
+		return false
+	}
+
+	condition, args := meetingVisibleCondition("m", user)
+	var c Count
+	err := DB.RawQuery("SELECT COUNT(*) FROM meetings m WHERE m.id = ? AND "+condition,
+		append([]interface{}{m.ID}, args...)...).First(&c)
+	if err != nil {
+		domain.Error(ctx, "isVisible() error checking meeting visibility, "+err.Error())
+		return false
+	}
+	return c.N > 0
+}
+
+// meetingVisibleCondition returns an SQL condition that is true if the meeting in the table with the given alias is
+// visible to the user. See meetingVisibleToUsersCondition for the visibility rules.
+func meetingVisibleCondition(alias string, user User) (string, []interface{}) {
+	if user.isSuperAdmin() {
+		return "TRUE", nil
+	}
+	condition := "EXISTS (SELECT 1 FROM users vu WHERE vu.id = ? AND " +
+		meetingVisibleToUsersCondition(alias, "vu") + ")"
+	return condition, []interface{}{user.ID}
+}
+
+// meetingVisibleToUsersCondition returns an SQL condition that is true if the meeting in the table with alias
+// `meetingAlias` is visible to the user in the table with alias `userAlias`. The meeting creator, participants
+// (including organizers) and invitees can always see a meeting. Otherwise, the meeting is visible if it is visible to
+// one of the user's organizations, as described by meetingVisibleToOrganizationsCondition. Super Admins can see all
+// meetings.
+func meetingVisibleToUsersCondition(meetingAlias, userAlias string) string {
+	userOrgs := fmt.Sprintf("SELECT organization_id FROM user_organizations WHERE user_id = %s.id", userAlias)
+	return fmt.Sprintf(`(
+		%[2]s.admin_role = '%[3]s'
+		OR %[1]s.created_by_id = %[2]s.id
+		OR %[1]s.id IN (SELECT meeting_id FROM meeting_participants WHERE user_id = %[2]s.id)
+		OR %[1]s.id IN (SELECT meeting_id FROM meeting_invites WHERE LOWER(email) = LOWER(%[2]s.email))
+		OR %[4]s
+	)`, meetingAlias, userAlias, UserAdminRoleSuperAdmin, meetingVisibleToOrganizationsCondition(meetingAlias, userOrgs))
+}
+
+// meetingVisibleToOrganizationsCondition returns an SQL condition that is true if the meeting in the table with alias
+// `meetingAlias` is visible to one of the organizations selected by the SQL expression `orgIDs`. ALL meetings are
+// visible to every organization, TRUSTED meetings to the meeting organization and the organizations that trust it,
+// ORGANIZATION meetings only to the meeting organization, and INVITE_ONLY meetings to none.
+func meetingVisibleToOrganizationsCondition(meetingAlias, orgIDs string) string {
+	return fmt.Sprintf(`(
+		%[1]s.visibility = '%[3]s'
+		OR %[1]s.visibility IN ('%[4]s', '%[5]s') AND %[1]s.organization_id IN (%[2]s)
+		OR %[1]s.visibility = '%[4]s' AND %[1]s.organization_id IN (
+			SELECT secondary_id FROM organization_trusts WHERE primary_id IN (%[2]s)
+		)
+	)`, meetingAlias, orgIDs, MeetingVisibilityAll, MeetingVisibilityTrusted, MeetingVisibilityOrganization)
+}
+
+// IsVisibleToOrganization returns true if the meeting can be seen by the users of the given organization under the
+// meeting visibility rules, regardless of their participation in the meeting
+func (m *Meeting) IsVisibleToOrganization(orgID int) (bool, error) {
+	return DB.Where("meetings.id = ? AND "+meetingVisibleToOrganizationsCondition("meetings", "?"),
+		m.ID, orgID, orgID).Exists(&Meeting{})
 }
 
 // FindByIDs finds all Meetings associated with the given IDs and loads them from the database
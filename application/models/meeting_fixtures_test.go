@@ -4,6 +4,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gobuffalo/nulls"
+
 	"github.com/silinternational/wecarry-api/domain"
 )
 
@@ -126,3 +128,77 @@ func createMeetingFixtures_CanUpdate(ms *ModelSuite) meetingFixtures {
 		Users:    uf.Users,
 	}
 }
+
+// meetingVisibilityFixtures are the fixtures of the meeting visibility test matrix
+type meetingVisibilityFixtures struct {
+	Meetings
+	Requests
+	Users
+}
+
+// createMeetingFixtures_Visibility creates one meeting of each visibility, all in Org0 and created by User0, each with
+// one request of ALL visibility. Org1 trusts Org0, and Org2 has no relationship to Org0. The users are:
+//
+// User0: the creator of the meetings, in Org0
+// User1: a participant in the meetings, in Org2
+// User2: an organizer of the meetings, in Org2
+// User3: invited to the meetings, in Org2
+// User4: a member of the meeting organization, Org0
+// User5: a member of Org1, which trusts the meeting organization
+// User6: no relationship to the meetings, in Org2
+// User7: a Super Admin, in Org2
+func createMeetingFixtures_Visibility(ms *ModelSuite) meetingVisibilityFixtures {
+	orgs := createOrganizationFixtures(ms.DB, 3)
+
+	createFixture(ms, &OrganizationTrust{PrimaryID: orgs[1].ID, SecondaryID: orgs[0].ID})
+
+	users := createUserFixtures(ms.DB, 8).Users
+	for i, orgIndex := range []int{0, 2, 2, 2, 0, 1, 2, 2} {
+		if orgIndex == 0 {
+			continue
+		}
+		uo, err := users[i].FindUserOrganization(orgs[0])
+		ms.NoError(err)
+		uo.OrganizationID = orgs[orgIndex].ID
+		ms.NoError(DB.UpdateColumns(&uo, "organization_id"))
+	}
+	users[7].AdminRole = UserAdminRoleSuperAdmin
+	ms.NoError(users[7].Save())
+
+	locations := createLocationFixtures(ms.DB, 4)
+	visibilities := []MeetingVisibility{MeetingVisibilityAll, MeetingVisibilityTrusted, MeetingVisibilityOrganization,
+		MeetingVisibilityInviteOnly}
+	meetings := make(Meetings, len(visibilities))
+	requests := createRequestFixtures(ms.DB, len(visibilities), false)
+	for i := range meetings {
+		meetings[i] = Meeting{
+			CreatedByID:    users[0].ID,
+			Name:           "Mtg " + visibilities[i].String(),
+			LocationID:     locations[i].ID,
+			StartDate:      time.Now(),
+			EndDate:        time.Now().Add(domain.DurationWeek),
+			OrganizationID: nulls.NewInt(orgs[0].ID),
+			Visibility:     visibilities[i],
+		}
+		createFixture(ms, &meetings[i])
+
+		createFixture(ms, &MeetingParticipant{MeetingID: meetings[i].ID, UserID: users[1].ID})
+		createFixture(ms, &MeetingParticipant{MeetingID: meetings[i].ID, UserID: users[2].ID, IsOrganizer: true})
+		createFixture(ms, &MeetingInvite{
+			MeetingID: meetings[i].ID,
+			InviterID: users[0].ID,
+			Secret:    domain.GetUUID(),
+			Email:     users[3].Email,
+		})
+
+		requests[i].MeetingID = nulls.NewInt(meetings[i].ID)
+		requests[i].Visibility = RequestVisibilityAll
+	}
+	ms.NoError(ms.DB.Update(&requests))
+
+	return meetingVisibilityFixtures{
+		Meetings: meetings,
+		Requests: requests,
+		Users:    users,
+	}
+}
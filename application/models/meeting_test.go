@@ -219,101 +219,73 @@ func getMeetingNames(meetings Meetings) []string {
 	return mNames
 }
 
-// TestMeeting_FindOnOrAfterDate tests the FindOnOrAfterDate function of the Meeting model
-func (ms *ModelSuite) TestMeeting_FindOnOrAfterDate() {
+// TestMeetings_FindByFilter tests the FindByFilter function of the Meeting model
+func (ms *ModelSuite) TestMeetings_FindByFilter() {
 	t := ms.T()
 
 	meetings := createMeetingFixtures_FindByTime(ms)
 
-	// Two days after meetings[2] ends
-	nearFuture := time.Time(meetings[2].EndDate).Add(domain.DurationDay * 2)
+	var creator User
+	ms.NoError(ms.DB.Find(&creator, meetings[0].CreatedByID))
 
-	// Two days after meetings[3] ends
-	farFuture := time.Time(meetings[3].EndDate).Add(domain.DurationDay * 2)
-
-	tests := []struct {
-		name    string
-		want    []string
-		testNow time.Time
-	}{
-		{name: "two for actual now", testNow: time.Now(), want: []string{meetings[2].Name, meetings[3].Name}},
-		{name: "one for now in future", testNow: nearFuture, want: []string{meetings[3].Name}},
-		{name: "empty for now in far future", testNow: farFuture, want: []string{}},
-	}
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			var meetings Meetings
-			err := meetings.FindOnOrAfterDate(test.testNow)
-			ms.NoError(err, "unexpected error")
-
-			mNames := getMeetingNames(meetings)
-			ms.Equal(test.want, mNames, "incorrect list of future meetings")
-		})
+	// put meetings[1] and meetings[3] near each other, and meetings[0] and meetings[2] far away
+	coordinates := [][2]float64{{-10, -10}, {10, 10}, {40, 40}, {10.1, 10.1}}
+	for i, c := range coordinates {
+		var location Location
+		ms.NoError(ms.DB.Find(&location, meetings[i].LocationID))
+		location.Latitude = nulls.NewFloat64(c[0])
+		location.Longitude = nulls.NewFloat64(c[1])
+		ms.NoError(ms.DB.Update(&location))
 	}
-}
-
-// TestMeeting_FindAfterDate tests the FindAfterDate function of the Meeting model
-func (ms *ModelSuite) TestMeeting_FindAfterDate() {
-	t := ms.T()
 
-	meetings := createMeetingFixtures_FindByTime(ms)
-
-	// Two days before meetings[2] starts
-	nearPast := time.Time(meetings[2].StartDate).Add(-domain.DurationDay * 2)
+	meetings[3].Description = nulls.NewString("A GATHERING of friends")
+	ms.NoError(ms.DB.Update(&meetings[3]))
+	meetings[2].Description = nulls.NewString("100% fun")
+	ms.NoError(ms.DB.Update(&meetings[2]))
 
-	// Two days after meetings[2] ends
-	nearFuture := time.Time(meetings[2].EndDate).Add(domain.DurationDay * 2)
+	now := time.Now()
+	lastMonth := now.Add(-domain.DurationDay * 30)
+	searchText := "gathering"
+	percent, underscore := "100%", "_"
+	nearby := Location{Latitude: nulls.NewFloat64(10), Longitude: nulls.NewFloat64(10)}
 
 	tests := []struct {
 		name    string
+		filter  MeetingFilterParams
+		page    int
+		perPage int
 		want    []string
-		testNow time.Time
 	}{
-		{name: "two for now in past", testNow: nearPast, want: []string{meetings[2].Name, meetings[3].Name}},
-		{name: "one for actual now", testNow: time.Now(), want: []string{meetings[3].Name}},
-		{name: "empty for now in near future", testNow: nearFuture, want: []string{}},
+		{name: "default", want: []string{meetings[2].Name, meetings[3].Name}},
+		{name: "recent", filter: MeetingFilterParams{EndAfter: &lastMonth, EndBefore: &now},
+			want: []string{meetings[1].Name}},
+		{name: "started", filter: MeetingFilterParams{StartBefore: &now},
+			want: []string{meetings[0].Name, meetings[1].Name, meetings[2].Name}},
+		{name: "not yet started", filter: MeetingFilterParams{StartAfter: &now}, want: []string{meetings[3].Name}},
+		{name: "search description", filter: MeetingFilterParams{SearchText: &searchText},
+			want: []string{meetings[3].Name}},
+		{name: "search for a percent sign", filter: MeetingFilterParams{SearchText: &percent},
+			want: []string{meetings[2].Name}},
+		{name: "search for an underscore", filter: MeetingFilterParams{SearchText: &underscore}, want: []string{}},
+		{name: "location", filter: MeetingFilterParams{Location: &nearby, EndBefore: &now},
+			want: []string{meetings[1].Name}},
+		{name: "location, default dates", filter: MeetingFilterParams{Location: &nearby},
+			want: []string{meetings[3].Name}},
+		{name: "page 2", filter: MeetingFilterParams{StartBefore: &now}, page: 2, perPage: 2,
+			want: []string{meetings[2].Name}},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			var meetings Meetings
-			err := meetings.FindAfterDate(test.testNow)
-			ms.NoError(err, "unexpected error")
-
-			mNames := getMeetingNames(meetings)
-			ms.Equal(test.want, mNames, "incorrect list of future meetings")
-		})
-	}
-}
-
-// TestMeeting_FindRecent tests the FindRecent function of the Meeting model
-func (ms *ModelSuite) TestMeeting_FindRecent() {
-	t := ms.T()
-
-	meetings := createMeetingFixtures_FindByTime(ms)
-
-	// Two days after meetings[2] ends
-	nearFuture := time.Time(meetings[2].EndDate).Add(domain.DurationDay * 2)
-
-	// Five weeks after meetings[3] ends
-	farFuture := time.Time(meetings[3].EndDate).Add(domain.DurationWeek * 5)
+			page, perPage := test.page, test.perPage
+			if page == 0 {
+				page, perPage = 1, domain.MaxPageSize
+			}
 
-	tests := []struct {
-		name    string
-		want    []string
-		testNow time.Time
-	}{
-		{name: "one for actual now", testNow: time.Now(), want: []string{meetings[1].Name}},
-		{name: "two for now in near future", testNow: nearFuture, want: []string{meetings[1].Name, meetings[2].Name}},
-		{name: "empty far now in far future", testNow: farFuture, want: []string{}},
-	}
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
 			var meetings Meetings
-			err := meetings.FindRecent(test.testNow)
+			err := meetings.FindByFilter(creator, test.filter, page, perPage)
 			ms.NoError(err, "unexpected error")
 
-			mNames := getMeetingNames(meetings)
-			ms.Equal(test.want, mNames, "incorrect list of future meetings")
+			ms.Equal(test.want, getMeetingNames(meetings), "incorrect list of meetings")
 		})
 	}
 }
@@ -329,6 +301,7 @@ func (ms *ModelSuite) TestMeeting_FindByInviteCode() {
 		wantErr bool
 	}{
 		{name: "good", code: f.Meetings[0].InviteCode.UUID.String(), want: f.Meetings[0]},
+		{name: "invite secret", code: f.MeetingInvites[2].Secret.String(), want: f.Meetings[1]},
 		{name: "blank uuid", code: "", wantErr: true},
 		{name: "wrong uuid", code: domain.GetUUID().String(), wantErr: true},
 	}
@@ -452,6 +425,21 @@ func (ms *ModelSuite) TestMeeting_CanUpdate() {
 	ms.False(mtg.CanUpdate(otherUser), "normal user (non meeting creator) should NOT be authorized")
 }
 
+func (ms *ModelSuite) TestMeeting_Update() {
+	f := createMeetingFixtures_CanUpdate(ms)
+	mtg := f.Meetings[0]
+	ms.Equal(0, mtg.Sequence, "new meeting should have sequence 0")
+
+	mtg.Name = "new name"
+	ms.NoError(mtg.Update())
+	ms.NoError(mtg.Update())
+
+	var found Meeting
+	ms.NoError(found.FindByUUID(mtg.UUID.String()))
+	ms.Equal("new name", found.Name, "meeting name was not updated")
+	ms.Equal(2, found.Sequence, "meeting sequence was not incremented on update")
+}
+
 func (ms *ModelSuite) TestMeeting_GetRequests() {
 	meetings := createMeetingFixtures(ms.DB, 2).Meetings
 
@@ -647,6 +635,50 @@ func (ms *ModelSuite) TestMeeting_Organizers() {
 	}
 }
 
+func (ms *ModelSuite) TestMeeting_AddRemoveOrganizer() {
+	f := createMeetingFixtures(ms.DB, 1)
+	mtg := f.Meetings[0]
+	creator, organizer, participant, nonParticipant := f.Users[0], f.Users[1], f.Users[2], f.Users[4]
+	ctx := createTestContext(creator)
+
+	organizerIDs := func() []int {
+		organizers, err := mtg.Organizers(ctx)
+		ms.NoError(err)
+		ids := make([]int, len(organizers))
+		for i := range organizers {
+			ids[i] = organizers[i].ID
+		}
+		return ids
+	}
+
+	ms.NoError(mtg.AddOrganizer(ctx, participant, creator), "error adding a participant as organizer")
+	ms.NoError(mtg.AddOrganizer(ctx, nonParticipant, creator), "error adding a non-participant as organizer")
+	ms.NoError(mtg.AddOrganizer(ctx, organizer, creator), "error adding an existing organizer")
+	ms.ElementsMatch([]int{organizer.ID, participant.ID, nonParticipant.ID}, organizerIDs())
+
+	outsider := createUserFixtures(ms.DB, 1).Users[0]
+	mtg.Visibility = MeetingVisibilityInviteOnly
+	ms.NoError(ms.DB.UpdateColumns(&mtg, "visibility"))
+	ms.Equal(ErrMeetingNotVisible, mtg.AddOrganizer(ctx, outsider, creator),
+		"a user who cannot see the meeting should not be added")
+	ms.ElementsMatch([]int{organizer.ID, participant.ID, nonParticipant.ID}, organizerIDs())
+
+	var p MeetingParticipant
+	ms.NoError(p.FindByMeetingIDAndUserID(mtg.ID, nonParticipant.ID), "new organizer was not added as a participant")
+
+	err := mtg.RemoveOrganizer(f.Users[3])
+	ms.Error(err, "expected an error removing a participant who is not an organizer")
+	ms.Contains(err.Error(), "not an organizer")
+
+	ms.NoError(mtg.RemoveOrganizer(participant))
+	ms.NoError(mtg.RemoveOrganizer(nonParticipant))
+	ms.Equal(ErrLastMeetingOrganizer, mtg.RemoveOrganizer(organizer), "the last organizer should not be removed")
+	ms.Equal([]int{organizer.ID}, organizerIDs())
+
+	ms.NoError(p.FindByMeetingIDAndUserID(mtg.ID, nonParticipant.ID), "removed organizer is no longer a participant")
+	ms.False(p.IsOrganizer)
+}
+
 func (ms *ModelSuite) TestMeeting_RemoveInvite() {
 	f := createMeetingFixtures(ms.DB, 2)
 
@@ -881,3 +913,83 @@ func (ms *ModelSuite) TestMeetings_FindByIDs() {
 		})
 	}
 }
+
+// TestMeeting_Visibility tests meeting visibility for every combination of visibility and user relationship to the
+// meeting, for Meeting.isVisible, Meetings.FindByFilter, meeting requests in Requests.FindByUser, and self-joining
+func (ms *ModelSuite) TestMeeting_Visibility() {
+	t := ms.T()
+	f := createMeetingFixtures_Visibility(ms)
+
+	// visible[user][meeting], with meetings in the order ALL, TRUSTED, ORGANIZATION, INVITE_ONLY
+	visible := map[string][4]bool{
+		"creator":             {true, true, true, true},
+		"participant":         {true, true, true, true},
+		"organizer":           {true, true, true, true},
+		"invitee":             {true, true, true, true},
+		"organization member": {true, true, true, false},
+		"trusted org member":  {true, true, false, false},
+		"unrelated user":      {true, false, false, false},
+		"super admin":         {true, true, true, true},
+	}
+	users := map[string]User{
+		"creator":             f.Users[0],
+		"participant":         f.Users[1],
+		"organizer":           f.Users[2],
+		"invitee":             f.Users[3],
+		"organization member": f.Users[4],
+		"trusted org member":  f.Users[5],
+		"unrelated user":      f.Users[6],
+		"super admin":         f.Users[7],
+	}
+
+	for name, user := range users {
+		for i, meeting := range f.Meetings {
+			want := visible[name][i]
+			t.Run(name+" "+meeting.Visibility.String(), func(t *testing.T) {
+				ms.Equal(want, meeting.isVisible(nil, user.ID), "incorrect result from isVisible")
+				ms.Equal(want, user.CanViewMeeting(nil, meeting), "incorrect result from CanViewMeeting")
+
+				var meetings Meetings
+				ms.NoError(meetings.FindByFilter(user, MeetingFilterParams{}, 1, domain.MaxPageSize))
+				found := false
+				for _, m := range meetings {
+					found = found || m.ID == meeting.ID
+				}
+				ms.Equal(want, found, "incorrect result from FindByFilter")
+
+				var requests Requests
+				ms.NoError(requests.FindByUser(nil, user, RequestFilterParams{MeetingID: &meeting.ID}))
+				ms.Equal(want, len(requests) == 1, "incorrect visibility of meeting request")
+
+				wantJoin := want && meeting.Visibility != MeetingVisibilityInviteOnly ||
+					name == "creator" || name == "super admin"
+				ms.Equal(wantJoin, user.CanCreateMeetingParticipant(nil, meeting), "incorrect self-join permission")
+			})
+		}
+	}
+
+	// users are only notified of new requests for meetings they can see
+	createFixture(ms, &Watch{UUID: domain.GetUUID(), OwnerID: f.Users[4].ID})
+	for i, want := range []bool{true, true, true, false} {
+		interested, err := f.Requests[i].FindInterestedUsers()
+		ms.NoError(err)
+		found := false
+		for _, u := range interested {
+			found = found || u.User.ID == f.Users[4].ID
+		}
+		ms.Equal(want, found, "incorrect notification of %s meeting request", f.Meetings[i].Visibility)
+	}
+
+	// a meeting request must be visible to the user's organization as well as being linked to a visible meeting
+	request := f.Requests[3]
+	request.Visibility = RequestVisibilitySame
+	ms.NoError(ms.DB.Update(&request))
+
+	for name, want := range map[string]bool{"creator": true, "participant": false, "organizer": false,
+		"invitee": false, "organization member": false} {
+
+		var requests Requests
+		ms.NoError(requests.FindByUser(nil, users[name], RequestFilterParams{MeetingID: &f.Meetings[3].ID}))
+		ms.Equal(want, len(requests) == 1, "incorrect visibility of meeting request to %s", name)
+	}
+}
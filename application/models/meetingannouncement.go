@@ -0,0 +1,100 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gobuffalo/events"
+	"github.com/gobuffalo/pop"
+	"github.com/gobuffalo/validate"
+	"github.com/gobuffalo/validate/validators"
+	"github.com/gofrs/uuid"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
+// MeetingAnnouncement is a message posted by an organizer of a Meeting to all of its participants
+type MeetingAnnouncement struct {
+	ID        int       `json:"id" db:"id"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	UUID      uuid.UUID `json:"uuid" db:"uuid"`
+	MeetingID int       `json:"meeting_id" db:"meeting_id"`
+	AuthorID  int       `json:"author_id" db:"author_id"`
+	Content   string    `json:"content" db:"content"`
+}
+
+// String can be helpful for serializing the model
+func (m MeetingAnnouncement) String() string {
+	jm, _ := json.Marshal(m)
+	return string(jm)
+}
+
+// MeetingAnnouncements is used for methods that operate on lists of objects
+type MeetingAnnouncements []MeetingAnnouncement
+
+// String can be helpful for serializing the model
+func (m MeetingAnnouncements) String() string {
+	jm, _ := json.Marshal(m)
+	return string(jm)
+}
+
+// Validate gets run every time you call a "pop.Validate*" (pop.ValidateAndSave, pop.ValidateAndCreate, pop.ValidateAndUpdate) method.
+func (m *MeetingAnnouncement) Validate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.Validate(
+		&validators.UUIDIsPresent{Field: m.UUID, Name: "UUID"},
+		&validators.IntIsPresent{Field: m.MeetingID, Name: "MeetingID"},
+		&validators.IntIsPresent{Field: m.AuthorID, Name: "AuthorID"},
+		&validators.StringIsPresent{Field: m.Content, Name: "Content"},
+	), nil
+}
+
+// ValidateCreate gets run every time you call "pop.ValidateAndCreate" method.
+func (m *MeetingAnnouncement) ValidateCreate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.NewErrors(), nil
+}
+
+// ValidateUpdate gets run every time you call "pop.ValidateAndUpdate" method.
+func (m *MeetingAnnouncement) ValidateUpdate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.NewErrors(), nil
+}
+
+// MeetingAnnouncementEventData is the event payload for a new meeting announcement
+type MeetingAnnouncementEventData struct {
+	MeetingAnnouncementID int
+}
+
+// Create stores the MeetingAnnouncement data as a new record in the database, and emits an event to deliver it to the
+// meeting participants.
+func (m *MeetingAnnouncement) Create() error {
+	if err := create(m); err != nil {
+		return err
+	}
+
+	e := events.Event{
+		Kind:    domain.EventApiMeetingAnnouncementCreated,
+		Message: "Meeting announcement created",
+		Payload: events.Payload{"eventData": MeetingAnnouncementEventData{
+			MeetingAnnouncementID: m.ID,
+		}},
+	}
+	emitEvent(e)
+	return nil
+}
+
+// FindByID loads from DB the MeetingAnnouncement record identified by the given ID
+func (m *MeetingAnnouncement) FindByID(id int) error {
+	return DB.Find(m, id)
+}
+
+// Meeting returns the related Meeting record
+func (m *MeetingAnnouncement) Meeting() (Meeting, error) {
+	var meeting Meeting
+	return meeting, DB.Find(&meeting, m.MeetingID)
+}
+
+// Author returns the related User record of the organizer who posted the announcement
+func (m *MeetingAnnouncement) Author() (User, error) {
+	var user User
+	return user, DB.Find(&user, m.AuthorID)
+}
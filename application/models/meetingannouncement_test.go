@@ -0,0 +1,94 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
+func (ms *ModelSuite) TestMeetingAnnouncement_Validate() {
+	t := ms.T()
+	tests := []struct {
+		name         string
+		announcement MeetingAnnouncement
+		wantErr      bool
+		errField     string
+	}{
+		{
+			name:         "minimum",
+			announcement: MeetingAnnouncement{UUID: domain.GetUUID(), MeetingID: 1, AuthorID: 1, Content: "hello"},
+			wantErr:      false,
+		},
+		{
+			name:         "missing MeetingID",
+			announcement: MeetingAnnouncement{UUID: domain.GetUUID(), AuthorID: 1, Content: "hello"},
+			wantErr:      true,
+			errField:     "meeting_id",
+		},
+		{
+			name:         "missing AuthorID",
+			announcement: MeetingAnnouncement{UUID: domain.GetUUID(), MeetingID: 1, Content: "hello"},
+			wantErr:      true,
+			errField:     "author_id",
+		},
+		{
+			name:         "missing Content",
+			announcement: MeetingAnnouncement{UUID: domain.GetUUID(), MeetingID: 1, AuthorID: 1},
+			wantErr:      true,
+			errField:     "content",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			vErr, _ := test.announcement.Validate(DB)
+			if test.wantErr {
+				ms.True(vErr.Count() != 0, "Expected an error, but did not get one")
+				ms.True(len(vErr.Get(test.errField)) > 0,
+					"Expected an error on field %v, but got none (errors: %v)",
+					test.errField, vErr.Errors)
+				return
+			}
+			ms.False(vErr.HasAny(), "Unexpected error: %v", vErr)
+		})
+	}
+}
+
+func (ms *ModelSuite) TestMeeting_Announcements() {
+	f := createMeetingFixtures(ms.DB, 2)
+
+	announcements := MeetingAnnouncements{
+		{MeetingID: f.Meetings[0].ID, AuthorID: f.Users[0].ID, Content: "first"},
+		{MeetingID: f.Meetings[0].ID, AuthorID: f.Users[1].ID, Content: "second"},
+		{MeetingID: f.Meetings[1].ID, AuthorID: f.Users[0].ID, Content: "other meeting"},
+	}
+	for i := range announcements {
+		ms.NoError(announcements[i].Create())
+	}
+
+	got, err := f.Meetings[0].Announcements(createTestContext(f.Users[4]))
+	ms.NoError(err)
+	ms.Equal(0, len(got), "a non-participant should not see the announcements")
+
+	got, err = f.Meetings[0].Announcements(createTestContext(f.Users[2]))
+	ms.NoError(err)
+	ms.Equal(2, len(got), "incorrect number of announcements")
+	ms.Equal("second", got[0].Content, "announcements are not sorted newest first")
+	ms.Equal("first", got[1].Content, "announcements are not sorted newest first")
+
+	author, err := got[0].Author()
+	ms.NoError(err)
+	ms.Equal(f.Users[1].ID, author.ID, "incorrect announcement author")
+}
+
+func (ms *ModelSuite) TestMeeting_ParticipantUsers() {
+	f := createMeetingFixtures(ms.DB, 2)
+
+	got, err := f.Meetings[0].ParticipantUsers()
+	ms.NoError(err)
+
+	ids := make([]int, len(got))
+	for i := range got {
+		ids[i] = got[i].ID
+	}
+	ms.ElementsMatch([]int{f.Users[1].ID, f.Users[2].ID, f.Users[3].ID}, ids, "incorrect participants")
+}
@@ -0,0 +1,161 @@
+package models
+
+import (
+	"crypto/rand"
+	"fmt"
+	"strings"
+
+	"github.com/gobuffalo/nulls"
+	"github.com/pkg/errors"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
+// handoffCodeChars excludes characters that are easily confused when read aloud or typed, such as 0/O and 1/I
+const handoffCodeChars = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+const handoffCodeLength = 8
+
+var (
+	// ErrHandoffCodeNotFound is returned by Meeting.CompleteHandoff if no request of the meeting has the given code
+	ErrHandoffCodeNotFound = errors.New("handoff code does not match a request of this meeting")
+
+	// ErrHandoffNotPending is returned by Meeting.CompleteHandoff if the request is not ACCEPTED or DELIVERED
+	ErrHandoffNotPending = errors.New("request is not awaiting handoff")
+)
+
+// MeetingHandoff is a set of requests linked to a meeting that are to be handed from one provider to one receiver
+type MeetingHandoff struct {
+	Provider User
+	Receiver User
+	Requests Requests
+}
+
+// MeetingHandoffs is merely for convenience and brevity
+type MeetingHandoffs []MeetingHandoff
+
+// Handoffs returns the ACCEPTED and DELIVERED requests linked to the meeting, grouped by provider and receiver. No
+// authorization is checked.
+func (m *Meeting) Handoffs() (MeetingHandoffs, error) {
+	var requests Requests
+	if err := DB.
+		Where("meeting_id = ? AND status IN (?, ?) AND provider_id IS NOT NULL",
+			m.ID, RequestStatusAccepted, RequestStatusDelivered).
+		Order("provider_id, created_by_id, id").
+		All(&requests); err != nil {
+
+		return nil, fmt.Errorf("error getting handoff requests for meeting id %v, %s", m.ID, err)
+	}
+
+	userIDs := make([]int, 0, 2*len(requests))
+	for _, r := range requests {
+		userIDs = append(userIDs, r.ProviderID.Int, r.CreatedByID)
+	}
+	var users Users
+	if len(userIDs) > 0 {
+		if err := users.FindByIDs(userIDs); err != nil {
+			return nil, fmt.Errorf("error getting handoff users for meeting id %v, %s", m.ID, err)
+		}
+	}
+	usersByID := map[int]User{}
+	for _, u := range users {
+		usersByID[u.ID] = u
+	}
+
+	handoffs := MeetingHandoffs{}
+	for _, r := range requests {
+		n := len(handoffs)
+		if n > 0 && handoffs[n-1].Provider.ID == r.ProviderID.Int && handoffs[n-1].Receiver.ID == r.CreatedByID {
+			handoffs[n-1].Requests = append(handoffs[n-1].Requests, r)
+			continue
+		}
+		handoffs = append(handoffs, MeetingHandoff{
+			Provider: usersByID[r.ProviderID.Int],
+			Receiver: usersByID[r.CreatedByID],
+			Requests: Requests{r},
+		})
+	}
+	return handoffs, nil
+}
+
+// CompleteHandoff marks the handoff of the meeting's request identified by `code`, moving it to COMPLETED. As with any
+// other status change, this is recorded in the request history and the provider and receiver are notified.
+func (m *Meeting) CompleteHandoff(code string) (Request, error) {
+	var request Request
+
+	code = normalizeHandoffCode(code)
+	if code == "" {
+		return request, ErrHandoffCodeNotFound
+	}
+
+	err := DB.Where("meeting_id = ? AND handoff_code = ?", m.ID, code).First(&request)
+	if domain.IsOtherThanNoRows(err) {
+		return request, fmt.Errorf("error finding request by handoff code, %s", err)
+	}
+	if err != nil {
+		return request, ErrHandoffCodeNotFound
+	}
+
+	if request.Status != RequestStatusAccepted && request.Status != RequestStatusDelivered {
+		return request, ErrHandoffNotPending
+	}
+
+	request.Status = RequestStatusCompleted
+	if err := request.Update(); err != nil {
+		return request, err
+	}
+
+	var pps PotentialProviders
+	if err := pps.destroyAllForRequest(request.ID); err != nil {
+		return request, err
+	}
+
+	return request, nil
+}
+
+// GetHandoffCode returns the code used to confirm the handoff of the request at a meeting exchange, generating one if
+// the request does not yet have a code.
+func (r *Request) GetHandoffCode() (string, error) {
+	if r.HandoffCode.Valid {
+		return r.HandoffCode.String, nil
+	}
+
+	code, err := newHandoffCode()
+	if err != nil {
+		return "", err
+	}
+
+	// Don't use Update here, since that runs the status transition callbacks
+	if err := DB.RawQuery("UPDATE requests SET handoff_code = ? WHERE id = ? AND handoff_code IS NULL",
+		code, r.ID).Exec(); err != nil {
+
+		return "", fmt.Errorf("error saving handoff code for request %v, %s", r.ID, err)
+	}
+
+	// reload in case a concurrent call stored a different code first
+	var stored Request
+	if err := DB.Find(&stored, r.ID); err != nil {
+		return "", fmt.Errorf("error reloading handoff code for request %v, %s", r.ID, err)
+	}
+	r.HandoffCode = nulls.NewString(stored.HandoffCode.String)
+	return r.HandoffCode.String, nil
+}
+
+func newHandoffCode() (string, error) {
+	rb := make([]byte, handoffCodeLength)
+	if _, err := rand.Read(rb); err != nil {
+		return "", err
+	}
+
+	code := make([]byte, handoffCodeLength)
+	for i := range rb {
+		code[i] = handoffCodeChars[int(rb[i])%len(handoffCodeChars)]
+	}
+	return string(code), nil
+}
+
+// normalizeHandoffCode accepts codes typed in lower case or with separating spaces or dashes
+func normalizeHandoffCode(code string) string {
+	code = strings.ToUpper(code)
+	return strings.NewReplacer(" ", "", "-", "").Replace(code)
+}
@@ -0,0 +1,95 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gobuffalo/nulls"
+)
+
+// createMeetingHandoffFixtures creates requests linked to the first meeting. The first two are accepted by the same
+// provider, the third by another provider and the fourth remains OPEN.
+func createMeetingHandoffFixtures(ms *ModelSuite) (meetingFixtures, Requests) {
+	f := createMeetingFixtures(ms.DB, 1)
+	requests := createRequestFixtures(ms.DB, 4, false)
+
+	providers := []int{f.Users[1].ID, f.Users[1].ID, f.Users[2].ID}
+	for i := range requests {
+		requests[i].MeetingID = nulls.NewInt(f.Meetings[0].ID)
+		if i < len(providers) {
+			requests[i].Status = RequestStatusAccepted
+			requests[i].ProviderID = nulls.NewInt(providers[i])
+		}
+		ms.NoError(requests[i].Update())
+	}
+	return f, requests
+}
+
+func (ms *ModelSuite) TestMeeting_Handoffs() {
+	f, requests := createMeetingHandoffFixtures(ms)
+
+	got, err := f.Meetings[0].Handoffs()
+	ms.NoError(err)
+	ms.Equal(2, len(got), "incorrect number of handoff groups")
+
+	ms.Equal(f.Users[1].ID, got[0].Provider.ID, "incorrect provider of first group")
+	ms.Equal(requests[0].CreatedByID, got[0].Receiver.ID, "incorrect receiver of first group")
+	ms.Equal(2, len(got[0].Requests), "incorrect number of requests in first group")
+
+	ms.Equal(f.Users[2].ID, got[1].Provider.ID, "incorrect provider of second group")
+	ms.Equal(1, len(got[1].Requests), "incorrect number of requests in second group")
+	ms.Equal(requests[2].ID, got[1].Requests[0].ID, "incorrect request in second group")
+}
+
+func (ms *ModelSuite) TestMeeting_CompleteHandoff() {
+	t := ms.T()
+	f, requests := createMeetingHandoffFixtures(ms)
+
+	code, err := requests[0].GetHandoffCode()
+	ms.NoError(err)
+	ms.Equal(handoffCodeLength, len(code), "incorrect handoff code length")
+
+	again, err := requests[0].GetHandoffCode()
+	ms.NoError(err)
+	ms.Equal(code, again, "handoff code should not change once set")
+
+	openRequest := requests[3]
+	openCode, err := openRequest.GetHandoffCode()
+	ms.NoError(err)
+
+	otherMeeting := createMeetingFixtures(ms.DB, 1).Meetings[0]
+
+	tests := []struct {
+		name    string
+		meeting Meeting
+		code    string
+		wantErr error
+	}{
+		{name: "wrong meeting", meeting: otherMeeting, code: code, wantErr: ErrHandoffCodeNotFound},
+		{name: "unknown code", meeting: f.Meetings[0], code: "ABCD2345", wantErr: ErrHandoffCodeNotFound},
+		{name: "empty code", meeting: f.Meetings[0], code: " ", wantErr: ErrHandoffCodeNotFound},
+		{name: "open request", meeting: f.Meetings[0], code: openCode, wantErr: ErrHandoffNotPending},
+		{name: "good, lower case with dash", meeting: f.Meetings[0], code: code[:4] + "-" + strings.ToLower(code[4:])},
+		{name: "already completed", meeting: f.Meetings[0], code: code, wantErr: ErrHandoffNotPending},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.meeting.CompleteHandoff(test.code)
+			if test.wantErr != nil {
+				ms.Equal(test.wantErr, err, "incorrect error")
+				return
+			}
+			ms.NoError(err)
+			ms.Equal(requests[0].ID, got.ID, "incorrect request completed")
+
+			var request Request
+			ms.NoError(request.FindByID(requests[0].ID))
+			ms.Equal(RequestStatusCompleted, request.Status, "request status was not updated")
+			ms.True(request.CompletedOn.Valid, "CompletedOn was not set")
+
+			var history RequestHistory
+			ms.NoError(ms.DB.Where("request_id = ?", request.ID).Order("id desc").First(&history))
+			ms.Equal(RequestStatusCompleted, history.Status, "request history was not recorded")
+		})
+	}
+}
@@ -2,8 +2,13 @@ package models
 
 import (
 	"errors"
+	"fmt"
+	"io"
+	"strconv"
 	"time"
 
+	"github.com/gobuffalo/events"
+	"github.com/gobuffalo/nulls"
 	"github.com/gobuffalo/pop"
 	"github.com/gobuffalo/validate"
 	"github.com/gobuffalo/validate/validators"
@@ -12,15 +17,65 @@ import (
 	"github.com/silinternational/wecarry-api/domain"
 )
 
+// MeetingInviteStatus is the delivery status of the invitation email of a MeetingInvite
+type MeetingInviteStatus string
+
+const (
+	// MeetingInviteStatusNotSent indicates that no invitation email has been sent
+	MeetingInviteStatusNotSent MeetingInviteStatus = "NOT_SENT"
+	// MeetingInviteStatusSent indicates that the invitation email was sent
+	MeetingInviteStatusSent MeetingInviteStatus = "SENT"
+	// MeetingInviteStatusBounced indicates that email to the invitee's address bounced
+	MeetingInviteStatusBounced MeetingInviteStatus = "BOUNCED"
+)
+
+func (e MeetingInviteStatus) IsValid() bool {
+	switch e {
+	case MeetingInviteStatusNotSent, MeetingInviteStatusSent, MeetingInviteStatusBounced:
+		return true
+	}
+	return false
+}
+
+func (e MeetingInviteStatus) String() string {
+	return string(e)
+}
+
+func (e *MeetingInviteStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MeetingInviteStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MeetingInviteStatus", str)
+	}
+	return nil
+}
+
+func (e MeetingInviteStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// ErrMeetingInviteRateLimited is returned by MeetingInvite.Send if the invitation was sent too recently or too often
+var ErrMeetingInviteRateLimited = errors.New("meeting invitation was sent too recently or too many times")
+
+// ErrMeetingInviteBounced is returned by MeetingInvite.Send if email to the invitee's address bounced
+var ErrMeetingInviteBounced = errors.New("meeting invitation email address bounced")
+
 // MeetingInvite is the model for storing meeting invites sent to prospective users, linked to a meeting/event
 type MeetingInvite struct {
-	ID        int       `json:"id" db:"id"`
-	CreatedAt time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
-	MeetingID int       `json:"meeting_id" db:"meeting_id"`
-	InviterID int       `json:"inviter_id" db:"inviter_id"`
-	Secret    uuid.UUID `json:"secret" db:"secret"`
-	Email     string    `json:"email" db:"email"`
+	ID        int                 `json:"id" db:"id"`
+	CreatedAt time.Time           `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time           `json:"updated_at" db:"updated_at"`
+	MeetingID int                 `json:"meeting_id" db:"meeting_id"`
+	InviterID int                 `json:"inviter_id" db:"inviter_id"`
+	Secret    uuid.UUID           `json:"secret" db:"secret"`
+	Email     string              `json:"email" db:"email"`
+	Status    MeetingInviteStatus `json:"status" db:"status"`
+	SendCount int                 `json:"send_count" db:"send_count"`
+	SentAt    nulls.Time          `json:"sent_at" db:"sent_at"`
 }
 
 // MeetingInvites is used for methods that operate on lists of objects
@@ -33,10 +88,35 @@ func (m *MeetingInvite) Validate(tx *pop.Connection) (*validate.Errors, error) {
 		&validators.IntIsPresent{Field: m.InviterID, Name: "InviterID"},
 		&validators.UUIDIsPresent{Field: m.Secret, Name: "Secret"},
 		&validators.EmailIsPresent{Field: m.Email, Name: "Email"},
+		&meetingInviteStatusValidator{Field: m.Status, Name: "Status"},
 	), nil
 }
 
-// Create validates and stores the MeetingInvite data as a new record in the database.
+type meetingInviteStatusValidator struct {
+	Name    string
+	Field   MeetingInviteStatus
+	Message string
+}
+
+// IsValid accepts an empty value, which is replaced by MeetingInviteStatusNotSent when the invite is saved
+func (v *meetingInviteStatusValidator) IsValid(errors *validate.Errors) {
+	if v.Field == "" || v.Field.IsValid() {
+		return
+	}
+	v.Message = fmt.Sprintf("%s is not a valid meeting invite status", v.Field)
+	errors.Add(validators.GenerateKey(v.Name), v.Message)
+}
+
+// BeforeSave sets the default status
+func (m *MeetingInvite) BeforeSave(tx *pop.Connection) error {
+	if m.Status == "" {
+		m.Status = MeetingInviteStatusNotSent
+	}
+	return nil
+}
+
+// Create validates and stores the MeetingInvite data as a new record in the database. If the email address was
+// already invited to the meeting, the existing invite is loaded instead.
 func (m *MeetingInvite) Create() error {
 	invite := *m
 	invite.Secret = domain.GetUUID()
@@ -44,7 +124,7 @@ func (m *MeetingInvite) Create() error {
 	err := create(&invite)
 	if err != nil && err.Error() ==
 		`pq: duplicate key value violates unique constraint "meeting_invites_meeting_id_email_idx"` {
-		err = nil
+		return m.FindByMeetingIDAndEmail(m.MeetingID, m.Email)
 	}
 	if err == nil {
 		*m = invite
@@ -52,6 +132,63 @@ func (m *MeetingInvite) Create() error {
 	return err
 }
 
+// MeetingInviteEventData is the event payload for sending a meeting invitation
+type MeetingInviteEventData struct {
+	MeetingInviteID int
+}
+
+// Send emits an event to email the invitation to the invitee. The first invitation is announced with the
+// EventApiMeetingInviteCreated event, and later ones with EventApiMeetingInviteResent. An invitation is sent at most
+// domain.MeetingInviteMaxSends times, no more than once per domain.MeetingInviteResendDelay, and not at all if email
+// to the invitee's address bounced.
+func (m *MeetingInvite) Send() error {
+	// count the send in the same statement that checks the limits, so that concurrent sends cannot both pass
+	now := time.Now()
+	n, err := DB.RawQuery(`UPDATE meeting_invites SET send_count = send_count + 1, sent_at = ?, updated_at = ?
+		WHERE id = ? AND status <> ? AND send_count < ? AND (sent_at IS NULL OR sent_at < ?)`,
+		now, now, m.ID, MeetingInviteStatusBounced, domain.MeetingInviteMaxSends,
+		now.Add(-domain.MeetingInviteResendDelay)).ExecWithCount()
+	if err != nil {
+		return err
+	}
+	if err := DB.Find(m, m.ID); err != nil {
+		return err
+	}
+	if n == 0 {
+		if m.Status == MeetingInviteStatusBounced {
+			return ErrMeetingInviteBounced
+		}
+		return ErrMeetingInviteRateLimited
+	}
+
+	kind := domain.EventApiMeetingInviteCreated
+	if m.SendCount > 1 {
+		kind = domain.EventApiMeetingInviteResent
+	}
+
+	e := events.Event{
+		Kind:    kind,
+		Message: "Meeting Invite sent",
+		Payload: events.Payload{"eventData": MeetingInviteEventData{
+			MeetingInviteID: m.ID,
+		}},
+	}
+	emitEvent(e)
+	return nil
+}
+
+// SetStatus records the delivery status of the invitation email
+func (m *MeetingInvite) SetStatus(status MeetingInviteStatus) error {
+	m.Status = status
+	return DB.UpdateColumns(m, "status", "updated_at")
+}
+
+// markMeetingInvitesBounced records that invitation email to the given address bounced
+func markMeetingInvitesBounced(email string) error {
+	return DB.RawQuery("UPDATE meeting_invites SET status = ?, updated_at = ? WHERE LOWER(email) = ?",
+		MeetingInviteStatusBounced, time.Now(), normalizeEmail(email)).Exec()
+}
+
 // Meeting returns the related Meeting record
 func (m *MeetingInvite) Meeting() (Meeting, error) {
 	var meeting Meeting
@@ -69,6 +206,11 @@ func (m *MeetingInvite) AvatarURL() string {
 	return gravatarURL(m.Email)
 }
 
+// FindByID loads the MeetingInvite with the given ID
+func (m *MeetingInvite) FindByID(id int) error {
+	return DB.Find(m, id)
+}
+
 func (m *MeetingInvite) FindByMeetingIDAndEmail(meetingID int, email string) error {
 	return DB.Where("meeting_id = ? and email = ?", meetingID, email).First(m)
 }
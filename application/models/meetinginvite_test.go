@@ -3,6 +3,7 @@ package models
 import (
 	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid"
 
@@ -290,3 +291,54 @@ func (ms *ModelSuite) TestMeetingInvite_FindBySecret() {
 		})
 	}
 }
+
+func (ms *ModelSuite) TestMeetingInvite_Send() {
+	f := createMeetingFixtures(ms.DB, 1)
+	invite := f.MeetingInvites[0]
+	ms.Equal(MeetingInviteStatusNotSent, invite.Status, "incorrect initial status")
+
+	stale := invite
+
+	ms.NoError(invite.Send())
+	ms.Equal(1, invite.SendCount, "send count was not incremented")
+	ms.True(invite.SentAt.Valid, "sent time was not recorded")
+
+	ms.Equal(ErrMeetingInviteRateLimited, invite.Send(), "invite was sent again too soon")
+	ms.Equal(ErrMeetingInviteRateLimited, stale.Send(), "a stale copy of the invite was sent again too soon")
+
+	backdate := func() {
+		ms.NoError(ms.DB.RawQuery("UPDATE meeting_invites SET sent_at = ? WHERE id = ?",
+			time.Now().Add(-domain.MeetingInviteResendDelay-time.Minute), invite.ID).Exec())
+	}
+	for i := 1; i < domain.MeetingInviteMaxSends; i++ {
+		backdate()
+		ms.NoError(invite.Send())
+	}
+	backdate()
+	ms.Equal(ErrMeetingInviteRateLimited, invite.Send(), "invite was sent too many times")
+
+	var found MeetingInvite
+	ms.NoError(found.FindByID(invite.ID))
+	ms.Equal(domain.MeetingInviteMaxSends, found.SendCount, "send count was not saved")
+
+	// a bounce is recorded on the invites to the address, and the invite cannot be sent again
+	bounced := f.MeetingInvites[1]
+	_, err := SuppressEmail(bounced.Email, EmailSuppressionReasonBounce, "test", "")
+	ms.NoError(err)
+	ms.NoError(bounced.FindByID(bounced.ID))
+	ms.Equal(MeetingInviteStatusBounced, bounced.Status, "bounce was not recorded")
+	ms.Equal(ErrMeetingInviteBounced, bounced.Send(), "invite to a bounced address was sent")
+}
+
+func (ms *ModelSuite) TestMeetingInvite_CreateDuplicate() {
+	f := createMeetingFixtures(ms.DB, 1)
+
+	invite := MeetingInvite{
+		MeetingID: f.MeetingInvites[0].MeetingID,
+		InviterID: f.MeetingInvites[0].InviterID,
+		Email:     f.MeetingInvites[0].Email,
+	}
+	ms.NoError(invite.Create())
+	ms.Equal(f.MeetingInvites[0].ID, invite.ID, "existing invite was not loaded")
+	ms.Equal(f.MeetingInvites[0].Secret, invite.Secret, "existing invite secret was replaced")
+}
@@ -33,7 +33,7 @@ var DB *pop.Connection
 const tokenBytes = 32
 
 // Keep a map of the json tag names for the standard user preferences struct
-// e.g. "time_zone": "time_zone".
+// e.g. "time_zone": "time_zone", along with the notification preference keys.
 // Having it as a map, makes it easy to check if a potential key is allowed
 var allowedUserPreferenceKeys map[string]string
 
@@ -56,6 +56,9 @@ func init() {
 	if err != nil {
 		log.Fatal(fmt.Errorf("error loading Allowed User Preferences ... %v", err))
 	}
+	for _, key := range notificationPreferenceKeys {
+		allowedUserPreferenceKeys[key] = key
+	}
 }
 
 func getRandomToken() (string, error) {
@@ -154,6 +157,26 @@ func (v *NullsStringIsURL) IsValid(errors *validate.Errors) {
 	newV.IsValid(errors)
 }
 
+type NullsStringIsPhoneNumber struct {
+	Name    string
+	Field   nulls.String
+	Message string
+}
+
+// IsValid adds an error if the field is not empty and not a phone number in E.164 format.
+func (v *NullsStringIsPhoneNumber) IsValid(errors *validate.Errors) {
+	if !v.Field.Valid || v.Field.String == "" {
+		return
+	}
+
+	if !domain.IsPhoneNumberValid(v.Field.String) {
+		if v.Message == "" {
+			v.Message = fmt.Sprintf("%s is not a valid phone number", v.Name)
+		}
+		errors.Add(validators.GenerateKey(v.Name), v.Message)
+	}
+}
+
 // This can include an event payload, which is a map[string]interface{}
 func emitEvent(e events.Event) {
 	if err := events.Emit(e); err != nil {
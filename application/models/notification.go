@@ -0,0 +1,137 @@
+package models
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/gobuffalo/nulls"
+	"github.com/gobuffalo/pop"
+	"github.com/gobuffalo/validate"
+	"github.com/gobuffalo/validate/validators"
+	"github.com/gofrs/uuid"
+)
+
+// Notification is the model for storing in-app notifications. One is created for each notification sent to a user,
+// regardless of the delivery channel.
+type Notification struct {
+	ID        int        `json:"id" db:"id"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at" db:"updated_at"`
+	UUID      uuid.UUID  `json:"uuid" db:"uuid"`
+	UserID    int        `json:"user_id" db:"user_id"`
+	Type      string     `json:"type" db:"type"`
+	Title     string     `json:"title" db:"title"`
+	RequestID nulls.Int  `json:"request_id" db:"request_id"`
+	ThreadID  nulls.Int  `json:"thread_id" db:"thread_id"`
+	MeetingID nulls.Int  `json:"meeting_id" db:"meeting_id"`
+	ReadAt    nulls.Time `json:"read_at" db:"read_at"`
+}
+
+// String can be helpful for serializing the model
+func (n Notification) String() string {
+	jn, _ := json.Marshal(n)
+	return string(jn)
+}
+
+// Notifications is used for methods that operate on lists of objects
+type Notifications []Notification
+
+// String can be helpful for serializing the model
+func (n Notifications) String() string {
+	jn, _ := json.Marshal(n)
+	return string(jn)
+}
+
+// Validate gets run every time you call a "pop.Validate*" (pop.ValidateAndSave, pop.ValidateAndCreate, pop.ValidateAndUpdate) method.
+func (n *Notification) Validate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.Validate(
+		&validators.UUIDIsPresent{Field: n.UUID, Name: "UUID"},
+		&validators.IntIsPresent{Field: n.UserID, Name: "UserID"},
+		&validators.StringIsPresent{Field: n.Type, Name: "Type"},
+	), nil
+}
+
+// ValidateCreate gets run every time you call "pop.ValidateAndCreate" method.
+func (n *Notification) ValidateCreate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.NewErrors(), nil
+}
+
+// ValidateUpdate gets run every time you call "pop.ValidateAndUpdate" method.
+func (n *Notification) ValidateUpdate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.NewErrors(), nil
+}
+
+// Create stores the Notification data as a new record in the database.
+func (n *Notification) Create() error {
+	return create(n)
+}
+
+// GetRequest loads the related Request, if any. It does not check authorization.
+func (n *Notification) GetRequest() (*Request, error) {
+	if !n.RequestID.Valid {
+		return nil, nil
+	}
+	request := Request{}
+	if err := DB.Find(&request, n.RequestID); err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+// GetThread loads the related Thread, if any. It does not check authorization.
+func (n *Notification) GetThread() (*Thread, error) {
+	if !n.ThreadID.Valid {
+		return nil, nil
+	}
+	thread := Thread{}
+	if err := DB.Find(&thread, n.ThreadID); err != nil {
+		return nil, err
+	}
+	return &thread, nil
+}
+
+// GetMeeting loads the related Meeting, if any. It does not check authorization.
+func (n *Notification) GetMeeting() (*Meeting, error) {
+	if !n.MeetingID.Valid {
+		return nil, nil
+	}
+	meeting := Meeting{}
+	if err := DB.Find(&meeting, n.MeetingID); err != nil {
+		return nil, err
+	}
+	return &meeting, nil
+}
+
+// FindByUser loads one page of the given user's notifications, newest first. If `unreadOnly` is true, notifications
+// that have been read are omitted.
+func (n *Notifications) FindByUser(user User, unreadOnly bool, page, perPage int) error {
+	q := DB.Where("user_id = ?", user.ID)
+	if unreadOnly {
+		q = q.Where("read_at IS NULL")
+	}
+	return q.Order("created_at desc, id desc").Paginate(page, perPage).All(n)
+}
+
+// UnreadCount returns the number of the given user's notifications that have not been read
+func (n *Notifications) UnreadCount(user User) (int, error) {
+	var c Count
+	err := DB.RawQuery("SELECT COUNT(*) FROM notifications WHERE user_id = ? AND read_at IS NULL", user.ID).First(&c)
+	return c.N, err
+}
+
+// MarkRead sets the read time on the given user's notifications identified by `ids` (UUIDs). If `ids` is empty, all
+// of the user's unread notifications are marked as read.
+func (n *Notifications) MarkRead(user User, ids []string) error {
+	args := []interface{}{time.Now(), user.ID}
+	stmt := "UPDATE notifications SET read_at = ? WHERE user_id = ? AND read_at IS NULL"
+	if len(ids) > 0 {
+		placeholders := make([]string, len(ids))
+		for i, id := range ids {
+			placeholders[i] = "?"
+			args = append(args, id)
+		}
+		stmt += " AND uuid IN (" + strings.Join(placeholders, ",") + ")"
+	}
+	return DB.RawQuery(stmt, args...).Exec()
+}
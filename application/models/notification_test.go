@@ -0,0 +1,69 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gobuffalo/nulls"
+)
+
+func createNotificationFixtures(ms *ModelSuite) (Users, Notifications) {
+	users := createUserFixtures(ms.DB, 2).Users
+	requests := createRequestFixtures(ms.DB, 1, false)
+
+	notifications := Notifications{
+		{UserID: users[0].ID, Type: "new_request", Title: "zero", RequestID: nulls.NewInt(requests[0].ID)},
+		{UserID: users[0].ID, Type: "new_request", Title: "one", ReadAt: nulls.NewTime(time.Now())},
+		{UserID: users[0].ID, Type: "new_thread_message", Title: "two"},
+		{UserID: users[1].ID, Type: "new_request", Title: "three"},
+	}
+	for i := range notifications {
+		ms.NoError(notifications[i].Create())
+	}
+
+	return users, notifications
+}
+
+func (ms *ModelSuite) TestNotifications_FindByUser() {
+	users, notifications := createNotificationFixtures(ms)
+
+	var got Notifications
+	ms.NoError(got.FindByUser(users[0], false, 1, 20))
+	ms.Equal(3, len(got), "incorrect number of notifications")
+	ms.Equal(notifications[2].ID, got[0].ID, "notifications not in reverse chronological order")
+
+	ms.NoError(got.FindByUser(users[0], true, 1, 20))
+	ms.Equal(2, len(got), "incorrect number of unread notifications")
+
+	ms.NoError(got.FindByUser(users[0], false, 2, 2))
+	ms.Equal(1, len(got), "incorrect number of notifications on the second page")
+	ms.Equal(notifications[0].ID, got[0].ID, "incorrect notification on the second page")
+
+	request, err := got[0].GetRequest()
+	ms.NoError(err)
+	ms.NotNil(request, "notification request not found")
+}
+
+func (ms *ModelSuite) TestNotifications_MarkRead() {
+	users, notifications := createNotificationFixtures(ms)
+
+	var n Notifications
+	count, err := n.UnreadCount(users[0])
+	ms.NoError(err)
+	ms.Equal(2, count, "incorrect unread count")
+
+	ms.NoError(n.MarkRead(users[0], []string{notifications[2].UUID.String(), notifications[3].UUID.String()}))
+
+	count, err = n.UnreadCount(users[0])
+	ms.NoError(err)
+	ms.Equal(1, count, "incorrect unread count after marking one notification as read")
+
+	count, err = n.UnreadCount(users[1])
+	ms.NoError(err)
+	ms.Equal(1, count, "another user's notification was marked as read")
+
+	ms.NoError(n.MarkRead(users[0], nil))
+
+	count, err = n.UnreadCount(users[0])
+	ms.NoError(err)
+	ms.Equal(0, count, "incorrect unread count after marking all notifications as read")
+}
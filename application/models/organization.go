@@ -257,6 +257,15 @@ func scopeUserAdminOrgs(cUser User) pop.ScopeFunc {
 	}
 }
 
+// Webhooks gets the webhooks registered by the organization
+func (o *Organization) Webhooks() (OrganizationWebhooks, error) {
+	var webhooks OrganizationWebhooks
+	if err := DB.Where("organization_id = ?", o.ID).Order("created_at asc").All(&webhooks); err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
 // LogoURL retrieves the logo URL from the attached file
 func (o *Organization) LogoURL() (*string, error) {
 	if o.FileID.Valid {
@@ -0,0 +1,225 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gobuffalo/nulls"
+	"github.com/gobuffalo/pop"
+	"github.com/gobuffalo/pop/slices"
+	"github.com/gobuffalo/validate"
+	"github.com/gobuffalo/validate/validators"
+	"github.com/gofrs/uuid"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
+type WebhookEvent string
+
+const (
+	WebhookEventRequestCreated            WebhookEvent = "REQUEST_CREATED"
+	WebhookEventRequestStatusUpdated      WebhookEvent = "REQUEST_STATUS_UPDATED"
+	WebhookEventPotentialProviderCreated  WebhookEvent = "POTENTIAL_PROVIDER_CREATED"
+	WebhookEventPotentialProviderRejected WebhookEvent = "POTENTIAL_PROVIDER_REJECTED"
+	WebhookEventMeetingCreated            WebhookEvent = "MEETING_CREATED"
+	WebhookEventUserJoinedOrganization    WebhookEvent = "USER_JOINED_ORGANIZATION"
+)
+
+func (e WebhookEvent) IsValid() bool {
+	switch e {
+	case WebhookEventRequestCreated, WebhookEventRequestStatusUpdated, WebhookEventPotentialProviderCreated,
+		WebhookEventPotentialProviderRejected, WebhookEventMeetingCreated, WebhookEventUserJoinedOrganization:
+		return true
+	}
+	return false
+}
+
+func (e WebhookEvent) String() string {
+	return string(e)
+}
+
+func (e *WebhookEvent) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookEvent(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookEvent", str)
+	}
+	return nil
+}
+
+func (e WebhookEvent) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// OrganizationWebhook is an endpoint registered by an organization to receive notice of events in the App
+type OrganizationWebhook struct {
+	ID             int           `json:"id" db:"id"`
+	CreatedAt      time.Time     `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time     `json:"updated_at" db:"updated_at"`
+	UUID           uuid.UUID     `json:"uuid" db:"uuid"`
+	OrganizationID int           `json:"organization_id" db:"organization_id"`
+	URL            string        `json:"url" db:"url"`
+	Events         slices.String `json:"events" db:"events"`
+	Secret         string        `json:"secret" db:"secret"`
+	Active         bool          `json:"active" db:"active"`
+	CreatedByID    nulls.Int     `json:"created_by_id" db:"created_by_id"`
+}
+
+// String can be helpful for serializing the model
+func (w OrganizationWebhook) String() string {
+	jw, _ := json.Marshal(w)
+	return string(jw)
+}
+
+// OrganizationWebhooks is used for methods that operate on lists of objects
+type OrganizationWebhooks []OrganizationWebhook
+
+// String can be helpful for serializing the model
+func (w OrganizationWebhooks) String() string {
+	jw, _ := json.Marshal(w)
+	return string(jw)
+}
+
+// Validate gets run every time you call a "pop.Validate*" (pop.ValidateAndSave, pop.ValidateAndCreate, pop.ValidateAndUpdate) method.
+func (w *OrganizationWebhook) Validate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.Validate(
+		&validators.UUIDIsPresent{Field: w.UUID, Name: "UUID"},
+		&validators.IntIsPresent{Field: w.OrganizationID, Name: "OrganizationID"},
+		&validators.StringIsPresent{Field: w.Secret, Name: "Secret"},
+		&webhookURLValidator{Field: w.URL, Name: "URL"},
+		&webhookEventsValidator{Field: w.Events, Name: "Events"},
+	), nil
+}
+
+type webhookURLValidator struct {
+	Name    string
+	Field   string
+	Message string
+}
+
+// IsValid requires an absolute https URL, so that the payload and signature are not exposed in transit. The host must
+// resolve to public addresses only, so that a webhook cannot be used to reach the App's internal network.
+func (v *webhookURLValidator) IsValid(errors *validate.Errors) {
+	u, err := url.Parse(v.Field)
+	if err != nil || u.Scheme != "https" || u.Host == "" || len(v.Field) > 1024 {
+		v.Message = fmt.Sprintf("%s must be an https URL of no more than 1024 characters", v.Name)
+		errors.Add(validators.GenerateKey(v.Name), v.Message)
+		return
+	}
+
+	if err := domain.CheckPublicHost(u.Hostname()); err != nil {
+		v.Message = fmt.Sprintf("%s must be a public address, %s", v.Name, err)
+		errors.Add(validators.GenerateKey(v.Name), v.Message)
+	}
+}
+
+type webhookEventsValidator struct {
+	Name    string
+	Field   slices.String
+	Message string
+}
+
+func (v *webhookEventsValidator) IsValid(errors *validate.Errors) {
+	if len(v.Field) == 0 {
+		v.Message = fmt.Sprintf("%s must include at least one event", v.Name)
+		errors.Add(validators.GenerateKey(v.Name), v.Message)
+		return
+	}
+
+	for _, e := range v.Field {
+		if !WebhookEvent(e).IsValid() {
+			v.Message = fmt.Sprintf("%s is not a valid webhook event", e)
+			errors.Add(validators.GenerateKey(v.Name), v.Message)
+		}
+	}
+}
+
+// ValidateCreate gets run every time you call "pop.ValidateAndCreate" method.
+func (w *OrganizationWebhook) ValidateCreate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.NewErrors(), nil
+}
+
+// ValidateUpdate gets run every time you call "pop.ValidateAndUpdate" method.
+func (w *OrganizationWebhook) ValidateUpdate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.NewErrors(), nil
+}
+
+// Create stores the OrganizationWebhook data as a new record in the database. A signing secret is generated if none
+// is given.
+func (w *OrganizationWebhook) Create() error {
+	if w.Secret == "" {
+		secret, err := getRandomToken()
+		if err != nil {
+			return fmt.Errorf("error creating webhook secret, %s", err)
+		}
+		w.Secret = secret
+	}
+
+	return create(w)
+}
+
+// Update writes the OrganizationWebhook data to an existing database record.
+func (w *OrganizationWebhook) Update() error {
+	return update(w)
+}
+
+// Destroy removes the webhook and its delivery log
+func (w *OrganizationWebhook) Destroy() error {
+	return DB.Destroy(w)
+}
+
+// FindByUUID loads from DB the OrganizationWebhook record identified by the given UUID
+func (w *OrganizationWebhook) FindByUUID(id string) error {
+	if id == "" {
+		return fmt.Errorf("error: webhook uuid must not be blank")
+	}
+
+	if err := DB.Where("uuid = ?", id).First(w); err != nil {
+		return fmt.Errorf("error finding webhook by uuid: %s", err.Error())
+	}
+
+	return nil
+}
+
+// GetEvents returns the events that are sent to the webhook
+func (w *OrganizationWebhook) GetEvents() []WebhookEvent {
+	events := make([]WebhookEvent, len(w.Events))
+	for i := range w.Events {
+		events[i] = WebhookEvent(w.Events[i])
+	}
+	return events
+}
+
+// SetEvents sets the events that are sent to the webhook, ignoring duplicates
+func (w *OrganizationWebhook) SetEvents(events []WebhookEvent) {
+	w.Events = slices.String{}
+	seen := map[WebhookEvent]bool{}
+	for _, e := range events {
+		if !seen[e] {
+			w.Events = append(w.Events, e.String())
+			seen[e] = true
+		}
+	}
+}
+
+// GetOrganization returns the organization that registered the webhook
+func (w *OrganizationWebhook) GetOrganization() (Organization, error) {
+	var org Organization
+	if err := DB.Find(&org, w.OrganizationID); err != nil {
+		return org, err
+	}
+	return org, nil
+}
+
+// FindActiveByEvent gets the active webhooks of all organizations that are subscribed to the given event
+func (w *OrganizationWebhooks) FindActiveByEvent(event WebhookEvent) error {
+	return DB.Where("active = true AND ? = ANY(events)", event.String()).Order("id asc").All(w)
+}
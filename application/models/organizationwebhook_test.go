@@ -0,0 +1,185 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/gobuffalo/nulls"
+	"github.com/gobuffalo/pop/slices"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
+func createOrganizationWebhookFixtures(ms *ModelSuite, orgs Organizations) OrganizationWebhooks {
+	webhooks := OrganizationWebhooks{
+		{OrganizationID: orgs[0].ID, URL: "https://example.com/hook0", Active: true,
+			Events: slices.String{WebhookEventRequestCreated.String()}},
+		{OrganizationID: orgs[0].ID, URL: "https://example.com/hook1", Active: false,
+			Events: slices.String{WebhookEventRequestCreated.String()}},
+		{OrganizationID: orgs[1].ID, URL: "https://example.com/hook2", Active: true,
+			Events: slices.String{WebhookEventRequestCreated.String(), WebhookEventMeetingCreated.String()}},
+	}
+	for i := range webhooks {
+		ms.NoError(webhooks[i].Create())
+	}
+	return webhooks
+}
+
+func (ms *ModelSuite) TestOrganizationWebhook_Validate() {
+	t := ms.T()
+	tests := []struct {
+		name     string
+		webhook  OrganizationWebhook
+		wantErrs []string
+	}{
+		{
+			name: "good",
+			webhook: OrganizationWebhook{URL: "https://example.com/hook", OrganizationID: 1, Secret: "s",
+				Events: slices.String{"REQUEST_CREATED"}},
+		},
+		{
+			name: "http URL",
+			webhook: OrganizationWebhook{URL: "http://example.com/hook", OrganizationID: 1, Secret: "s",
+				Events: slices.String{"REQUEST_CREATED"}},
+			wantErrs: []string{"url"},
+		},
+		{
+			name: "loopback address",
+			webhook: OrganizationWebhook{URL: "https://127.0.0.1/hook", OrganizationID: 1, Secret: "s",
+				Events: slices.String{"REQUEST_CREATED"}},
+			wantErrs: []string{"url"},
+		},
+		{
+			name: "link-local address",
+			webhook: OrganizationWebhook{URL: "https://169.254.169.254/latest/meta-data", OrganizationID: 1,
+				Secret: "s", Events: slices.String{"REQUEST_CREATED"}},
+			wantErrs: []string{"url"},
+		},
+		{
+			name: "private address",
+			webhook: OrganizationWebhook{URL: "https://[fd00::1]:8443/hook", OrganizationID: 1, Secret: "s",
+				Events: slices.String{"REQUEST_CREATED"}},
+			wantErrs: []string{"url"},
+		},
+		{
+			name: "name resolving to loopback",
+			webhook: OrganizationWebhook{URL: "https://localhost/hook", OrganizationID: 1, Secret: "s",
+				Events: slices.String{"REQUEST_CREATED"}},
+			wantErrs: []string{"url"},
+		},
+		{
+			name:     "no events",
+			webhook:  OrganizationWebhook{URL: "https://example.com/hook", OrganizationID: 1, Secret: "s"},
+			wantErrs: []string{"events"},
+		},
+		{
+			name: "bad event",
+			webhook: OrganizationWebhook{URL: "https://example.com/hook", OrganizationID: 1, Secret: "s",
+				Events: slices.String{"REQUEST_DELETED"}},
+			wantErrs: []string{"events"},
+		},
+		{
+			name:     "missing organization and secret",
+			webhook:  OrganizationWebhook{URL: "https://example.com/hook", Events: slices.String{"REQUEST_CREATED"}},
+			wantErrs: []string{"organization_id", "secret"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.webhook.UUID = domain.GetUUID()
+			vErr, _ := test.webhook.Validate(DB)
+			ms.Equal(len(test.wantErrs) > 0, vErr.HasAny(), "unexpected validation result, %s", vErr)
+			for _, key := range test.wantErrs {
+				ms.NotEmpty(vErr.Get(key), "missing error for %s", key)
+			}
+		})
+	}
+}
+
+func (ms *ModelSuite) TestOrganizationWebhook_Create() {
+	orgs := createOrganizationFixtures(ms.DB, 2)
+	webhooks := createOrganizationWebhookFixtures(ms, orgs)
+
+	ms.NotEqual("", webhooks[0].Secret, "secret was not generated")
+	ms.NotEqual(webhooks[0].Secret, webhooks[1].Secret, "secrets should be unique")
+
+	var found OrganizationWebhook
+	ms.NoError(found.FindByUUID(webhooks[2].UUID.String()))
+	ms.Equal([]WebhookEvent{WebhookEventRequestCreated, WebhookEventMeetingCreated}, found.GetEvents())
+}
+
+func (ms *ModelSuite) TestOrganizationWebhook_SetEvents() {
+	var w OrganizationWebhook
+	w.SetEvents([]WebhookEvent{WebhookEventMeetingCreated, WebhookEventRequestCreated, WebhookEventMeetingCreated})
+	ms.Equal(slices.String{"MEETING_CREATED", "REQUEST_CREATED"}, w.Events, "duplicates were not removed")
+}
+
+func (ms *ModelSuite) TestOrganizationWebhooks_FindActiveByEvent() {
+	orgs := createOrganizationFixtures(ms.DB, 2)
+	webhooks := createOrganizationWebhookFixtures(ms, orgs)
+
+	var found OrganizationWebhooks
+	ms.NoError(found.FindActiveByEvent(WebhookEventRequestCreated))
+	ms.Equal(2, len(found), "wrong number of webhooks found")
+	ms.Equal(webhooks[0].ID, found[0].ID)
+	ms.Equal(webhooks[2].ID, found[1].ID)
+
+	ms.NoError(found.FindActiveByEvent(WebhookEventMeetingCreated))
+	ms.Equal(1, len(found), "wrong number of webhooks found")
+	ms.Equal(webhooks[2].ID, found[0].ID)
+
+	ms.NoError(found.FindActiveByEvent(WebhookEventUserJoinedOrganization))
+	ms.Equal(0, len(found), "no webhooks should be found")
+
+	orgWebhooks, err := orgs[0].Webhooks()
+	ms.NoError(err)
+	ms.Equal(2, len(orgWebhooks), "wrong number of webhooks for the organization")
+}
+
+func (ms *ModelSuite) TestRequest_IsVisibleToOrganization() {
+	t := ms.T()
+	orgs := createOrganizationFixtures(ms.DB, 3)
+	ms.NoError(orgs[0].CreateTrust(orgs[1].UUID.String()))
+
+	meetings := createMeetingFixtures(ms.DB, 3).Meetings
+	meetingVisibilities := []MeetingVisibility{MeetingVisibilityTrusted, MeetingVisibilityOrganization,
+		MeetingVisibilityInviteOnly}
+	for i := range meetings {
+		meetings[i].OrganizationID = nulls.NewInt(orgs[0].ID)
+		meetings[i].Visibility = meetingVisibilities[i]
+		ms.NoError(ms.DB.Save(&meetings[i]))
+	}
+
+	requests := createRequestFixtures(ms.DB, 6, false)
+	visibilities := []RequestVisibility{RequestVisibilityAll, RequestVisibilityTrusted, RequestVisibilitySame,
+		RequestVisibilityAll, RequestVisibilityAll, RequestVisibilityAll}
+	for i := range requests {
+		requests[i].OrganizationID = orgs[0].ID
+		requests[i].Visibility = visibilities[i]
+		if i >= 3 {
+			requests[i].MeetingID = nulls.NewInt(meetings[i-3].ID)
+		}
+		ms.NoError(ms.DB.Save(&requests[i]))
+	}
+
+	tests := []struct {
+		name    string
+		request Request
+		want    []bool
+	}{
+		{name: "all", request: requests[0], want: []bool{true, true, true}},
+		{name: "trusted", request: requests[1], want: []bool{true, true, false}},
+		{name: "same", request: requests[2], want: []bool{true, false, false}},
+		{name: "trusted meeting", request: requests[3], want: []bool{true, true, false}},
+		{name: "organization meeting", request: requests[4], want: []bool{true, false, false}},
+		{name: "invite-only meeting", request: requests[5], want: []bool{false, false, false}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for i := range orgs {
+				got, err := test.request.IsVisibleToOrganization(orgs[i].ID)
+				ms.NoError(err)
+				ms.Equal(test.want[i], got, "wrong visibility for org %d", i)
+			}
+		})
+	}
+}
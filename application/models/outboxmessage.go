@@ -0,0 +1,283 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/gobuffalo/nulls"
+	"github.com/gobuffalo/pop"
+	"github.com/gobuffalo/validate"
+	"github.com/gobuffalo/validate/validators"
+	"github.com/gofrs/uuid"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
+type OutboxMessageStatus string
+
+const (
+	OutboxMessageStatusQueued OutboxMessageStatus = "QUEUED"
+	OutboxMessageStatusSent   OutboxMessageStatus = "SENT"
+	OutboxMessageStatusDead   OutboxMessageStatus = "DEAD"
+)
+
+func (e OutboxMessageStatus) IsValid() bool {
+	switch e {
+	case OutboxMessageStatusQueued, OutboxMessageStatusSent, OutboxMessageStatusDead:
+		return true
+	}
+	return false
+}
+
+func (e OutboxMessageStatus) String() string {
+	return string(e)
+}
+
+func (e *OutboxMessageStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OutboxMessageStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OutboxMessageStatus", str)
+	}
+	return nil
+}
+
+func (e OutboxMessageStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// OutboxMessage is a rendered email waiting to be delivered, or a record of its delivery. Text messages and push
+// notifications are also stored here while they are held until the end of the recipient's quiet hours.
+type OutboxMessage struct {
+	ID             int                 `json:"id" db:"id"`
+	CreatedAt      time.Time           `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time           `json:"updated_at" db:"updated_at"`
+	UUID           uuid.UUID           `json:"uuid" db:"uuid"`
+	Channel        string              `json:"channel" db:"channel"`
+	IdempotencyKey string              `json:"idempotency_key" db:"idempotency_key"`
+	UserID         nulls.Int           `json:"user_id" db:"user_id"`
+	Template       string              `json:"template" db:"template"`
+	Subject        string              `json:"subject" db:"subject"`
+	FromName       string              `json:"from_name" db:"from_name"`
+	FromEmail      string              `json:"from_email" db:"from_email"`
+	ToName         string              `json:"to_name" db:"to_name"`
+	ToEmail        string              `json:"to_email" db:"to_email"`
+	HTMLBody       string              `json:"html_body" db:"html_body"`
+	TextBody       string              `json:"text_body" db:"text_body"`
+	UnsubscribeURL nulls.String        `json:"unsubscribe_url" db:"unsubscribe_url"`
+	Status         OutboxMessageStatus `json:"status" db:"status"`
+	Attempts       int                 `json:"attempts" db:"attempts"`
+	NextAttemptAt  time.Time           `json:"next_attempt_at" db:"next_attempt_at"`
+	LastError      nulls.String        `json:"last_error" db:"last_error"`
+	SentAt         nulls.Time          `json:"sent_at" db:"sent_at"`
+	NotBefore      nulls.Time          `json:"not_before" db:"not_before"`
+}
+
+// String can be helpful for serializing the model
+func (o OutboxMessage) String() string {
+	jo, _ := json.Marshal(o)
+	return string(jo)
+}
+
+// OutboxMessages is used for methods that operate on lists of objects
+type OutboxMessages []OutboxMessage
+
+// String can be helpful for serializing the model
+func (o OutboxMessages) String() string {
+	jo, _ := json.Marshal(o)
+	return string(jo)
+}
+
+// Validate gets run every time you call a "pop.Validate*" (pop.ValidateAndSave, pop.ValidateAndCreate, pop.ValidateAndUpdate) method.
+func (o *OutboxMessage) Validate(tx *pop.Connection) (*validate.Errors, error) {
+	v := []validate.Validator{
+		&validators.UUIDIsPresent{Field: o.UUID, Name: "UUID"},
+		&validators.StringIsPresent{Field: o.IdempotencyKey, Name: "IdempotencyKey"},
+		&validators.StringIsPresent{Field: o.Template, Name: "Template"},
+		&outboxMessageStatusValidator{Field: o.Status, Name: "Status"},
+	}
+
+	switch o.Channel {
+	case domain.NotificationChannelEmail:
+		v = append(v,
+			&validators.StringIsPresent{Field: o.ToEmail, Name: "ToEmail"},
+			&validators.StringIsPresent{Field: o.HTMLBody, Name: "HTMLBody"})
+	case domain.NotificationChannelMobile, domain.NotificationChannelPush:
+		// the recipient's phone number or push subscriptions are looked up when the message is delivered
+		v = append(v,
+			&validators.IntIsPresent{Field: o.UserID.Int, Name: "UserID"},
+			&validators.StringIsPresent{Field: o.TextBody, Name: "TextBody"})
+	default:
+		v = append(v, &validators.StringInclusion{Field: o.Channel, Name: "Channel",
+			List: []string{domain.NotificationChannelEmail, domain.NotificationChannelMobile,
+				domain.NotificationChannelPush}})
+	}
+
+	return validate.Validate(v...), nil
+}
+
+type outboxMessageStatusValidator struct {
+	Name    string
+	Field   OutboxMessageStatus
+	Message string
+}
+
+func (v *outboxMessageStatusValidator) IsValid(errors *validate.Errors) {
+	if v.Field.IsValid() {
+		return
+	}
+	v.Message = fmt.Sprintf("%s is not a valid outbox message status", v.Field)
+	errors.Add(validators.GenerateKey(v.Name), v.Message)
+}
+
+// ValidateCreate gets run every time you call "pop.ValidateAndCreate" method.
+func (o *OutboxMessage) ValidateCreate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.NewErrors(), nil
+}
+
+// ValidateUpdate gets run every time you call "pop.ValidateAndUpdate" method.
+func (o *OutboxMessage) ValidateUpdate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.NewErrors(), nil
+}
+
+// Enqueue stores a new message in the QUEUED state, claimed for immediate delivery by the caller. If NotBefore is set,
+// the message is instead left for DeliverOutbox to pick up at that time. If a message with the same idempotency key
+// already exists, nothing is stored and `created` is false.
+func (o *OutboxMessage) Enqueue() (created bool, err error) {
+	if o.IdempotencyKey == "" {
+		o.IdempotencyKey = domain.GetUUID().String()
+	}
+
+	exists, err := DB.Where("idempotency_key = ?", o.IdempotencyKey).Exists(&OutboxMessage{})
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		return false, nil
+	}
+
+	if o.Channel == "" {
+		o.Channel = domain.NotificationChannelEmail
+	}
+	o.Status = OutboxMessageStatusQueued
+	o.NextAttemptAt = time.Now().Add(domain.OutboxClaimDuration)
+	if o.NotBefore.Valid {
+		o.NextAttemptAt = o.NotBefore.Time
+	}
+	if err := create(o); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Claim reserves a queued message for delivery by postponing its next attempt, so that it is not picked up by another
+// worker. It returns false if the message was already claimed, sent or abandoned.
+func (o *OutboxMessage) Claim() (bool, error) {
+	now := time.Now()
+	next := now.Add(domain.OutboxClaimDuration)
+	n, err := DB.RawQuery(
+		"UPDATE outbox_messages SET next_attempt_at = ?, updated_at = ? WHERE id = ? AND status = ? AND next_attempt_at <= ?",
+		next, now, o.ID, OutboxMessageStatusQueued, now).ExecWithCount()
+	if err != nil {
+		return false, err
+	}
+
+	o.NextAttemptAt = next
+	return n == 1, nil
+}
+
+// RecordSent marks the message as delivered
+func (o *OutboxMessage) RecordSent() error {
+	o.Attempts++
+	o.Status = OutboxMessageStatusSent
+	o.SentAt = nulls.NewTime(time.Now())
+	o.LastError = nulls.String{}
+	return update(o)
+}
+
+// RecordFailure stores the delivery error and schedules the next attempt with exponential backoff. After
+// domain.OutboxMaxAttempts attempts, the message is moved to the DEAD state and is not retried.
+func (o *OutboxMessage) RecordFailure(deliveryErr error) error {
+	o.Attempts++
+	o.LastError = nulls.NewString(deliveryErr.Error())
+
+	if o.Attempts >= domain.OutboxMaxAttempts {
+		o.Status = OutboxMessageStatusDead
+		return update(o)
+	}
+
+	o.NextAttemptAt = time.Now().Add(outboxRetryDelay(o.Attempts))
+	return update(o)
+}
+
+// Abandon moves the message to the DEAD state without another attempt, for example when the recipient address has
+// been suppressed since the message was queued
+func (o *OutboxMessage) Abandon(reason error) error {
+	o.Status = OutboxMessageStatusDead
+	o.LastError = nulls.NewString(reason.Error())
+	return update(o)
+}
+
+// outboxRetryDelay returns the delay before the next delivery attempt, doubling with each failed attempt
+func outboxRetryDelay(attempts int) time.Duration {
+	return backoffDelay(attempts, domain.OutboxRetryDelay, domain.OutboxMaxRetryDelay)
+}
+
+// backoffDelay returns the delay before the next attempt of a retried operation, starting at `initial` and doubling
+// after each failed attempt up to `max`
+func backoffDelay(attempts int, initial, max time.Duration) time.Duration {
+	delay := initial
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= max {
+			return max
+		}
+	}
+	return delay
+}
+
+// FindDue gets the queued messages that are due for a delivery attempt, oldest first
+func (o *OutboxMessages) FindDue(limit int) error {
+	return DB.Where("status = ? AND next_attempt_at <= ?", OutboxMessageStatusQueued, time.Now()).
+		Order("next_attempt_at asc").Limit(limit).All(o)
+}
+
+// FindForStatusQuery gets one page of email messages, newest first, optionally filtered by recipient user, template and
+// status
+func (o *OutboxMessages) FindForStatusQuery(user *User, template *string, status *OutboxMessageStatus,
+	page, perPage int) error {
+
+	q := DB.Where("channel = ?", domain.NotificationChannelEmail)
+	if user != nil {
+		q = q.Where("user_id = ?", user.ID)
+	}
+	if template != nil {
+		q = q.Where("template = ?", *template)
+	}
+	if status != nil {
+		q = q.Where("status = ?", *status)
+	}
+
+	return q.Order("created_at desc, id desc").Paginate(page, perPage).All(o)
+}
+
+// GetUser returns the recipient user, if any
+func (o *OutboxMessage) GetUser() (*User, error) {
+	if !o.UserID.Valid {
+		return nil, nil
+	}
+
+	var user User
+	if err := user.FindByID(o.UserID.Int); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
@@ -0,0 +1,170 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gobuffalo/nulls"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
+func createOutboxMessageFixtures(ms *ModelSuite, users Users) OutboxMessages {
+	messages := OutboxMessages{
+		{IdempotencyKey: "new_request:1:1", UserID: nulls.NewInt(users[0].ID), Template: "new_request"},
+		{IdempotencyKey: "new_request:1:2", UserID: nulls.NewInt(users[1].ID), Template: "new_request"},
+		{IdempotencyKey: "digest:1:1", UserID: nulls.NewInt(users[0].ID), Template: "digest"},
+	}
+	for i := range messages {
+		messages[i].Subject = "subject"
+		messages[i].ToEmail = "user@example.com"
+		messages[i].HTMLBody = "<p>body</p>"
+		created, err := messages[i].Enqueue()
+		ms.NoError(err)
+		ms.True(created, "message was not created")
+	}
+
+	return messages
+}
+
+func (ms *ModelSuite) TestOutboxMessage_Enqueue() {
+	users := createUserFixtures(ms.DB, 2).Users
+	messages := createOutboxMessageFixtures(ms, users)
+
+	ms.Equal(OutboxMessageStatusQueued, messages[0].Status, "incorrect status")
+	ms.True(messages[0].NextAttemptAt.After(time.Now()), "new message should be claimed by the sender")
+
+	duplicate := OutboxMessage{
+		IdempotencyKey: messages[0].IdempotencyKey,
+		Template:       "new_request",
+		ToEmail:        "user@example.com",
+		HTMLBody:       "<p>body</p>",
+	}
+	created, err := duplicate.Enqueue()
+	ms.NoError(err)
+	ms.False(created, "duplicate message was created")
+
+	noKey := OutboxMessage{Template: "new_request", ToEmail: "user@example.com", HTMLBody: "<p>body</p>"}
+	created, err = noKey.Enqueue()
+	ms.NoError(err)
+	ms.True(created, "message without an idempotency key was not created")
+	ms.NotEqual("", noKey.IdempotencyKey, "idempotency key was not assigned")
+	ms.Equal(domain.NotificationChannelEmail, noKey.Channel, "channel should default to email")
+
+	notBefore := time.Now().Add(3 * time.Hour).Truncate(time.Second)
+	held := OutboxMessage{
+		Channel:   domain.NotificationChannelPush,
+		UserID:    nulls.NewInt(users[0].ID),
+		Template:  "new_request",
+		TextBody:  `{"title":"subject"}`,
+		NotBefore: nulls.NewTime(notBefore),
+	}
+	created, err = held.Enqueue()
+	ms.NoError(err)
+	ms.True(created, "held push message was not created")
+	ms.True(notBefore.Equal(held.NextAttemptAt), "held message should not be attempted before NotBefore")
+
+	var due OutboxMessages
+	ms.NoError(due.FindDue(10))
+	for _, d := range due {
+		ms.NotEqual(held.ID, d.ID, "held message is due before NotBefore")
+	}
+
+	noUser := OutboxMessage{Channel: domain.NotificationChannelMobile, Template: "new_request", TextBody: "text"}
+	_, err = noUser.Enqueue()
+	ms.Error(err, "expected an error for a text message without a recipient user")
+}
+
+func (ms *ModelSuite) TestOutboxMessage_Claim() {
+	users := createUserFixtures(ms.DB, 2).Users
+	messages := createOutboxMessageFixtures(ms, users)
+
+	claimed, err := messages[0].Claim()
+	ms.NoError(err)
+	ms.False(claimed, "message claimed while the first claim is still active")
+
+	ms.NoError(DB.RawQuery("UPDATE outbox_messages SET next_attempt_at = ? WHERE id = ?",
+		time.Now().Add(-time.Minute), messages[0].ID).Exec())
+
+	claimed, err = messages[0].Claim()
+	ms.NoError(err)
+	ms.True(claimed, "due message was not claimed")
+
+	claimed, err = messages[0].Claim()
+	ms.NoError(err)
+	ms.False(claimed, "message claimed twice")
+}
+
+func (ms *ModelSuite) TestOutboxMessage_RecordFailure() {
+	users := createUserFixtures(ms.DB, 2).Users
+	messages := createOutboxMessageFixtures(ms, users)
+	m := messages[0]
+
+	ms.NoError(m.RecordFailure(errors.New("connection refused")))
+	ms.Equal(1, m.Attempts, "incorrect number of attempts")
+	ms.Equal(OutboxMessageStatusQueued, m.Status, "message should be retried")
+	ms.Equal("connection refused", m.LastError.String, "incorrect last error")
+	ms.WithinDuration(time.Now().Add(domain.OutboxRetryDelay), m.NextAttemptAt, time.Second)
+
+	ms.NoError(m.RecordFailure(errors.New("connection refused")))
+	ms.WithinDuration(time.Now().Add(2*domain.OutboxRetryDelay), m.NextAttemptAt, time.Second,
+		"retry delay was not doubled")
+
+	for m.Attempts < domain.OutboxMaxAttempts {
+		ms.NoError(m.RecordFailure(errors.New("mailbox unavailable")))
+	}
+	ms.Equal(OutboxMessageStatusDead, m.Status, "message should be abandoned after the maximum attempts")
+
+	var due OutboxMessages
+	ms.NoError(DB.RawQuery("UPDATE outbox_messages SET next_attempt_at = ?", time.Now().Add(-time.Minute)).Exec())
+	ms.NoError(due.FindDue(10))
+	ms.Equal(2, len(due), "abandoned message should not be due")
+
+	ms.NoError(messages[1].RecordSent())
+	ms.NoError(due.FindDue(10))
+	ms.Equal(1, len(due), "sent message should not be due")
+	ms.Equal(messages[2].ID, due[0].ID, "incorrect due message")
+}
+
+func (ms *ModelSuite) TestOutboxMessages_FindForStatusQuery() {
+	t := ms.T()
+	users := createUserFixtures(ms.DB, 2).Users
+	messages := createOutboxMessageFixtures(ms, users)
+	ms.NoError(messages[2].RecordSent())
+
+	template := "new_request"
+	sent := OutboxMessageStatusSent
+
+	tests := []struct {
+		name     string
+		user     *User
+		template *string
+		status   *OutboxMessageStatus
+		page     int
+		perPage  int
+		want     []int
+	}{
+		{name: "all", page: 1, perPage: 10, want: []int{messages[2].ID, messages[1].ID, messages[0].ID}},
+		{name: "by user", user: &users[0], page: 1, perPage: 10, want: []int{messages[2].ID, messages[0].ID}},
+		{name: "by template", template: &template, page: 1, perPage: 10, want: []int{messages[1].ID, messages[0].ID}},
+		{name: "by status", status: &sent, page: 1, perPage: 10, want: []int{messages[2].ID}},
+		{name: "second page", page: 2, perPage: 2, want: []int{messages[0].ID}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got OutboxMessages
+			ms.NoError(got.FindForStatusQuery(test.user, test.template, test.status, test.page, test.perPage))
+
+			ids := make([]int, len(got))
+			for i := range got {
+				ids[i] = got[i].ID
+			}
+			ms.Equal(test.want, ids)
+		})
+	}
+
+	user, err := messages[0].GetUser()
+	ms.NoError(err)
+	ms.Equal(users[0].ID, user.ID, "incorrect recipient user")
+}
@@ -0,0 +1,155 @@
+package models
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/gobuffalo/events"
+	"github.com/gobuffalo/nulls"
+	"github.com/gobuffalo/pop"
+	"github.com/gobuffalo/validate"
+	"github.com/gobuffalo/validate/validators"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
+// PhoneCode is a pending verification code sent by text message to a user's new phone number
+type PhoneCode struct {
+	ID          int       `json:"id" db:"id"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+	UserID      int       `json:"user_id" db:"user_id"`
+	PhoneNumber string    `json:"phone_number" db:"phone_number"`
+	CodeHash    string    `json:"code_hash" db:"code_hash"`
+	Attempts    int       `json:"attempts" db:"attempts"`
+	ExpiresAt   time.Time `json:"expires_at" db:"expires_at"`
+}
+
+// String can be helpful for serializing the model
+func (p PhoneCode) String() string {
+	jp, _ := json.Marshal(p)
+	return string(jp)
+}
+
+// Validate gets run every time you call a "pop.Validate*" (pop.ValidateAndSave, pop.ValidateAndCreate, pop.ValidateAndUpdate) method.
+func (p *PhoneCode) Validate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.Validate(
+		&validators.IntIsPresent{Field: p.UserID, Name: "UserID"},
+		&NullsStringIsPhoneNumber{Field: nulls.NewString(p.PhoneNumber), Name: "PhoneNumber"},
+		&validators.StringIsPresent{Field: p.CodeHash, Name: "CodeHash"},
+		&validators.TimeIsPresent{Field: p.ExpiresAt, Name: "ExpiresAt"},
+	), nil
+}
+
+// ValidateCreate gets run every time you call "pop.ValidateAndCreate" method.
+func (p *PhoneCode) ValidateCreate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.NewErrors(), nil
+}
+
+// ValidateUpdate gets run every time you call "pop.ValidateAndUpdate" method.
+func (p *PhoneCode) ValidateUpdate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.NewErrors(), nil
+}
+
+// hashPhoneCode returns a hash of the code, salted with the user's UUID
+func hashPhoneCode(user User, code string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(user.UUID.String()+code)))
+}
+
+// newPhoneCode generates a random six-digit verification code
+func newPhoneCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// SendPhoneCode starts verification of a new phone number by emitting an event to send a code to the number by text
+// message. Any code previously sent to the user is replaced. A new code cannot be requested until
+// domain.PhoneCodeResendDelay has passed since the previous one.
+func (u *User) SendPhoneCode(phoneNumber string) error {
+	if !domain.IsPhoneNumberValid(phoneNumber) {
+		return fmt.Errorf("invalid phone number '%s'", phoneNumber)
+	}
+
+	var p PhoneCode
+	if err := DB.Where("user_id = ?", u.ID).First(&p); domain.IsOtherThanNoRows(err) {
+		return err
+	}
+
+	if p.ID != 0 && time.Since(p.UpdatedAt) < domain.PhoneCodeResendDelay {
+		return errors.New("a phone verification code was sent too recently")
+	}
+
+	code, err := newPhoneCode()
+	if err != nil {
+		return fmt.Errorf("error generating phone verification code, %s", err)
+	}
+
+	p.UserID = u.ID
+	p.PhoneNumber = phoneNumber
+	p.CodeHash = hashPhoneCode(*u, code)
+	p.Attempts = 0
+	p.ExpiresAt = time.Now().Add(domain.PhoneCodeLifetime)
+	if err := save(&p); err != nil {
+		return err
+	}
+
+	e := events.Event{
+		Kind:    domain.EventApiPhoneCodeCreated,
+		Message: "Phone Code Created for User " + u.UUID.String(),
+		Payload: events.Payload{
+			domain.ArgUserID:      u.ID,
+			domain.ArgPhoneNumber: phoneNumber,
+			domain.ArgPhoneCode:   code,
+		},
+	}
+	emitEvent(e)
+
+	return nil
+}
+
+// ConfirmPhoneCode checks the code sent by SendPhoneCode and, if it is correct, sets the user's phone number. The code
+// is invalidated after domain.PhoneCodeMaxAttempts attempts.
+func (u *User) ConfirmPhoneCode(code string) error {
+	var p PhoneCode
+	if err := DB.Where("user_id = ?", u.ID).First(&p); err != nil {
+		return fmt.Errorf("error finding phone verification code, %s", err)
+	}
+
+	// count the attempt before checking the code, in the same statement that checks the limit, so that parallel
+	// guesses cannot exceed it
+	n, err := DB.RawQuery("UPDATE phone_codes SET attempts = attempts + 1, updated_at = ? "+
+		"WHERE id = ? AND attempts < ? AND expires_at > ?",
+		time.Now(), p.ID, domain.PhoneCodeMaxAttempts, time.Now()).ExecWithCount()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errors.New("phone verification code is expired")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashPhoneCode(*u, code)), []byte(p.CodeHash)) != 1 {
+		return errors.New("phone verification code is incorrect")
+	}
+
+	u.PhoneNumber = nulls.NewString(p.PhoneNumber)
+	if err := u.Save(); err != nil {
+		return err
+	}
+
+	return DB.Destroy(&p)
+}
+
+// RemovePhoneNumber removes the user's phone number
+func (u *User) RemovePhoneNumber() error {
+	u.PhoneNumber = nulls.String{}
+	return u.Save()
+}
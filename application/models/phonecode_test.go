@@ -0,0 +1,86 @@
+package models
+
+import (
+	"time"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
+// setPhoneCode replaces the random code sent to the user with a known code
+func setPhoneCode(ms *ModelSuite, user User, code string) PhoneCode {
+	var p PhoneCode
+	ms.NoError(DB.Where("user_id = ?", user.ID).First(&p))
+	p.CodeHash = hashPhoneCode(user, code)
+	ms.NoError(DB.Update(&p))
+	return p
+}
+
+func (ms *ModelSuite) TestUser_SendPhoneCode() {
+	user := createUserFixtures(ms.DB, 1).Users[0]
+
+	ms.Error(user.SendPhoneCode("555-0100"), "invalid phone number was accepted")
+
+	ms.NoError(user.SendPhoneCode("+15555550100"))
+
+	var p PhoneCode
+	ms.NoError(DB.Where("user_id = ?", user.ID).First(&p))
+	ms.Equal("+15555550100", p.PhoneNumber, "incorrect phone number")
+	ms.True(p.ExpiresAt.After(time.Now()), "code should not be expired")
+
+	ms.Error(user.SendPhoneCode("+15555550199"), "a second code was sent too soon")
+
+	p.UpdatedAt = time.Now().Add(-domain.PhoneCodeResendDelay)
+	ms.NoError(DB.RawQuery("UPDATE phone_codes SET updated_at = ? WHERE id = ?", p.UpdatedAt, p.ID).Exec())
+	ms.NoError(user.SendPhoneCode("+15555550199"))
+
+	var codes []PhoneCode
+	ms.NoError(DB.Where("user_id = ?", user.ID).All(&codes))
+	ms.Equal(1, len(codes), "previous code was not replaced")
+	ms.Equal("+15555550199", codes[0].PhoneNumber, "incorrect phone number")
+}
+
+func (ms *ModelSuite) TestUser_ConfirmPhoneCode() {
+	users := createUserFixtures(ms.DB, 3).Users
+
+	// correct code
+	ms.NoError(users[0].SendPhoneCode("+15555550100"))
+	setPhoneCode(ms, users[0], "123456")
+	ms.Error(users[0].ConfirmPhoneCode("654321"), "incorrect code was accepted")
+	ms.NoError(users[0].ConfirmPhoneCode("123456"))
+
+	var user User
+	ms.NoError(user.FindByID(users[0].ID))
+	ms.Equal("+15555550100", user.PhoneNumber.String, "phone number was not saved")
+	ms.Error(users[0].ConfirmPhoneCode("123456"), "code was accepted a second time")
+
+	// expired code
+	ms.NoError(users[1].SendPhoneCode("+15555550101"))
+	p := setPhoneCode(ms, users[1], "123456")
+	p.ExpiresAt = time.Now().Add(-time.Minute)
+	ms.NoError(DB.Update(&p))
+	ms.Error(users[1].ConfirmPhoneCode("123456"), "expired code was accepted")
+
+	// too many attempts
+	ms.NoError(users[2].SendPhoneCode("+15555550102"))
+	setPhoneCode(ms, users[2], "123456")
+	for i := 0; i < domain.PhoneCodeMaxAttempts; i++ {
+		ms.Error(users[2].ConfirmPhoneCode("000000"), "incorrect code was accepted")
+	}
+	ms.Error(users[2].ConfirmPhoneCode("123456"), "code was accepted after too many attempts")
+
+	ms.NoError(user.FindByID(users[2].ID))
+	ms.False(user.PhoneNumber.Valid, "phone number should not be set")
+}
+
+func (ms *ModelSuite) TestUser_RemovePhoneNumber() {
+	user := createUserFixtures(ms.DB, 1).Users[0]
+	user.PhoneNumber.String = "+15555550100"
+	user.PhoneNumber.Valid = true
+	ms.NoError(user.Save())
+
+	ms.NoError(user.RemovePhoneNumber())
+
+	var got User
+	ms.NoError(got.FindByID(user.ID))
+	ms.False(got.PhoneNumber.Valid, "phone number was not removed")
+}
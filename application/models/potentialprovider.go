@@ -226,8 +226,19 @@ func (p *PotentialProviders) DestroyAllWithRequestUUID(requestUUID string, curre
 			currentUser.ID, request.ID)
 	}
 
-	if err := DB.Where("request_id = ?", request.ID).All(p); err != nil {
+	return p.destroyAllForRequest(request.ID)
+}
+
+// destroyAllForRequest destroys all the PotentialProviders of the Request without checking authorization
+func (p *PotentialProviders) destroyAllForRequest(requestID int) error {
+	if err := DB.Where("request_id = ?", requestID).All(p); err != nil {
 		return errors.New("unable to find Request's Potential Providers in order to remove them: " + err.Error())
 	}
-	return DB.Destroy(p)
+	if len(*p) == 0 {
+		return nil
+	}
+	if err := DB.Destroy(p); err != nil {
+		return errors.New("unable to remove Request's Potential Providers: " + err.Error())
+	}
+	return nil
 }
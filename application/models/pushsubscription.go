@@ -0,0 +1,123 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gobuffalo/pop"
+	"github.com/gobuffalo/validate"
+	"github.com/gobuffalo/validate/validators"
+	"github.com/gofrs/uuid"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
+// PushSubscription is a Web Push subscription for one browser of a user
+type PushSubscription struct {
+	ID        int       `json:"id" db:"id"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	UUID      uuid.UUID `json:"uuid" db:"uuid"`
+	UserID    int       `json:"user_id" db:"user_id"`
+	Endpoint  string    `json:"endpoint" db:"endpoint"`
+	P256dh    string    `json:"p256dh" db:"p256dh"`
+	Auth      string    `json:"auth" db:"auth"`
+}
+
+// String can be helpful for serializing the model
+func (p PushSubscription) String() string {
+	jp, _ := json.Marshal(p)
+	return string(jp)
+}
+
+// PushSubscriptions is used for methods that operate on lists of objects
+type PushSubscriptions []PushSubscription
+
+// String can be helpful for serializing the model
+func (p PushSubscriptions) String() string {
+	jp, _ := json.Marshal(p)
+	return string(jp)
+}
+
+// Validate gets run every time you call a "pop.Validate*" (pop.ValidateAndSave, pop.ValidateAndCreate, pop.ValidateAndUpdate) method.
+func (p *PushSubscription) Validate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.Validate(
+		&validators.UUIDIsPresent{Field: p.UUID, Name: "UUID"},
+		&validators.IntIsPresent{Field: p.UserID, Name: "UserID"},
+		&pushEndpointValidator{Field: p.Endpoint, Name: "Endpoint"},
+		&validators.StringIsPresent{Field: p.P256dh, Name: "P256dh"},
+		&validators.StringIsPresent{Field: p.Auth, Name: "Auth"},
+	), nil
+}
+
+type pushEndpointValidator struct {
+	Name    string
+	Field   string
+	Message string
+}
+
+// IsValid requires an endpoint on one of the known push services, so that notifications are not posted to arbitrary
+// URLs
+func (v *pushEndpointValidator) IsValid(errors *validate.Errors) {
+	if domain.IsPushServiceURLAllowed(v.Field) {
+		return
+	}
+	v.Message = fmt.Sprintf("%s must be an https URL of a supported push service", v.Name)
+	errors.Add(validators.GenerateKey(v.Name), v.Message)
+}
+
+// ValidateCreate gets run every time you call "pop.ValidateAndCreate" method.
+func (p *PushSubscription) ValidateCreate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.NewErrors(), nil
+}
+
+// ValidateUpdate gets run every time you call "pop.ValidateAndUpdate" method.
+func (p *PushSubscription) ValidateUpdate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.NewErrors(), nil
+}
+
+// Save wraps DB.Save() call to create a UUID if it's empty and check for errors
+func (p *PushSubscription) Save() error {
+	return save(p)
+}
+
+// Destroy removes the PushSubscription from the database
+func (p *PushSubscription) Destroy() error {
+	return DB.Destroy(p)
+}
+
+// FindByEndpoint loads from DB the PushSubscription record identified by the given endpoint URL
+func (p *PushSubscription) FindByEndpoint(endpoint string) error {
+	if endpoint == "" {
+		return errors.New("error: push subscription endpoint must not be blank")
+	}
+
+	if err := DB.Where("endpoint = ?", endpoint).First(p); err != nil {
+		return fmt.Errorf("error finding push subscription by endpoint: %s", err)
+	}
+
+	return nil
+}
+
+// Register saves the subscription for the given user. A browser has only one subscription, so an existing record with
+// the same endpoint is given the new keys. If the endpoint was registered to another user, e.g. on a shared browser,
+// it is reassigned to the given user, who is now the one receiving notifications in that browser.
+func (p *PushSubscription) Register(user User, endpoint, p256dh, auth string) error {
+	if err := DB.Where("endpoint = ?", endpoint).First(p); domain.IsOtherThanNoRows(err) {
+		return err
+	}
+
+	p.UserID = user.ID
+	p.Endpoint = endpoint
+	p.P256dh = p256dh
+	p.Auth = auth
+
+	return p.Save()
+}
+
+// FindByUser returns all of the given user's push subscriptions
+func (p *PushSubscriptions) FindByUser(user User) error {
+	return DB.Where("user_id = ?", user.ID).Order("created_at asc").All(p)
+}
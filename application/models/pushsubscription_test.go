@@ -0,0 +1,51 @@
+package models
+
+func (ms *ModelSuite) TestPushSubscription_Register() {
+	users := createUserFixtures(ms.DB, 2).Users
+	endpoint := "https://fcm.googleapis.com/fcm/send/abc123"
+
+	var first PushSubscription
+	ms.NoError(first.Register(users[0], endpoint, "key0", "auth0"))
+	ms.Equal(users[0].ID, first.UserID, "incorrect subscription user")
+
+	var again PushSubscription
+	ms.NoError(again.Register(users[0], endpoint, "key1", "auth1"))
+	ms.Equal(first.ID, again.ID, "a new subscription was created for an existing endpoint")
+	ms.Equal("key1", again.P256dh, "subscription key was not replaced")
+
+	// another user of the same browser takes over the subscription
+	var other PushSubscription
+	ms.NoError(other.Register(users[1], endpoint, "key2", "auth2"))
+	ms.Equal(first.ID, other.ID, "a new subscription was created for another user's endpoint")
+
+	var subscriptions PushSubscriptions
+	ms.NoError(subscriptions.FindByUser(users[0]))
+	ms.Equal(0, len(subscriptions), "subscription was not taken from the original user")
+
+	ms.NoError(subscriptions.FindByUser(users[1]))
+	ms.Equal(1, len(subscriptions), "subscription was not reassigned")
+	ms.Equal("key2", subscriptions[0].P256dh, "subscription keys were not replaced")
+
+	var invalid PushSubscription
+	ms.Error(invalid.Register(users[0], "not a url", "key", "auth"), "invalid endpoint was accepted")
+
+	var unknown PushSubscription
+	ms.Error(unknown.Register(users[0], "https://push.example.com/send/abc123", "key", "auth"),
+		"endpoint of an unknown push service was accepted")
+}
+
+func (ms *ModelSuite) TestPushSubscription_FindByEndpoint() {
+	users := createUserFixtures(ms.DB, 1).Users
+	subscription := PushSubscription{UserID: users[0].ID, Endpoint: "https://fcm.googleapis.com/fcm/send/1", P256dh: "k", Auth: "a"}
+	createFixture(ms, &subscription)
+
+	var got PushSubscription
+	ms.NoError(got.FindByEndpoint(subscription.Endpoint))
+	ms.Equal(subscription.ID, got.ID, "incorrect subscription found")
+
+	ms.Error(got.FindByEndpoint(""), "expected an error for a blank endpoint")
+	ms.Error(got.FindByEndpoint("https://fcm.googleapis.com/fcm/send/2"), "expected an error for an unknown endpoint")
+
+	ms.NoError(got.Destroy())
+	ms.Error(got.FindByEndpoint(subscription.Endpoint), "subscription was not destroyed")
+}
@@ -219,6 +219,7 @@ type Request struct {
 	OriginID       nulls.Int         `json:"origin_id" db:"origin_id"`
 	MeetingID      nulls.Int         `json:"meeting_id" db:"meeting_id"`
 	Visibility     RequestVisibility `json:"visibility" db:"visibility"`
+	HandoffCode    nulls.String      `json:"handoff_code" db:"handoff_code"`
 
 	CreatedBy    User         `belongs_to:"users"`
 	Organization Organization `belongs_to:"organizations"`
@@ -791,9 +792,46 @@ type RequestFilterParams struct {
 	Origin      *Location
 	SearchText  *string
 	RequestID   *int
+	MeetingID   *int
 }
 
-// FindByUser finds all requests visible to the current user, optionally filtered by location or search text.
+// visibleRequestsQuery returns an SQL query that selects the requests visible to the user, other than REMOVED and
+// COMPLETED requests. Any `joins` are added after `FROM requests`, and further conditions may be appended with AND.
+func visibleRequestsQuery(user User, joins string) (string, []interface{}) {
+	// requests for a meeting are only visible if both the request and the meeting are visible
+	meetingVisible, meetingArgs := meetingVisibleCondition("m", user)
+
+	query := `
+	WITH o AS (
+		SELECT id FROM organizations WHERE id IN (
+			SELECT organization_id FROM user_organizations WHERE user_id = ?
+		)
+	)
+	SELECT requests.* FROM requests ` + joins + ` WHERE
+	(
+		(
+			requests.organization_id IN (SELECT id FROM o)
+			OR
+			requests.visibility = ?
+			OR
+			requests.organization_id IN (
+				SELECT id FROM organizations WHERE id IN (
+					SELECT secondary_id FROM organization_trusts WHERE primary_id IN (SELECT id FROM o)
+				)
+			) AND requests.visibility = ?
+		)
+		AND
+		(requests.meeting_id IS NULL OR requests.meeting_id IN (SELECT m.id FROM meetings m WHERE ` + meetingVisible + `))
+	)
+	AND requests.status not in (?, ?)`
+
+	args := []interface{}{user.ID, RequestVisibilityAll, RequestVisibilityTrusted}
+	args = append(args, meetingArgs...)
+	args = append(args, RequestStatusRemoved, RequestStatusCompleted)
+	return query, args
+}
+
+// FindByUser finds all requests visible to the current user, optionally filtered by location, search text or meeting.
 func (p *Requests) FindByUser(ctx context.Context, user User, filter RequestFilterParams) error {
 	if user.ID == 0 {
 		return errors.New("invalid User ID in Requests.FindByUser")
@@ -804,28 +842,7 @@ func (p *Requests) FindByUser(ctx context.Context, user User, filter RequestFilt
 		return nil
 	}
 
-	selectClause := `
-	WITH o AS (
-		SELECT id FROM organizations WHERE id IN (
-			SELECT organization_id FROM user_organizations WHERE user_id = ?
-		)
-	)
-	SELECT * FROM requests WHERE
-	(
-		organization_id IN (SELECT id FROM o)
-		OR
-		visibility = ?
-		OR
-		organization_id IN (
-			SELECT id FROM organizations WHERE id IN (
-				SELECT secondary_id FROM organization_trusts WHERE primary_id IN (SELECT id FROM o)
-			)
-		) AND visibility = ?
-	)
-	AND status not in (?, ?)`
-
-	args := []interface{}{user.ID, RequestVisibilityAll, RequestVisibilityTrusted, RequestStatusRemoved,
-		RequestStatusCompleted}
+	selectClause, args := visibleRequestsQuery(user, "")
 
 	if filter.SearchText != nil {
 		selectClause = selectClause + " AND (LOWER(title) LIKE ? or LOWER(description) LIKE ?)"
@@ -836,6 +853,10 @@ func (p *Requests) FindByUser(ctx context.Context, user User, filter RequestFilt
 		selectClause = selectClause + " AND requests.id = ?"
 		args = append(args, *filter.RequestID)
 	}
+	if filter.MeetingID != nil {
+		selectClause = selectClause + " AND meeting_id = ?"
+		args = append(args, *filter.MeetingID)
+	}
 
 	requests := Requests{}
 	q := DB.RawQuery(selectClause+" ORDER BY created_at desc", args...)
@@ -857,6 +878,25 @@ func (p *Requests) FindByUser(ctx context.Context, user User, filter RequestFilt
 	return nil
 }
 
+// FindByMeeting finds one page of the requests for the meeting, newest first, that are visible to the user, using the
+// same visibility rules as FindByUser. `page` starts at 1.
+func (p *Requests) FindByMeeting(ctx context.Context, user User, meeting Meeting, page, perPage int) error {
+	*p = Requests{}
+
+	if !user.HasOrganization() {
+		return nil
+	}
+
+	query, args := visibleRequestsQuery(user, "")
+	query += " AND requests.meeting_id = ? ORDER BY requests.created_at desc, requests.id desc LIMIT ? OFFSET ?"
+	args = append(args, meeting.ID, perPage, (page-1)*perPage)
+
+	if err := DB.RawQuery(query, args...).All(p); err != nil {
+		return fmt.Errorf("error finding requests for meeting %s, %s", meeting.UUID, err)
+	}
+	return nil
+}
+
 // GetDestination reads the destination record, if it exists, and returns the Location object.
 func (r *Request) GetDestination() (*Location, error) {
 	location := Location{}
@@ -988,21 +1028,103 @@ func (r *Request) canUserChangeStatus(user User, newStatus RequestStatus) bool {
 	return false
 }
 
-// GetAudience returns a list of all of the users which have visibility to this request. As of this writing, it is
-// simply the users in the organization associated with this request.
-func (r *Request) GetAudience() (Users, error) {
+// InterestedUser is a user who wants to be notified of a new request, with the notification preference that applies
+type InterestedUser struct {
+	User          User
+	PreferenceKey string
+}
+
+// FindInterestedUsers finds the users in the request's organization who want to be notified of the new request,
+// either because the request origin is near them or because it matches one of their watches, and who have not turned
+// off that type of notification. Users who cannot see the request's meeting, if any, are left out. A fixed number of
+// queries is used regardless of the size of the organization.
+func (r *Request) FindInterestedUsers() ([]InterestedUser, error) {
 	if r.ID <= 0 {
-		return nil, errors.New("invalid request ID in GetAudience")
+		return nil, errors.New("invalid request ID in FindInterestedUsers")
 	}
-	org, err := r.GetOrganization()
+
+	isNear := map[int]bool{}
+	isWatched := map[int]bool{}
+
+	origin, err := r.GetOrigin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get request origin, %s", err)
+	}
+	if origin != nil {
+		near, args := nearCondition("l", *origin, strconv.Itoa(domain.DefaultProximityDistanceKm))
+		var nearIDs []int
+		err := DB.RawQuery("SELECT u.id FROM users u "+
+			"JOIN user_organizations uo ON uo.user_id = u.id "+
+			"JOIN locations l ON l.id = u.location_id "+
+			"WHERE uo.organization_id = ? AND u.id <> ? AND "+near,
+			append([]interface{}{r.OrganizationID, r.CreatedByID}, args...)...).All(&nearIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find users near request, %s", err)
+		}
+		for _, id := range nearIDs {
+			isNear[id] = true
+		}
+	}
+
+	condition, args, err := watchMatchCondition(*r)
 	if err != nil {
 		return nil, err
 	}
-	users, err := org.GetUsers()
+	var watcherIDs []int
+	err = DB.RawQuery("SELECT DISTINCT w.owner_id FROM watches w "+
+		"JOIN user_organizations uo ON uo.user_id = w.owner_id "+watchMatchJoins+
+		" WHERE uo.organization_id = ? AND w.owner_id <> ? AND "+condition,
+		append([]interface{}{r.OrganizationID, r.CreatedByID}, args...)...).All(&watcherIDs)
 	if err != nil {
-		return nil, fmt.Errorf("unable to get request organization user list, %s", err.Error())
+		return nil, fmt.Errorf("failed to find users with a matching watch, %s", err)
+	}
+	for _, id := range watcherIDs {
+		isWatched[id] = true
+	}
+
+	ids := make([]int, 0, len(isNear)+len(isWatched))
+	for id := range isNear {
+		ids = append(ids, id)
+	}
+	for id := range isWatched {
+		if !isNear[id] {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	q := DB.Where("id in (?)", ids)
+	if r.MeetingID.Valid {
+		q = q.Where("EXISTS (SELECT 1 FROM meetings m WHERE m.id = ? AND "+
+			meetingVisibleToUsersCondition("m", "users")+")", r.MeetingID.Int)
 	}
-	return users, nil
+	var users Users
+	if err := q.Order("id").All(&users); err != nil {
+		return nil, fmt.Errorf("failed to load interested users, %s", err)
+	}
+
+	var optOuts UserPreferences
+	if err := DB.Where("user_id in (?)", ids).
+		Where("key in (?)", domain.UserPreferenceKeyNotifyNewRequest, domain.UserPreferenceKeyNotifyWatchMatch).
+		Where("value = ?", domain.NotificationChannelNone).
+		All(&optOuts); err != nil {
+		return nil, fmt.Errorf("failed to load notification preferences of interested users, %s", err)
+	}
+	turnedOff := map[int][]string{}
+	for _, p := range optOuts {
+		turnedOff[p.UserID] = append(turnedOff[p.UserID], p.Key)
+	}
+
+	interested := make([]InterestedUser, 0, len(users))
+	for _, u := range users {
+		key := chooseRequestNotificationKey(isNear[u.ID], isWatched[u.ID], turnedOff[u.ID])
+		if key == "" {
+			continue
+		}
+		interested = append(interested, InterestedUser{User: u, PreferenceKey: key})
+	}
+	return interested, nil
 }
 
 // Meeting reads the meeting record, if it exists, and returns a pointer to the object.
@@ -1053,6 +1175,28 @@ func (r *Request) IsVisible(ctx context.Context, user User) bool {
 	return len(requests) > 0
 }
 
+// IsVisibleToOrganization returns true if the request can be seen by the users of the given organization under the
+// request's visibility rules. A request for a meeting is only visible if the meeting is also visible to the
+// organization.
+func (r *Request) IsVisibleToOrganization(orgID int) (bool, error) {
+	visible := r.OrganizationID == orgID || r.Visibility == RequestVisibilityAll
+	if !visible && r.Visibility == RequestVisibilityTrusted {
+		var err error
+		visible, err = DB.Where("primary_id = ? AND secondary_id = ?", orgID, r.OrganizationID).
+			Exists(&OrganizationTrust{})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if !visible || !r.MeetingID.Valid {
+		return visible, nil
+	}
+
+	meeting := Meeting{ID: r.MeetingID.Int}
+	return meeting.IsVisibleToOrganization(orgID)
+}
+
 func (r *Request) GetCurrentActions(user User) ([]string, error) {
 	transitions, err := r.GetStatusTransitions(user)
 	if err != nil {
@@ -264,6 +264,10 @@ func CreateFixtures_Requests_FindByUser(ms *ModelSuite) RequestFixtures {
 	requests[6].OrganizationID = orgs[2].ID
 	requests[6].Visibility = RequestVisibilityTrusted
 	requests[7].OrganizationID = orgs[2].ID
+
+	meeting := createMeetingFixtures(ms.DB, 1).Meetings[0]
+	requests[0].MeetingID = nulls.NewInt(meeting.ID)
+	requests[7].MeetingID = nulls.NewInt(meeting.ID)
 	ms.NoError(ms.DB.Save(&requests))
 
 	// can't go directly to "completed"
@@ -346,22 +350,3 @@ func CreateFixtures_Request_IsEditable(ms *ModelSuite) RequestFixtures {
 		Requests: requests,
 	}
 }
-
-func createFixturesForRequestGetAudience(ms *ModelSuite) RequestFixtures {
-	orgs := make(Organizations, 2)
-	for i := range orgs {
-		orgs[i] = Organization{UUID: domain.GetUUID(), AuthConfig: "{}"}
-		createFixture(ms, &orgs[i])
-	}
-
-	users := createUserFixtures(ms.DB, 2).Users
-
-	requests := createRequestFixtures(ms.DB, 2, false)
-	requests[1].OrganizationID = orgs[1].ID
-	ms.NoError(ms.DB.Save(&requests[1]))
-
-	return RequestFixtures{
-		Users:    users,
-		Requests: requests,
-	}
-}
@@ -1649,6 +1649,7 @@ func (ms *ModelSuite) TestRequests_FindByUser() {
 		dest           *Location
 		orig           *Location
 		requestID      *int
+		meetingID      *int
 		wantRequestIDs []int
 		wantErr        bool
 	}{
@@ -1662,6 +1663,10 @@ func (ms *ModelSuite) TestRequests_FindByUser() {
 		{name: "origin", user: f.Users[0], orig: &requestOneOrigin, wantRequestIDs: []int{f.Requests[1].ID}},
 		{name: "user 0, request 1 (visible)", user: f.Users[0], requestID: &f.Requests[1].ID, wantRequestIDs: []int{f.Requests[1].ID}},
 		{name: "user 0, request 2 (not visible)", user: f.Users[0], requestID: &f.Requests[2].ID, wantRequestIDs: []int{}},
+		{name: "user 0, meeting", user: f.Users[0], meetingID: &f.Requests[0].MeetingID.Int,
+			wantRequestIDs: []int{f.Requests[0].ID}},
+		{name: "user 2, meeting", user: f.Users[2], meetingID: &f.Requests[0].MeetingID.Int,
+			wantRequestIDs: []int{f.Requests[7].ID}},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -1671,6 +1676,7 @@ func (ms *ModelSuite) TestRequests_FindByUser() {
 				Destination: test.dest,
 				Origin:      test.orig,
 				RequestID:   test.requestID,
+				MeetingID:   test.meetingID,
 			}
 			err := requests.FindByUser(c, test.user, filter)
 
@@ -1726,6 +1732,43 @@ func (ms *ModelSuite) TestRequests_GetPotentialProviders() {
 	}
 }
 
+func (ms *ModelSuite) TestRequests_FindByMeeting() {
+	t := ms.T()
+	f := createMeetingFixtures(ms.DB, 2)
+	requests := createRequestFixtures(ms.DB, 4, false)
+	user := f.Users[0]
+
+	for i, m := range []int{0, 0, 0, 1} {
+		requests[i].MeetingID = nulls.NewInt(f.Meetings[m].ID)
+		ms.NoError(ms.DB.Update(&requests[i]))
+	}
+
+	tests := []struct {
+		name    string
+		meeting Meeting
+		page    int
+		perPage int
+		want    []int
+	}{
+		{name: "all", meeting: f.Meetings[0], page: 1, perPage: 10,
+			want: []int{requests[2].ID, requests[1].ID, requests[0].ID}},
+		{name: "first page", meeting: f.Meetings[0], page: 1, perPage: 2, want: []int{requests[2].ID, requests[1].ID}},
+		{name: "second page", meeting: f.Meetings[0], page: 2, perPage: 2, want: []int{requests[0].ID}},
+		{name: "other meeting", meeting: f.Meetings[1], page: 1, perPage: 10, want: []int{requests[3].ID}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Requests
+			ms.NoError(got.FindByMeeting(context.Background(), user, tt.meeting, tt.page, tt.perPage))
+			ids := make([]int, len(got))
+			for i := range got {
+				ids[i] = got[i].ID
+			}
+			ms.Equal(tt.want, ids)
+		})
+	}
+}
+
 func (ms *ModelSuite) TestRequests_FindByUser_SearchText() {
 	t := ms.T()
 	f := createFixtures_Requests_FindByUser_SearchText(ms)
@@ -1936,53 +1979,95 @@ func (ms *ModelSuite) TestRequest_canUserChangeStatus() {
 	}
 }
 
-func (ms *ModelSuite) TestRequest_GetAudience() {
+func (ms *ModelSuite) TestRequest_FindInterestedUsers() {
 	t := ms.T()
-	f := createFixturesForRequestGetAudience(ms)
+	f := createFixturesForRequestFindInterestedUsers(ms)
+
+	// a user in another organization, with a watch that matches every request, is not notified
+	outsider := createUserFixtures(ms.DB, 1).Users[0]
+	otherOrg := createOrganizationFixtures(ms.DB, 1)[0]
+	ms.NoError(ms.DB.RawQuery("UPDATE user_organizations SET organization_id = ? WHERE user_id = ?",
+		otherOrg.ID, outsider.ID).Exec())
+	createFixture(ms, &Watch{UUID: domain.GetUUID(), OwnerID: outsider.ID})
 
 	tests := []struct {
 		name    string
 		request Request
-		want    []int
-		wantErr string
+		want    map[int]string
 	}{
 		{
-			name:    "basic",
+			name:    "nobody",
 			request: f.Requests[0],
-			want:    []int{f.Users[0].ID, f.Users[1].ID},
+			want:    map[int]string{},
 		},
 		{
-			name:    "no users",
+			name:    "near",
 			request: f.Requests[1],
-			want:    []int{},
+			want:    map[int]string{f.Users[1].ID: domain.UserPreferenceKeyNotifyNewRequest},
 		},
 		{
-			name:    "invalid request",
-			request: Request{},
-			want:    []int{},
-			wantErr: "invalid request ID in GetAudience",
+			name:    "watch",
+			request: f.Requests[2],
+			want:    map[int]string{f.Users[2].ID: domain.UserPreferenceKeyNotifyWatchMatch},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := tt.request.GetAudience()
-			if tt.wantErr != "" {
-				ms.Error(err)
-				ms.Contains(err.Error(), tt.wantErr)
-				return
-			}
-
+			got, err := tt.request.FindInterestedUsers()
 			ms.NoError(err)
 
-			ids := make([]int, len(got))
-			for i := range got {
-				ids[i] = got[i].ID
+			keys := map[int]string{}
+			for _, u := range got {
+				keys[u.User.ID] = u.PreferenceKey
 			}
-			if !reflect.DeepEqual(ids, tt.want) {
-				t.Errorf("GetAudience()\ngot = %v\nwant %v", ids, tt.want)
+			ms.Equal(tt.want, keys)
+
+			// the result must be the same as checking each user in the organization individually
+			org, err := tt.request.GetOrganization()
+			ms.NoError(err)
+			users, err := org.GetUsers()
+			ms.NoError(err)
+			for _, user := range users {
+				ms.Equal(requestNotificationKey(user, tt.request), keys[user.ID], "mismatch for user %d", user.ID)
 			}
 		})
 	}
+
+	_, err := (&Request{}).FindInterestedUsers()
+	ms.Error(err, "expected an error for an invalid request")
+}
+
+// requestNotificationKey checks one user's interest in a new request, without the set-based queries of
+// FindInterestedUsers, to verify its result. Meeting visibility is not checked.
+func requestNotificationKey(u User, request Request) string {
+	if request.CreatedByID == u.ID {
+		return ""
+	}
+
+	var turnedOff []string
+	for _, key := range []string{domain.UserPreferenceKeyNotifyNewRequest, domain.UserPreferenceKeyNotifyWatchMatch} {
+		if u.GetNotificationChannel(key) == domain.NotificationChannelNone {
+			turnedOff = append(turnedOff, key)
+		}
+	}
+
+	isNear := false
+	origin, err := request.GetOrigin()
+	if err == nil && origin != nil && DB.Load(&u, "Location") == nil {
+		isNear = u.Location.IsNear(*origin)
+	}
+
+	isWatched := false
+	var watches Watches
+	if err := watches.FindByUser(u); err == nil {
+		for _, watch := range watches {
+			if watch.matchesRequest(request) {
+				isWatched = true
+			}
+		}
+	}
+
+	return chooseRequestNotificationKey(isNear, isWatched, turnedOff)
 }
 
 func (ms *ModelSuite) TestRequest_Meeting() {
@@ -0,0 +1,102 @@
+package models
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
+// unsubscribeTokenPurpose separates the unsubscribe token signatures from other uses of the session secret
+const unsubscribeTokenPurpose = "unsubscribe:"
+
+// ErrInvalidUnsubscribeToken is returned for an unsubscribe token that is malformed, forged or expired
+var ErrInvalidUnsubscribeToken = errors.New("invalid unsubscribe token")
+
+// IsUnsubscribeCategory returns true if the given category can be used in an unsubscribe token. The categories are
+// the notification preference keys and domain.UnsubscribeCategoryDigest.
+func IsUnsubscribeCategory(category string) bool {
+	return category == domain.UnsubscribeCategoryDigest || isNotificationPreferenceKey(category)
+}
+
+// NewUnsubscribeToken returns a signed token that allows the user to opt out of one category of notifications without
+// logging in. The token expires after domain.UnsubscribeTokenLifetime.
+func (u *User) NewUnsubscribeToken(category string) (string, error) {
+	if !IsUnsubscribeCategory(category) {
+		return "", fmt.Errorf("invalid unsubscribe category '%s'", category)
+	}
+	if domain.Env.SessionSecret == "" {
+		return "", errors.New("SESSION_SECRET is required to sign unsubscribe tokens")
+	}
+
+	expiresAt := time.Now().Add(domain.UnsubscribeTokenLifetime).Unix()
+	payload := strings.Join([]string{u.UUID.String(), category, strconv.FormatInt(expiresAt, 10)}, "|")
+	encoded := base64.RawURLEncoding.EncodeToString([]byte(payload))
+
+	return encoded + "." + signUnsubscribeToken(encoded), nil
+}
+
+// FindByUnsubscribeToken loads the user identified by a valid unsubscribe token, and returns the token's category
+func (u *User) FindByUnsubscribeToken(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return "", ErrInvalidUnsubscribeToken
+	}
+	if !hmac.Equal([]byte(parts[1]), []byte(signUnsubscribeToken(parts[0]))) {
+		return "", ErrInvalidUnsubscribeToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return "", ErrInvalidUnsubscribeToken
+	}
+	fields := strings.Split(string(payload), "|")
+	if len(fields) != 3 || !IsUnsubscribeCategory(fields[1]) {
+		return "", ErrInvalidUnsubscribeToken
+	}
+
+	expiresAt, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil || time.Now().Unix() > expiresAt {
+		return "", ErrInvalidUnsubscribeToken
+	}
+
+	if err := DB.Where("uuid = ?", fields[0]).First(u); err != nil {
+		if domain.IsOtherThanNoRows(err) {
+			return "", fmt.Errorf("error finding user by unsubscribe token, %s", err)
+		}
+		return "", ErrInvalidUnsubscribeToken
+	}
+
+	return fields[1], nil
+}
+
+// signUnsubscribeToken returns the URL-safe HMAC signature of the encoded token payload
+func signUnsubscribeToken(encodedPayload string) string {
+	mac := hmac.New(sha256.New, []byte(domain.Env.SessionSecret))
+	_, _ = mac.Write([]byte(unsubscribeTokenPurpose + encodedPayload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Unsubscribe turns off the notifications in the given unsubscribe category
+func (u *User) Unsubscribe(category string) error {
+	if !IsUnsubscribeCategory(category) {
+		return fmt.Errorf("invalid unsubscribe category '%s'", category)
+	}
+
+	prefs := NotificationPreferences{}
+	if category == domain.UnsubscribeCategoryDigest {
+		prefs[domain.UserPreferenceKeyNotifyNewRequest] = domain.NotificationChannelNone
+		prefs[domain.UserPreferenceKeyNotifyWatchMatch] = domain.NotificationChannelNone
+	} else {
+		prefs[category] = domain.NotificationChannelNone
+	}
+
+	_, err := u.UpdateNotificationPreferences(prefs)
+	return err
+}
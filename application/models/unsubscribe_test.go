@@ -0,0 +1,130 @@
+package models
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
+func (ms *ModelSuite) TestUser_FindByUnsubscribeToken() {
+	t := ms.T()
+	users := createUserFixtures(ms.DB, 2).Users
+
+	token, err := users[0].NewUnsubscribeToken(domain.UserPreferenceKeyNotifyNewMessage)
+	ms.NoError(err)
+
+	digestToken, err := users[1].NewUnsubscribeToken(domain.UnsubscribeCategoryDigest)
+	ms.NoError(err)
+
+	parts := strings.Split(token, ".")
+	otherParts := strings.Split(digestToken, ".")
+
+	expired := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%s|%s|%d",
+		users[0].UUID, domain.UserPreferenceKeyNotifyNewMessage, time.Now().Add(-time.Minute).Unix())))
+	expiredToken := expired + "." + signUnsubscribeToken(expired)
+
+	deletedUser := User{UUID: domain.GetUUID()}
+	deletedUserToken, err := deletedUser.NewUnsubscribeToken(domain.UserPreferenceKeyNotifyNewMessage)
+	ms.NoError(err)
+
+	tests := []struct {
+		name         string
+		token        string
+		wantUserID   int
+		wantCategory string
+		wantErr      bool
+	}{
+		{
+			name:         "notification preference",
+			token:        token,
+			wantUserID:   users[0].ID,
+			wantCategory: domain.UserPreferenceKeyNotifyNewMessage,
+		},
+		{
+			name:         "digest",
+			token:        digestToken,
+			wantUserID:   users[1].ID,
+			wantCategory: domain.UnsubscribeCategoryDigest,
+		},
+		{
+			name:    "swapped signature",
+			token:   parts[0] + "." + otherParts[1],
+			wantErr: true,
+		},
+		{
+			name:    "no signature",
+			token:   parts[0],
+			wantErr: true,
+		},
+		{
+			name:    "expired",
+			token:   expiredToken,
+			wantErr: true,
+		},
+		{
+			name:    "unknown user",
+			token:   deletedUserToken,
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var user User
+			category, err := user.FindByUnsubscribeToken(test.token)
+			if test.wantErr {
+				ms.Equal(ErrInvalidUnsubscribeToken, err)
+				return
+			}
+			ms.NoError(err)
+			ms.Equal(test.wantUserID, user.ID, "incorrect user")
+			ms.Equal(test.wantCategory, category, "incorrect category")
+		})
+	}
+}
+
+func (ms *ModelSuite) TestUser_NewUnsubscribeToken_InvalidCategory() {
+	user := createUserFixtures(ms.DB, 1).Users[0]
+
+	_, err := user.NewUnsubscribeToken("bogus")
+	ms.Error(err)
+}
+
+func (ms *ModelSuite) TestUser_Unsubscribe() {
+	t := ms.T()
+	users := createUserFixtures(ms.DB, 2).Users
+
+	tests := []struct {
+		name     string
+		user     User
+		category string
+		wantOff  []string
+	}{
+		{
+			name:     "notification preference",
+			user:     users[0],
+			category: domain.UserPreferenceKeyNotifyNewMessage,
+			wantOff:  []string{domain.UserPreferenceKeyNotifyNewMessage},
+		},
+		{
+			name:     "digest",
+			user:     users[1],
+			category: domain.UnsubscribeCategoryDigest,
+			wantOff:  []string{domain.UserPreferenceKeyNotifyNewRequest, domain.UserPreferenceKeyNotifyWatchMatch},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ms.NoError(test.user.Unsubscribe(test.category))
+
+			var user User
+			ms.NoError(user.FindByID(test.user.ID))
+			for _, key := range test.wantOff {
+				ms.Equal(domain.NotificationChannelNone, user.GetNotificationChannel(key), "%s is not turned off", key)
+			}
+		})
+	}
+}
@@ -63,6 +63,7 @@ type User struct {
 	FileID             nulls.Int         `json:"file_id" db:"file_id"`
 	AuthPhotoURL       nulls.String      `json:"auth_photo_url" db:"auth_photo_url"`
 	LocationID         nulls.Int         `json:"location_id" db:"location_id"`
+	PhoneNumber        nulls.String      `json:"phone_number" db:"phone_number"`
 	Organizations      Organizations     `many_to_many:"user_organizations" order_by:"name asc" json:"-"`
 	UserOrganizations  UserOrganizations `has_many:"user_organizations" json:"-"`
 	UserPreferences    UserPreferences   `has_many:"user_preferences" json:"-"`
@@ -95,6 +96,7 @@ func (u *User) Validate(tx *pop.Connection) (*validate.Errors, error) {
 		&validators.UUIDIsPresent{Field: u.UUID, Name: "UUID"},
 		&NullsStringIsURL{Field: u.AuthPhotoURL, Name: "AuthPhotoURL"},
 		&domain.StringIsVisible{Field: u.Nickname, Name: "Nickname"},
+		&NullsStringIsPhoneNumber{Field: u.PhoneNumber, Name: "PhoneNumber"},
 	), nil
 }
 
@@ -435,6 +437,11 @@ func (u *Users) FindByIDs(ids []int) error {
 	return DB.Where("id in (?)", ids).All(u)
 }
 
+// FindWithDigestItems finds all Users that have at least one item queued for their next digest
+func (u *Users) FindWithDigestItems() error {
+	return DB.Where("id in (SELECT DISTINCT user_id FROM digest_items)").All(u)
+}
+
 // HashClientIdAccessToken just returns a sha256.Sum256 of the input value
 func HashClientIdAccessToken(accessToken string) string {
 	return fmt.Sprintf("%x", sha256.Sum256([]byte(accessToken)))
@@ -639,53 +646,22 @@ func (u *User) GetThreads() (Threads, error) {
 	return t, nil
 }
 
-// WantsRequestNotification answers the question "Does the user want notifications for this request?"
-func (u *User) WantsRequestNotification(request Request) bool {
-	if request.CreatedByID == u.ID {
-		return false
-	}
-
-	if u.isNearRequest(request) {
-		return true
-	}
-
-	return u.hasMatchingWatch(request)
-}
-
-func (u *User) isNearRequest(request Request) bool {
-	if err := DB.Load(u, "Location"); err != nil {
-		domain.ErrLogger.Printf("load of user location failed, %s", err)
-		return false
+// chooseRequestNotificationKey picks the preference key for a new request notification. A request can interest a user
+// both because it is near and because it matches a watch, so the first of these that the user has not turned off
+// applies.
+func chooseRequestNotificationKey(isNear, isWatched bool, turnedOff []string) string {
+	off := map[string]bool{}
+	for _, key := range turnedOff {
+		off[key] = true
 	}
 
-	requestOrigin, err := request.GetOrigin()
-	if err != nil {
-		domain.ErrLogger.Printf("failed to get request origin, %s", err)
-		return false
-	}
-	if requestOrigin == nil {
-		return false
+	if isNear && !off[domain.UserPreferenceKeyNotifyNewRequest] {
+		return domain.UserPreferenceKeyNotifyNewRequest
 	}
-
-	if u.Location.IsNear(*requestOrigin) {
-		return true
+	if isWatched && !off[domain.UserPreferenceKeyNotifyWatchMatch] {
+		return domain.UserPreferenceKeyNotifyWatchMatch
 	}
-	return false
-}
-
-func (u *User) hasMatchingWatch(request Request) bool {
-	watches := Watches{}
-	if err := watches.FindByUser(*u); err != nil {
-		domain.ErrLogger.Printf("failed to get watch list, %s", err)
-		return false
-	}
-	for _, watch := range watches {
-		if watch.matchesRequest(request) {
-			return true
-		}
-	}
-
-	return false
+	return ""
 }
 
 // GetPreferences returns a StandardPreferences struct
@@ -736,6 +712,63 @@ func (u *User) UpdateStandardPreferences(prefs StandardPreferences) (StandardPre
 	return u.GetPreferences()
 }
 
+// GetNotificationPreferences returns the user's chosen channel for each type of notification. Any notification
+// type without a valid preference in the database is given the default channel, email.
+func (u *User) GetNotificationPreferences() (NotificationPreferences, error) {
+	if err := DB.Load(u, "UserPreferences"); err != nil {
+		err := errors.New("error getting user preferences ... " + err.Error())
+		return NotificationPreferences{}, err
+	}
+
+	dbPreferences := map[string]string{}
+	for _, uP := range u.UserPreferences {
+		dbPreferences[uP.Key] = uP.Value
+	}
+
+	prefs := NotificationPreferences{}
+	for _, key := range notificationPreferenceKeys {
+		value, ok := dbPreferences[key]
+		if !ok {
+			prefs[key] = domain.NotificationChannelEmail
+			continue
+		}
+		if !domain.IsNotificationChannelAllowed(value) {
+			domain.Logger.Printf("user preference %s in database not allowed ... %s", key, value)
+			value = domain.NotificationChannelEmail
+		}
+		prefs[key] = value
+	}
+
+	return prefs, nil
+}
+
+// UpdateNotificationPreferences validates and updates a user's notification preferences. Notification types not
+// included in `prefs` are left unchanged.
+func (u *User) UpdateNotificationPreferences(prefs NotificationPreferences) (NotificationPreferences, error) {
+	if err := updateUsersNotificationPreferences(*u, prefs); err != nil {
+		return NotificationPreferences{}, err
+	}
+
+	return u.GetNotificationPreferences()
+}
+
+// GetNotificationChannel returns the user's chosen channel for the type of notification identified by the given
+// preference key. If the preference cannot be determined, the default channel, email, is returned.
+func (u User) GetNotificationChannel(key string) string {
+	prefs, err := u.GetNotificationPreferences()
+	if err != nil {
+		domain.ErrLogger.Printf("error getting notification preferences for user %s, %s", u.UUID, err)
+		return domain.NotificationChannelEmail
+	}
+
+	channel, ok := prefs[key]
+	if !ok {
+		return domain.NotificationChannelEmail
+	}
+
+	return channel
+}
+
 func (u User) GetLanguagePreference() string {
 	prefs, err := u.GetPreferences()
 	if err != nil || prefs.Language == "" {
@@ -745,6 +778,17 @@ func (u User) GetLanguagePreference() string {
 	return prefs.Language
 }
 
+// GetDigestPreference returns the user's preference for receiving new request notifications: immediately, or in a
+// daily or weekly digest
+func (u User) GetDigestPreference() string {
+	prefs, err := u.GetPreferences()
+	if err != nil || prefs.Digest == "" {
+		return domain.UserPreferenceDigestImmediate
+	}
+
+	return prefs.Digest
+}
+
 // GetRealName returns the real name, first and last, of the user
 func (u *User) GetRealName() string {
 	return strings.TrimSpace(u.FirstName + " " + u.LastName)
@@ -782,18 +826,59 @@ func (u *User) MeetingsAsParticipant(ctx context.Context) ([]Meeting, error) {
 }
 
 func (u *User) CanCreateMeetingInvite(ctx buffalo.Context, meeting Meeting) bool {
-	return u.ID == meeting.CreatedByID || meeting.isOrganizer(ctx, u.ID) || u.isSuperAdmin()
+	return u.canManageMeeting(ctx, meeting)
 }
 
 func (u *User) CanRemoveMeetingInvite(ctx buffalo.Context, meeting Meeting) bool {
-	return u.ID == meeting.CreatedByID || meeting.isOrganizer(ctx, u.ID) || u.isSuperAdmin()
+	return u.canManageMeeting(ctx, meeting)
 }
 
-func (u *User) CanCreateMeetingParticipant(ctx buffalo.Context, meeting Meeting) bool {
+// CanViewMeeting returns true if the meeting is visible to the user under the meeting visibility rules
+func (u *User) CanViewMeeting(ctx buffalo.Context, meeting Meeting) bool {
 	return u.ID == meeting.CreatedByID || meeting.isVisible(ctx, u.ID) || u.isSuperAdmin()
 }
 
+// CanCreateMeetingParticipant returns true if the user may join the meeting without an invite code. INVITE_ONLY
+// meetings can only be joined with a code.
+func (u *User) CanCreateMeetingParticipant(ctx buffalo.Context, meeting Meeting) bool {
+	if u.ID == meeting.CreatedByID || u.isSuperAdmin() {
+		return true
+	}
+	return meeting.Visibility != MeetingVisibilityInviteOnly && meeting.isVisible(ctx, u.ID)
+}
+
+// CanRemoveMeetingParticipant returns true if the user may remove participants from the meeting. The meeting creator,
+// organizers and Super Admins are authorized.
 func (u *User) CanRemoveMeetingParticipant(ctx buffalo.Context, meeting Meeting) bool {
+	return u.canManageMeeting(ctx, meeting)
+}
+
+// CanCreateMeetingAnnouncement returns true if the user may post an announcement to the meeting participants. The
+// meeting creator, organizers and Super Admins are authorized.
+func (u *User) CanCreateMeetingAnnouncement(ctx buffalo.Context, meeting Meeting) bool {
+	return u.canManageMeeting(ctx, meeting)
+}
+
+// CanManageMeetingExchange returns true if the user may run the exchange session of the meeting, listing its pending
+// handoffs and completing them by code. The meeting creator, organizers and Super Admins are authorized.
+func (u *User) CanManageMeetingExchange(ctx buffalo.Context, meeting Meeting) bool {
+	return u.canManageMeeting(ctx, meeting)
+}
+
+// CanViewRequestHandoffCode returns true if the user is the receiver or the provider of the request
+func (u *User) CanViewRequestHandoffCode(request Request) bool {
+	return u.ID == request.CreatedByID || (request.ProviderID.Valid && u.ID == request.ProviderID.Int)
+}
+
+// CanManageMeetingOrganizers returns true if the user may add or remove organizers of the meeting. The meeting
+// creator, organizers and Super Admins are authorized.
+func (u *User) CanManageMeetingOrganizers(ctx buffalo.Context, meeting Meeting) bool {
+	return u.canManageMeeting(ctx, meeting)
+}
+
+// canManageMeeting returns true if the user may manage the people and activity of the meeting. The meeting creator and
+// organizers are authorized, as are Super Admins. Other admin roles are not, since they may only edit meeting details.
+func (u *User) canManageMeeting(ctx buffalo.Context, meeting Meeting) bool {
 	return u.ID == meeting.CreatedByID || meeting.isOrganizer(ctx, u.ID) || u.isSuperAdmin()
 }
 
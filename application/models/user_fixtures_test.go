@@ -403,7 +403,7 @@ func CreateUserFixtures_GetThreads(ms *ModelSuite) UserRequestFixtures {
 	}
 }
 
-func CreateFixturesForUserWantsRequestNotification(ms *ModelSuite) UserRequestFixtures {
+func createFixturesForRequestFindInterestedUsers(ms *ModelSuite) UserRequestFixtures {
 	uf := createUserFixtures(ms.DB, 3)
 	users := uf.Users
 
@@ -460,6 +460,35 @@ func CreateUserFixtures_TestGetPreference(ms *ModelSuite) UserRequestFixtures {
 	return UserRequestFixtures{Users: users, UserPreferences: userPreferences}
 }
 
+func CreateUserFixtures_TestNotificationPreferences(ms *ModelSuite) Users {
+	users := createUserFixtures(ms.DB, 2).Users
+
+	userPreferences := UserPreferences{
+		{
+			UserID: users[0].ID,
+			Key:    domain.UserPreferenceKeyNotifyNewRequest,
+			Value:  domain.NotificationChannelNone,
+		},
+		{
+			UserID: users[0].ID,
+			Key:    domain.UserPreferenceKeyNotifyNewMessage,
+			Value:  domain.NotificationChannelInApp,
+		},
+		{
+			UserID: users[0].ID,
+			Key:    domain.UserPreferenceKeyNotifyWatchMatch,
+			Value:  "carrier_pigeon",
+		},
+	}
+
+	for i := range userPreferences {
+		userPreferences[i].UUID = domain.GetUUID()
+		createFixture(ms, &userPreferences[i])
+	}
+
+	return users
+}
+
 func CreateUserFixtures_TestGetLanguagePreference(ms *ModelSuite) Users {
 	users := createUserFixtures(ms.DB, 3).Users
 
@@ -1376,52 +1376,42 @@ func (ms *ModelSuite) TestUser_GetThreads() {
 	}
 }
 
-func (ms *ModelSuite) TestUser_WantsRequestNotification() {
+func (ms *ModelSuite) Test_chooseRequestNotificationKey() {
 	t := ms.T()
-	f := CreateFixturesForUserWantsRequestNotification(ms)
+	newRequest := domain.UserPreferenceKeyNotifyNewRequest
+	watchMatch := domain.UserPreferenceKeyNotifyWatchMatch
 
 	tests := []struct {
-		name    string
-		user    User
-		request Request
-		want    bool
+		name      string
+		isNear    bool
+		isWatched bool
+		turnedOff []string
+		want      string
 	}{
+		{name: "neither", want: ""},
+		{name: "near", isNear: true, want: newRequest},
+		{name: "watched", isWatched: true, want: watchMatch},
+		{name: "near and watched", isNear: true, isWatched: true, want: newRequest},
+		{name: "near, turned off", isNear: true, turnedOff: []string{newRequest}, want: ""},
 		{
-			name:    "no, I created it",
-			user:    f.Users[0],
-			request: f.Requests[0],
-			want:    false,
-		},
-		{
-			name:    "no, it's a request for something not near me",
-			user:    f.Users[1],
-			request: f.Requests[0],
-			want:    false,
-		},
-		{
-			name:    "yes, it's a request for something near me",
-			user:    f.Users[1],
-			request: f.Requests[1],
-			want:    true,
-		},
-		{
-			name:    "no, there is no request origin",
-			user:    f.Users[1],
-			request: f.Requests[2],
-			want:    false,
+			name:      "near and watched, new request turned off",
+			isNear:    true,
+			isWatched: true,
+			turnedOff: []string{newRequest},
+			want:      watchMatch,
 		},
 		{
-			name:    "yes, I have a watch for that location",
-			user:    f.Users[2],
-			request: f.Requests[2],
-			want:    true,
+			name:      "near and watched, both turned off",
+			isNear:    true,
+			isWatched: true,
+			turnedOff: []string{newRequest, watchMatch},
+			want:      "",
 		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got := test.user.WantsRequestNotification(test.request)
-
-			ms.Equal(test.want, got, "incorrect result from WantsRequestNotification()")
+			got := chooseRequestNotificationKey(test.isNear, test.isWatched, test.turnedOff)
+			ms.Equal(test.want, got)
 		})
 	}
 }
@@ -1506,6 +1496,126 @@ func (ms *ModelSuite) TestUser_GetPreferences() {
 	}
 }
 
+func (ms *ModelSuite) TestUser_GetNotificationChannel() {
+	t := ms.T()
+
+	users := CreateUserFixtures_TestNotificationPreferences(ms)
+
+	tests := []struct {
+		name string
+		user User
+		key  string
+		want string
+	}{
+		{
+			name: "none",
+			user: users[0],
+			key:  domain.UserPreferenceKeyNotifyNewRequest,
+			want: domain.NotificationChannelNone,
+		},
+		{
+			name: "in-app",
+			user: users[0],
+			key:  domain.UserPreferenceKeyNotifyNewMessage,
+			want: domain.NotificationChannelInApp,
+		},
+		{
+			name: "invalid value in database so email default",
+			user: users[0],
+			key:  domain.UserPreferenceKeyNotifyWatchMatch,
+			want: domain.NotificationChannelEmail,
+		},
+		{
+			name: "not set so email default",
+			user: users[1],
+			key:  domain.UserPreferenceKeyNotifyNewRequest,
+			want: domain.NotificationChannelEmail,
+		},
+		{
+			name: "unknown key so email default",
+			user: users[1],
+			key:  "notify_unknown",
+			want: domain.NotificationChannelEmail,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.user.GetNotificationChannel(test.key)
+
+			ms.Equal(test.want, got, "incorrect result from GetNotificationChannel()")
+		})
+	}
+}
+
+func (ms *ModelSuite) TestUser_UpdateNotificationPreferences() {
+	t := ms.T()
+
+	users := CreateUserFixtures_TestNotificationPreferences(ms)
+
+	tests := []struct {
+		name    string
+		user    User
+		prefs   NotificationPreferences
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "change one, leave the others",
+			user: users[0],
+			prefs: NotificationPreferences{
+				domain.UserPreferenceKeyNotifyNewRequest: domain.NotificationChannelMobile,
+			},
+			want: map[string]string{
+				domain.UserPreferenceKeyNotifyNewRequest:     domain.NotificationChannelMobile,
+				domain.UserPreferenceKeyNotifyNewMessage:     domain.NotificationChannelInApp,
+				domain.UserPreferenceKeyNotifyRequestRemoved: domain.NotificationChannelEmail,
+			},
+		},
+		{
+			name: "start with none then add one",
+			user: users[1],
+			prefs: NotificationPreferences{
+				domain.UserPreferenceKeyNotifyOfferReceived: domain.NotificationChannelNone,
+			},
+			want: map[string]string{
+				domain.UserPreferenceKeyNotifyOfferReceived: domain.NotificationChannelNone,
+				domain.UserPreferenceKeyNotifyNewRequest:    domain.NotificationChannelEmail,
+			},
+		},
+		{
+			name: "bad channel",
+			user: users[1],
+			prefs: NotificationPreferences{
+				domain.UserPreferenceKeyNotifyOfferReceived: "carrier_pigeon",
+			},
+			wantErr: true,
+		},
+		{
+			name: "bad key",
+			user: users[1],
+			prefs: NotificationPreferences{
+				domain.UserPreferenceKeyLanguage: domain.NotificationChannelEmail,
+			},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.user.UpdateNotificationPreferences(test.prefs)
+			if test.wantErr {
+				ms.Error(err)
+				return
+			}
+			ms.NoError(err)
+
+			ms.Equal(len(notificationPreferenceKeys), len(got), "incorrect number of preferences")
+			for key, channel := range test.want {
+				ms.Equal(channel, got[key], "incorrect channel for %s", key)
+			}
+		})
+	}
+}
+
 func (ms *ModelSuite) TestUser_GetLanguagePreference() {
 	t := ms.T()
 
@@ -1721,6 +1831,35 @@ func (ms *ModelSuite) TestUser_CanCreateMeetingParticipant() {
 	}
 }
 
+func (ms *ModelSuite) TestUser_canManageMeeting() {
+	f := createMeetingFixtures(ms.DB, 1)
+
+	admins := createUserFixtures(ms.DB, 2).Users
+	admins[0].AdminRole = UserAdminRoleSuperAdmin
+	ms.NoError(admins[0].Save())
+	admins[1].AdminRole = UserAdminRoleSalesAdmin
+	ms.NoError(admins[1].Save())
+
+	tests := []struct {
+		name string
+		user User
+		want bool
+	}{
+		{name: "creator", user: f.Users[0], want: true},
+		{name: "organizer", user: f.Users[1], want: true},
+		{name: "participant", user: f.Users[2], want: false},
+		{name: "not a participant", user: f.Users[4], want: false},
+		{name: "super admin", user: admins[0], want: true},
+		{name: "sales admin", user: admins[1], want: false},
+	}
+	for _, tt := range tests {
+		ms.T().Run(tt.name, func(t *testing.T) {
+			got := tt.user.canManageMeeting(createTestContext(tt.user), f.Meetings[0])
+			ms.Equal(tt.want, got)
+		})
+	}
+}
+
 func (ms *ModelSuite) TestUsers_FindByIDs() {
 	t := ms.T()
 
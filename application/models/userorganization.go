@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/gobuffalo/events"
 	"github.com/gobuffalo/pop"
 	"github.com/gobuffalo/validate"
 	"github.com/gobuffalo/validate/validators"
+
+	"github.com/silinternational/wecarry-api/domain"
 )
 
 const UserOrganizationRoleUser = "user"
@@ -87,7 +90,28 @@ func (u *UserOrganizations) FindByAuthEmail(authEmail string, orgID int) error {
 	return nil
 }
 
+// UserOrganizationEventData is the event payload for a user joining an organization
+type UserOrganizationEventData struct {
+	UserID         int
+	OrganizationID int
+}
+
 // Create stores the UserOrganization data as a new record in the database.
 func (u *UserOrganization) Create() error {
 	return create(u)
 }
+
+// AfterCreate is called by Pop after successful creation of the record
+func (u *UserOrganization) AfterCreate(tx *pop.Connection) error {
+	e := events.Event{
+		Kind:    domain.EventApiUserOrganizationCreated,
+		Message: "User joined organization",
+		Payload: events.Payload{"eventData": UserOrganizationEventData{
+			UserID:         u.UserID,
+			OrganizationID: u.OrganizationID,
+		}},
+	}
+
+	emitEvent(e)
+	return nil
+}
@@ -14,15 +14,101 @@ import (
 )
 
 type StandardPreferences struct {
-	Language   string `json:"language"`
-	TimeZone   string `json:"time_zone"`
-	WeightUnit string `json:"weight_unit"`
+	Language        string `json:"language"`
+	TimeZone        string `json:"time_zone"`
+	WeightUnit      string `json:"weight_unit"`
+	Digest          string `json:"digest"`
+	QuietHoursStart string `json:"quiet_hours_start"`
+	QuietHoursEnd   string `json:"quiet_hours_end"`
 }
 
 func (s *StandardPreferences) hydrateValues(values map[string]string) {
 	s.Language = values[domain.UserPreferenceKeyLanguage]
 	s.TimeZone = values[domain.UserPreferenceKeyTimeZone]
 	s.WeightUnit = values[domain.UserPreferenceKeyWeightUnit]
+	s.Digest = values[domain.UserPreferenceKeyDigest]
+	s.QuietHoursStart = values[domain.UserPreferenceKeyQuietHoursStart]
+	s.QuietHoursEnd = values[domain.UserPreferenceKeyQuietHoursEnd]
+}
+
+// GetLocation returns the location of the user's preferred time zone, or UTC if there is no valid preference
+func (s StandardPreferences) GetLocation() *time.Location {
+	if s.TimeZone == "" {
+		return time.UTC
+	}
+
+	location, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// GetQuietHoursDelay returns the time remaining in the user's quiet hours at the given time, or zero if the time is
+// not within quiet hours. Quiet hours are in the user's time zone, and may span midnight. If either end of the quiet
+// hours is not set, or both are the same, there are no quiet hours.
+func (s StandardPreferences) GetQuietHoursDelay(t time.Time) time.Duration {
+	start, err := time.Parse(domain.QuietHoursTimeFormat, s.QuietHoursStart)
+	if err != nil {
+		return 0
+	}
+	end, err := time.Parse(domain.QuietHoursTimeFormat, s.QuietHoursEnd)
+	if err != nil {
+		return 0
+	}
+
+	local := t.In(s.GetLocation())
+	startAt := time.Date(local.Year(), local.Month(), local.Day(), start.Hour(), start.Minute(), 0, 0, local.Location())
+	endAt := time.Date(local.Year(), local.Month(), local.Day(), end.Hour(), end.Minute(), 0, 0, local.Location())
+
+	switch {
+	case startAt.Equal(endAt):
+		return 0
+	case startAt.Before(endAt):
+		if local.Before(startAt) || !local.Before(endAt) {
+			return 0
+		}
+	default:
+		// the quiet hours span midnight
+		if !local.Before(endAt) && local.Before(startAt) {
+			return 0
+		}
+		if !local.Before(startAt) {
+			endAt = endAt.AddDate(0, 0, 1)
+		}
+	}
+
+	return endAt.Sub(local)
+}
+
+// NotificationPreferences maps each notification preference key to the channel chosen by the user
+type NotificationPreferences map[string]string
+
+// notificationPreferenceKeys is the list of all notification preference keys
+var notificationPreferenceKeys = []string{
+	domain.UserPreferenceKeyNotifyNewRequest,
+	domain.UserPreferenceKeyNotifyWatchMatch,
+	domain.UserPreferenceKeyNotifyNewMessage,
+	domain.UserPreferenceKeyNotifyOfferReceived,
+	domain.UserPreferenceKeyNotifyOfferRejected,
+	domain.UserPreferenceKeyNotifyOfferRetracted,
+	domain.UserPreferenceKeyNotifyRequestAccepted,
+	domain.UserPreferenceKeyNotifyRequestDelivered,
+	domain.UserPreferenceKeyNotifyRequestReceived,
+	domain.UserPreferenceKeyNotifyRequestNotDelivered,
+	domain.UserPreferenceKeyNotifyRequestNotReceived,
+	domain.UserPreferenceKeyNotifyRequestReopened,
+	domain.UserPreferenceKeyNotifyRequestRemoved,
+	domain.UserPreferenceKeyNotifyMeetingAnnouncement,
+}
+
+func isNotificationPreferenceKey(key string) bool {
+	for _, k := range notificationPreferenceKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
 }
 
 type UserPreference struct {
@@ -107,6 +193,18 @@ func getPreferencesFieldsAndValidators(prefs StandardPreferences) map[string]fie
 		fieldValue: prefs.WeightUnit,
 		validator:  domain.IsWeightUnitAllowed,
 	}
+	fieldAndValidators[domain.UserPreferenceKeyDigest] = fieldAndValidator{
+		fieldValue: prefs.Digest,
+		validator:  domain.IsDigestAllowed,
+	}
+	fieldAndValidators[domain.UserPreferenceKeyQuietHoursStart] = fieldAndValidator{
+		fieldValue: prefs.QuietHoursStart,
+		validator:  domain.IsQuietHoursTimeAllowed,
+	}
+	fieldAndValidators[domain.UserPreferenceKeyQuietHoursEnd] = fieldAndValidator{
+		fieldValue: prefs.QuietHoursEnd,
+		validator:  domain.IsQuietHoursTimeAllowed,
+	}
 
 	return fieldAndValidators
 }
@@ -185,6 +283,26 @@ func updateUsersStandardPreferences(user User, prefs StandardPreferences) error
 	return nil
 }
 
+func updateUsersNotificationPreferences(user User, prefs NotificationPreferences) error {
+	for key, channel := range prefs {
+		if !isNotificationPreferenceKey(key) {
+			return fmt.Errorf("unexpected notification preference key %s", key)
+		}
+		if !domain.IsNotificationChannelAllowed(channel) {
+			return fmt.Errorf("unexpected UserPreference %s ... %s", key, channel)
+		}
+	}
+
+	for key, channel := range prefs {
+		var p UserPreference
+		if err := p.updateForUserByKey(user, key, channel); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (p *UserPreference) removeAll(userID int) error {
 	return DB.RawQuery("DELETE FROM user_preferences WHERE user_id = ?", userID).Exec()
 }
@@ -6,6 +6,7 @@ import (
 	"runtime"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/gobuffalo/validate"
 	"github.com/silinternational/wecarry-api/domain"
@@ -240,6 +241,82 @@ func (ms *ModelSuite) TestUserPreference_getPreferencesFieldsAndValidators() {
 	ms.Equal(wantValrs, gotValrs, "incorrect validators")
 }
 
+func (ms *ModelSuite) TestStandardPreferences_GetQuietHoursDelay() {
+	t := ms.T()
+
+	// 21 April 2020 is a Tuesday. Seoul is UTC+9 with no daylight saving time.
+	at := func(hour, minute int) time.Time {
+		return time.Date(2020, 4, 21, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name  string
+		prefs StandardPreferences
+		t     time.Time
+		want  time.Duration
+	}{
+		{
+			name:  "no quiet hours",
+			prefs: StandardPreferences{},
+			t:     at(3, 0),
+			want:  0,
+		},
+		{
+			name:  "no end",
+			prefs: StandardPreferences{QuietHoursStart: "00:00"},
+			t:     at(3, 0),
+			want:  0,
+		},
+		{
+			name:  "same start and end",
+			prefs: StandardPreferences{QuietHoursStart: "03:00", QuietHoursEnd: "03:00"},
+			t:     at(3, 0),
+			want:  0,
+		},
+		{
+			name:  "within quiet hours",
+			prefs: StandardPreferences{QuietHoursStart: "01:00", QuietHoursEnd: "06:00"},
+			t:     at(3, 0),
+			want:  3 * time.Hour,
+		},
+		{
+			name:  "at the end of quiet hours",
+			prefs: StandardPreferences{QuietHoursStart: "01:00", QuietHoursEnd: "06:00"},
+			t:     at(6, 0),
+			want:  0,
+		},
+		{
+			name:  "before midnight in quiet hours that span midnight",
+			prefs: StandardPreferences{QuietHoursStart: "22:00", QuietHoursEnd: "07:00"},
+			t:     at(23, 15),
+			want:  7*time.Hour + 45*time.Minute,
+		},
+		{
+			name:  "after midnight in quiet hours that span midnight",
+			prefs: StandardPreferences{QuietHoursStart: "22:00", QuietHoursEnd: "07:00"},
+			t:     at(2, 0),
+			want:  5 * time.Hour,
+		},
+		{
+			name:  "outside quiet hours that span midnight",
+			prefs: StandardPreferences{QuietHoursStart: "22:00", QuietHoursEnd: "07:00"},
+			t:     at(12, 0),
+			want:  0,
+		},
+		{
+			name:  "user's time zone",
+			prefs: StandardPreferences{TimeZone: "Asia/Seoul", QuietHoursStart: "22:00", QuietHoursEnd: "07:00"},
+			t:     at(14, 0),
+			want:  3 * time.Hour,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ms.Equal(test.want, test.prefs.GetQuietHoursDelay(test.t))
+		})
+	}
+}
+
 func (ms *ModelSuite) TestUserPreference_updateForUserByKey() {
 	t := ms.T()
 
@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/gobuffalo/events"
 	"github.com/gobuffalo/nulls"
 	"github.com/gobuffalo/pop"
 	"github.com/gobuffalo/validate"
@@ -16,6 +19,45 @@ import (
 	"github.com/silinternational/wecarry-api/domain"
 )
 
+// WatchLocationMatch determines how the destination and origin of a watch are compared to request locations
+type WatchLocationMatch string
+
+const (
+	// WatchLocationMatchNear matches request locations within the watch radius
+	WatchLocationMatchNear WatchLocationMatch = "NEAR"
+	// WatchLocationMatchCountry matches request locations in the same country, regardless of distance
+	WatchLocationMatchCountry WatchLocationMatch = "COUNTRY"
+)
+
+func (e WatchLocationMatch) IsValid() bool {
+	switch e {
+	case WatchLocationMatchNear, WatchLocationMatchCountry:
+		return true
+	}
+	return false
+}
+
+func (e WatchLocationMatch) String() string {
+	return string(e)
+}
+
+func (e *WatchLocationMatch) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WatchLocationMatch(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WatchLocationMatch", str)
+	}
+	return nil
+}
+
+func (e WatchLocationMatch) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // Watch is the model for storing request watches that trigger notifications on the conditions specified
 type Watch struct {
 	ID            int          `json:"id" db:"id"`
@@ -29,6 +71,25 @@ type Watch struct {
 	MeetingID     nulls.Int    `json:"meeting_id" db:"meeting_id"`
 	SearchText    nulls.String `json:"search_text" db:"search_text"`
 	Size          *RequestSize `json:"size" db:"size"`
+
+	// RadiusKm is the maximum distance of a request location from the watch destination or origin. If null,
+	// domain.DefaultProximityDistanceKm is used.
+	RadiusKm nulls.Int `json:"radius_km" db:"radius_km"`
+
+	// LocationMatch determines whether request locations must be near the watch locations or only in the same country
+	LocationMatch WatchLocationMatch `json:"location_match" db:"location_match"`
+
+	// NeededAfter and NeededBefore are the bounds of the request needed-before date. Requests without a needed-before
+	// date are not limited by these bounds.
+	NeededAfter  nulls.Time `json:"needed_after" db:"needed_after"`
+	NeededBefore nulls.Time `json:"needed_before" db:"needed_before"`
+
+	// Kilograms is the maximum weight of a request. Requests without a weight are not limited.
+	Kilograms nulls.Float64 `json:"kilograms" db:"kilograms"`
+
+	// ExpiresOn is the last day the watch is active. After that, the watch is deactivated and the owner is notified.
+	ExpiresOn     nulls.Time `json:"expires_on" db:"expires_on"`
+	DeactivatedAt nulls.Time `json:"deactivated_at" db:"deactivated_at"`
 }
 
 // Watches is used for methods that operate on lists of objects
@@ -39,9 +100,79 @@ func (w *Watch) Validate(tx *pop.Connection) (*validate.Errors, error) {
 	return validate.Validate(
 		&validators.UUIDIsPresent{Field: w.UUID, Name: "UUID"},
 		&validators.IntIsPresent{Field: w.OwnerID, Name: "OwnerID"},
+		&watchRadiusValidator{Field: w.RadiusKm, Name: "RadiusKm"},
+		&watchLocationMatchValidator{Field: w.LocationMatch, Name: "LocationMatch"},
+		&watchDatesValidator{NeededAfter: w.NeededAfter, NeededBefore: w.NeededBefore, Name: "NeededBefore"},
+		&watchKilogramsValidator{Field: w.Kilograms, Name: "Kilograms"},
 	), nil
 }
 
+type watchRadiusValidator struct {
+	Name    string
+	Field   nulls.Int
+	Message string
+}
+
+func (v *watchRadiusValidator) IsValid(errors *validate.Errors) {
+	if !v.Field.Valid || (v.Field.Int > 0 && v.Field.Int <= domain.MaxWatchRadiusKm) {
+		return
+	}
+	v.Message = fmt.Sprintf("watch radius must be between 1 and %d km", domain.MaxWatchRadiusKm)
+	errors.Add(validators.GenerateKey(v.Name), v.Message)
+}
+
+type watchLocationMatchValidator struct {
+	Name    string
+	Field   WatchLocationMatch
+	Message string
+}
+
+// IsValid accepts an empty value, which is replaced by WatchLocationMatchNear when the watch is saved
+func (v *watchLocationMatchValidator) IsValid(errors *validate.Errors) {
+	if v.Field == "" || v.Field.IsValid() {
+		return
+	}
+	v.Message = fmt.Sprintf("%s is not a valid watch location match", v.Field)
+	errors.Add(validators.GenerateKey(v.Name), v.Message)
+}
+
+type watchDatesValidator struct {
+	Name         string
+	NeededAfter  nulls.Time
+	NeededBefore nulls.Time
+	Message      string
+}
+
+func (v *watchDatesValidator) IsValid(errors *validate.Errors) {
+	if !v.NeededAfter.Valid || !v.NeededBefore.Valid || !v.NeededBefore.Time.Before(v.NeededAfter.Time) {
+		return
+	}
+	v.Message = "watch neededBefore date must not be before the neededAfter date"
+	errors.Add(validators.GenerateKey(v.Name), v.Message)
+}
+
+type watchKilogramsValidator struct {
+	Name    string
+	Field   nulls.Float64
+	Message string
+}
+
+func (v *watchKilogramsValidator) IsValid(errors *validate.Errors) {
+	if !v.Field.Valid || v.Field.Float64 > 0 {
+		return
+	}
+	v.Message = "watch kilograms must be greater than zero"
+	errors.Add(validators.GenerateKey(v.Name), v.Message)
+}
+
+// BeforeSave sets the default location match
+func (w *Watch) BeforeSave(tx *pop.Connection) error {
+	if w.LocationMatch == "" {
+		w.LocationMatch = WatchLocationMatchNear
+	}
+	return nil
+}
+
 // ValidateCreate gets run every time you call "pop.ValidateAndCreate" method.
 func (w *Watch) ValidateCreate(tx *pop.Connection) (*validate.Errors, error) {
 	return validate.NewErrors(), nil
@@ -84,6 +215,38 @@ func (w *Watches) FindByUser(user User) error {
 	return nil
 }
 
+// FindExpired finds all active watches whose expiration date is before the given time
+func (w *Watches) FindExpired(t time.Time) error {
+	return DB.Where("deactivated_at IS NULL AND expires_on < ?::date", t.UTC().Format(domain.DateFormat)).
+		Order("id").All(w)
+}
+
+// IsExpired returns true if the watch expiration date is before the given time
+func (w *Watch) IsExpired(t time.Time) bool {
+	return w.ExpiresOn.Valid && w.ExpiresOn.Time.Format(domain.DateFormat) < t.UTC().Format(domain.DateFormat)
+}
+
+// IsActive returns true if the watch has not been deactivated and has not expired at the given time
+func (w *Watch) IsActive(t time.Time) bool {
+	return !w.DeactivatedAt.Valid && !w.IsExpired(t)
+}
+
+// Deactivate stops the watch from matching new requests
+func (w *Watch) Deactivate() error {
+	w.DeactivatedAt = nulls.NewTime(time.Now())
+	return w.Update()
+}
+
+// SendSummary triggers a message to the watch owner listing the open requests that currently match the watch
+func (w *Watch) SendSummary() {
+	e := events.Event{
+		Kind:    domain.EventApiWatchSummaryRequested,
+		Message: "Summary requested for Watch " + w.UUID.String(),
+		Payload: events.Payload{domain.ArgWatchID: w.ID},
+	}
+	emitEvent(e)
+}
+
 // GetOwner returns the owner of the watch.
 func (w *Watch) GetOwner() (*User, error) {
 	owner := User{}
@@ -159,18 +322,23 @@ func (w *Watch) Meeting(ctx context.Context) (*Meeting, error) {
 	return meeting, nil
 }
 
-// matchesRequest returns true if all non-null watch criteria match the request
+// matchesRequest returns true if the watch is active and all non-null watch criteria match the request
 func (w *Watch) matchesRequest(request Request) bool {
 	if w == nil {
 		domain.ErrLogger.Printf("nil receiver in Watch.matchesRequest")
 		return false
 	}
+	if !w.IsActive(time.Now()) {
+		return false
+	}
 	matchFunctions := []func(*Watch, Request) bool{
 		(*Watch).sizeMatches,
 		(*Watch).textMatches,
 		(*Watch).meetingMatches,
 		(*Watch).destinationMatches,
 		(*Watch).originMatches,
+		(*Watch).datesMatch,
+		(*Watch).kilogramsMatches,
 	}
 	for _, c := range matchFunctions {
 		if !c(w, request) {
@@ -180,7 +348,239 @@ func (w *Watch) matchesRequest(request Request) bool {
 	return true
 }
 
-// destinationMatches returns true if watch destination is not provided or passes the IsNear test
+// watchMatchCondition returns an SQL condition that selects the watches matching the request. It is the set-based
+// equivalent of matchesRequest, for a query on `watches w` with the watch destination joined as `wd` and the watch
+// origin joined as `wo`.
+func watchMatchCondition(request Request) (string, []interface{}, error) {
+	// active: the watch has not been deactivated and has not expired
+	conditions := []string{"w.deactivated_at IS NULL", "(w.expires_on IS NULL OR w.expires_on >= ?::date)"}
+	args := []interface{}{time.Now().UTC().Format(domain.DateFormat)}
+
+	// size: the watch size is unset or is larger than or the same as the request size
+	var smallerSizes []interface{}
+	for _, size := range []RequestSize{RequestSizeTiny, RequestSizeSmall, RequestSizeMedium, RequestSizeLarge,
+		RequestSizeXlarge} {
+		if !size.isLargerOrSame(request.Size) {
+			smallerSizes = append(smallerSizes, size)
+		}
+	}
+	if len(smallerSizes) > 0 {
+		conditions = append(conditions, "(w.size IS NULL OR w.size NOT IN (?"+strings.Repeat(", ?", len(smallerSizes)-1)+"))")
+		args = append(args, smallerSizes...)
+	}
+
+	// text: the watch search text is unset or is in the request title, description or creator's nickname
+	creator, err := request.Creator()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get request %s creator, %s", request.UUID, err)
+	}
+	conditions = append(conditions, "(w.search_text IS NULL OR strpos(?, w.search_text) > 0 "+
+		"OR strpos(?, w.search_text) > 0 OR strpos(?, w.search_text) > 0)")
+	args = append(args, request.Title, request.Description.String, creator.Nickname)
+
+	// meeting: the watch meeting is unset or is the request meeting
+	if request.MeetingID.Valid {
+		conditions = append(conditions, "(w.meeting_id IS NULL OR w.meeting_id = ?)")
+		args = append(args, request.MeetingID.Int)
+	} else {
+		conditions = append(conditions, "w.meeting_id IS NULL")
+	}
+
+	// destination and origin: the watch location is unset or matches the request location
+	destination, err := request.GetDestination()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get request %s destination, %s", request.UUID, err)
+	}
+	match, matchArgs := watchLocationCondition("wd", *destination)
+	conditions = append(conditions, "(w.destination_id IS NULL OR "+match+")")
+	args = append(args, matchArgs...)
+
+	origin, err := request.GetOrigin()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get request %s origin, %s", request.UUID, err)
+	}
+	if origin == nil {
+		conditions = append(conditions, "w.origin_id IS NULL")
+	} else {
+		match, matchArgs = watchLocationCondition("wo", *origin)
+		conditions = append(conditions, "(w.origin_id IS NULL OR "+match+")")
+		args = append(args, matchArgs...)
+	}
+
+	// dates: the request has no needed-before date, or it is within the watch date bounds
+	if request.NeededBefore.Valid {
+		neededBefore := request.NeededBefore.Time.Format(domain.DateFormat)
+		conditions = append(conditions, "(w.needed_after IS NULL OR w.needed_after <= ?::date)",
+			"(w.needed_before IS NULL OR w.needed_before >= ?::date)")
+		args = append(args, neededBefore, neededBefore)
+	}
+
+	// kilograms: the request has no weight, or it is not more than the watch maximum
+	if request.Kilograms.Valid {
+		conditions = append(conditions, "(w.kilograms IS NULL OR w.kilograms >= ?)")
+		args = append(args, request.Kilograms.Float64)
+	}
+
+	return strings.Join(conditions, " AND "), args, nil
+}
+
+// watchLocationCondition returns an SQL condition that is true if the watch location, joined as `alias`, matches
+// the request location in the same way as locationMatches
+func watchLocationCondition(alias string, loc Location) (string, []interface{}) {
+	radius := fmt.Sprintf("coalesce(w.radius_km, %d)", domain.DefaultProximityDistanceKm)
+	near, nearArgs := nearCondition(alias, loc, radius)
+	condition := fmt.Sprintf("(CASE WHEN w.location_match = '%s' THEN %s.country <> '' AND %s.country = ? ELSE %s END)",
+		WatchLocationMatchCountry, alias, alias, near)
+	return condition, append([]interface{}{loc.Country}, nearArgs...)
+}
+
+// watchMatchJoins are the joins of the watch locations required by watchMatchCondition
+const watchMatchJoins = "LEFT JOIN locations wd ON wd.id = w.destination_id " +
+	"LEFT JOIN locations wo ON wo.id = w.origin_id"
+
+// FindByWatch finds one page of the open requests, newest first, that currently match the watch and are visible to
+// the watch owner, using the same visibility rules as FindByUser. Requests created by the watch owner are not included.
+// `page` starts at 1.
+func (p *Requests) FindByWatch(ctx context.Context, watch Watch, page, perPage int) error {
+	*p = Requests{}
+
+	if !watch.IsActive(time.Now()) {
+		return nil
+	}
+
+	owner, err := watch.GetOwner()
+	if err != nil {
+		return fmt.Errorf("failed to get watch %s owner, %s", watch.UUID, err)
+	}
+	if !owner.HasOrganization() {
+		return nil
+	}
+
+	condition, matchArgs, err := requestMatchCondition(watch)
+	if err != nil {
+		return err
+	}
+
+	query, args := visibleRequestsQuery(*owner, requestMatchJoins)
+	query += " AND requests.status = ? AND requests.created_by_id <> ? AND " + condition +
+		" ORDER BY requests.created_at desc, requests.id desc LIMIT ? OFFSET ?"
+	args = append(args, RequestStatusOpen, owner.ID)
+	args = append(args, matchArgs...)
+	args = append(args, perPage, (page-1)*perPage)
+
+	if err := DB.RawQuery(query, args...).All(p); err != nil {
+		return fmt.Errorf("error finding requests for watch %s, %s", watch.UUID, err)
+	}
+	return nil
+}
+
+// requestMatchJoins are the joins of the request locations and creator required by requestMatchCondition
+const requestMatchJoins = "JOIN locations rd ON rd.id = requests.destination_id " +
+	"LEFT JOIN locations ro ON ro.id = requests.origin_id " +
+	"JOIN users rc ON rc.id = requests.created_by_id"
+
+// requestMatchCondition returns an SQL condition that selects the requests matching the watch criteria. It is the
+// converse of watchMatchCondition, for a query on `requests` with requestMatchJoins. Whether the watch is active is
+// not checked.
+func requestMatchCondition(w Watch) (string, []interface{}, error) {
+	conditions := []string{"TRUE"}
+	var args []interface{}
+
+	// size: the watch size is unset or is larger than or the same as the request size
+	if w.Size != nil {
+		var sizes []interface{}
+		for _, size := range []RequestSize{RequestSizeTiny, RequestSizeSmall, RequestSizeMedium, RequestSizeLarge,
+			RequestSizeXlarge} {
+			if w.Size.isLargerOrSame(size) {
+				sizes = append(sizes, size)
+			}
+		}
+		conditions = append(conditions, "requests.size IN (?"+strings.Repeat(", ?", len(sizes)-1)+")")
+		args = append(args, sizes...)
+	}
+
+	// text: the watch search text is unset or is in the request title, description or creator's nickname
+	if w.SearchText.Valid {
+		conditions = append(conditions, "(strpos(requests.title, ?) > 0 "+
+			"OR strpos(coalesce(requests.description, ''), ?) > 0 OR strpos(rc.nickname, ?) > 0)")
+		args = append(args, w.SearchText.String, w.SearchText.String, w.SearchText.String)
+	}
+
+	// meeting: the watch meeting is unset or is the request meeting
+	if w.MeetingID.Valid {
+		conditions = append(conditions, "requests.meeting_id = ?")
+		args = append(args, w.MeetingID.Int)
+	}
+
+	// destination and origin: the watch location is unset or matches the request location
+	if w.DestinationID.Valid {
+		destination, err := w.GetDestination()
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to get watch %s destination, %s", w.UUID, err)
+		}
+		match, matchArgs := w.requestLocationCondition("rd", *destination)
+		conditions = append(conditions, match)
+		args = append(args, matchArgs...)
+	}
+	if w.OriginID.Valid {
+		origin, err := w.GetOrigin()
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to get watch %s origin, %s", w.UUID, err)
+		}
+		match, matchArgs := w.requestLocationCondition("ro", *origin)
+		conditions = append(conditions, "(requests.origin_id IS NOT NULL AND "+match+")")
+		args = append(args, matchArgs...)
+	}
+
+	// dates: the request has no needed-before date, or it is within the watch date bounds
+	if w.NeededAfter.Valid {
+		conditions = append(conditions, "(requests.needed_before IS NULL OR requests.needed_before::date >= ?::date)")
+		args = append(args, w.NeededAfter.Time.Format(domain.DateFormat))
+	}
+	if w.NeededBefore.Valid {
+		conditions = append(conditions, "(requests.needed_before IS NULL OR requests.needed_before::date <= ?::date)")
+		args = append(args, w.NeededBefore.Time.Format(domain.DateFormat))
+	}
+
+	// kilograms: the request has no weight, or it is not more than the watch maximum
+	if w.Kilograms.Valid {
+		conditions = append(conditions, "(requests.kilograms IS NULL OR requests.kilograms <= ?)")
+		args = append(args, w.Kilograms.Float64)
+	}
+
+	return strings.Join(conditions, " AND "), args, nil
+}
+
+// requestLocationCondition returns an SQL condition that is true if the request location, joined as `alias`, matches
+// the watch location in the same way as locationMatches
+func (w *Watch) requestLocationCondition(alias string, watchLocation Location) (string, []interface{}) {
+	if w.LocationMatch == WatchLocationMatchCountry {
+		if watchLocation.Country == "" {
+			return "FALSE", nil
+		}
+		return alias + ".country = ?", []interface{}{watchLocation.Country}
+	}
+	return nearCondition(alias, watchLocation, strconv.Itoa(w.radiusKm()))
+}
+
+// radiusKm returns the maximum distance of a matching request location from the watch locations
+func (w *Watch) radiusKm() int {
+	if w.RadiusKm.Valid {
+		return w.RadiusKm.Int
+	}
+	return domain.DefaultProximityDistanceKm
+}
+
+// locationMatches returns true if the request location is in the same country as the watch location, for a watch
+// that matches by country, or otherwise is within the watch radius of the watch location
+func (w *Watch) locationMatches(watchLocation, requestLocation Location) bool {
+	if w.LocationMatch == WatchLocationMatchCountry {
+		return watchLocation.Country != "" && watchLocation.Country == requestLocation.Country
+	}
+	return watchLocation.IsWithin(requestLocation, float64(w.radiusKm()))
+}
+
+// destinationMatches returns true if watch destination is not provided or matches the request destination
 func (w *Watch) destinationMatches(request Request) bool {
 	if w == nil {
 		domain.ErrLogger.Printf("nil receiver in Watch.destinationMatches")
@@ -197,11 +597,12 @@ func (w *Watch) destinationMatches(request Request) bool {
 	watchDestination, err := w.GetDestination()
 	if err != nil {
 		domain.ErrLogger.Printf("failed to get watch %s destination in destinationMatches, %s", w.UUID, err)
+		return false
 	}
-	return watchDestination.IsNear(*requestDestination)
+	return w.locationMatches(*watchDestination, *requestDestination)
 }
 
-// originMatches returns true if watch origin is not provided or passes the IsNear test
+// originMatches returns true if watch origin is not provided or matches the request origin
 func (w *Watch) originMatches(request Request) bool {
 	if w == nil {
 		domain.ErrLogger.Printf("nil receiver in Watch.originMatches")
@@ -215,11 +616,15 @@ func (w *Watch) originMatches(request Request) bool {
 		domain.ErrLogger.Printf("failed to get request %s origin in originMatches, %s", request.UUID, err)
 		return false
 	}
+	if requestOrigin == nil {
+		return false
+	}
 	watchOrigin, err := w.GetOrigin()
 	if err != nil {
 		domain.ErrLogger.Printf("failed to get watch %s origin in originMatches, %s", w.UUID, err)
+		return false
 	}
-	return watchOrigin.IsNear(*requestOrigin)
+	return w.locationMatches(*watchOrigin, *requestOrigin)
 }
 
 // meetingMatches returns true if watch meeting is not provided or is identical to the request meeting
@@ -271,3 +676,35 @@ func (w *Watch) sizeMatches(request Request) bool {
 	}
 	return w.Size.isLargerOrSame(request.Size)
 }
+
+// datesMatch returns true if the request has no needed-before date, or if it is within the watch date bounds
+func (w *Watch) datesMatch(request Request) bool {
+	if w == nil {
+		domain.ErrLogger.Printf("nil receiver in Watch.datesMatch")
+		return false
+	}
+	if !request.NeededBefore.Valid {
+		return true
+	}
+	neededBefore := request.NeededBefore.Time.Format(domain.DateFormat)
+	if w.NeededAfter.Valid && neededBefore < w.NeededAfter.Time.Format(domain.DateFormat) {
+		return false
+	}
+	if w.NeededBefore.Valid && neededBefore > w.NeededBefore.Time.Format(domain.DateFormat) {
+		return false
+	}
+	return true
+}
+
+// kilogramsMatches returns true if watch kilograms is not provided, the request has no weight, or the request weight
+// is not more than the watch kilograms
+func (w *Watch) kilogramsMatches(request Request) bool {
+	if w == nil {
+		domain.ErrLogger.Printf("nil receiver in Watch.kilogramsMatches")
+		return false
+	}
+	if !w.Kilograms.Valid || !request.Kilograms.Valid {
+		return true
+	}
+	return request.Kilograms.Float64 <= w.Kilograms.Float64
+}
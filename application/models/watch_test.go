@@ -1,7 +1,9 @@
 package models
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/gobuffalo/nulls"
 	"github.com/gobuffalo/pop"
@@ -41,6 +43,78 @@ func (ms *ModelSuite) TestWatch_Validate() {
 			wantErr:  true,
 			errField: "owner_id",
 		},
+		{
+			name: "radius and location match",
+			watch: Watch{
+				UUID:          domain.GetUUID(),
+				OwnerID:       1,
+				RadiusKm:      nulls.NewInt(domain.MaxWatchRadiusKm),
+				LocationMatch: WatchLocationMatchCountry,
+			},
+			wantErr: false,
+		},
+		{
+			name: "zero radius",
+			watch: Watch{
+				UUID:     domain.GetUUID(),
+				OwnerID:  1,
+				RadiusKm: nulls.NewInt(0),
+			},
+			wantErr:  true,
+			errField: "radius_km",
+		},
+		{
+			name: "radius too large",
+			watch: Watch{
+				UUID:     domain.GetUUID(),
+				OwnerID:  1,
+				RadiusKm: nulls.NewInt(domain.MaxWatchRadiusKm + 1),
+			},
+			wantErr:  true,
+			errField: "radius_km",
+		},
+		{
+			name: "dates and kilograms",
+			watch: Watch{
+				UUID:         domain.GetUUID(),
+				OwnerID:      1,
+				NeededAfter:  nulls.NewTime(time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)),
+				NeededBefore: nulls.NewTime(time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)),
+				Kilograms:    nulls.NewFloat64(0.5),
+			},
+			wantErr: false,
+		},
+		{
+			name: "needed before earlier than needed after",
+			watch: Watch{
+				UUID:         domain.GetUUID(),
+				OwnerID:      1,
+				NeededAfter:  nulls.NewTime(time.Date(2030, 6, 2, 0, 0, 0, 0, time.UTC)),
+				NeededBefore: nulls.NewTime(time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			wantErr:  true,
+			errField: "needed_before",
+		},
+		{
+			name: "zero kilograms",
+			watch: Watch{
+				UUID:      domain.GetUUID(),
+				OwnerID:   1,
+				Kilograms: nulls.NewFloat64(0),
+			},
+			wantErr:  true,
+			errField: "kilograms",
+		},
+		{
+			name: "bad location match",
+			watch: Watch{
+				UUID:          domain.GetUUID(),
+				OwnerID:       1,
+				LocationMatch: "REGION",
+			},
+			wantErr:  true,
+			errField: "location_match",
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -536,3 +610,295 @@ func (ms *ModelSuite) TestWatch_textMatches() {
 		})
 	}
 }
+
+// watchMatchCase is a watch and request combination with the expected result of matching, shared by the tests of
+// matchesRequest and of its set-based equivalent, watchMatchCondition
+type watchMatchCase struct {
+	name    string
+	watch   Watch
+	request Request
+	want    bool
+}
+
+// createWatchMatchCases creates watches covering each of the watch criteria, and the requests to match them against
+func createWatchMatchCases(ms *ModelSuite) []watchMatchCase {
+	meetings := createMeetingFixtures(ms.DB, 2).Meetings
+	requests := createRequestFixtures(ms.DB, 2, false)
+	owner := createUserFixtures(ms.DB, 1).Users[0]
+
+	creator, err := requests[0].Creator()
+	ms.NoError(err)
+
+	// request 0 is a small request with an origin and no meeting, request 1 is a large request with a meeting and no
+	// origin
+	ms.NoError(ms.DB.RawQuery("UPDATE locations SET latitude = 10, longitude = 20, country = 'KE' WHERE id = ?",
+		requests[0].DestinationID).Exec())
+	ms.NoError(ms.DB.RawQuery("UPDATE locations SET latitude = -30, longitude = 40, country = 'FR' WHERE id = ?",
+		requests[0].OriginID).Exec())
+	requests[1].Size = RequestSizeLarge
+	requests[1].MeetingID = nulls.NewInt(meetings[0].ID)
+	requests[1].OriginID = nulls.Int{}
+	requests[1].NeededBefore = nulls.Time{}
+	requests[1].Kilograms = nulls.Float64{}
+	ms.NoError(ms.DB.Update(&requests[1]))
+
+	// request 0 is needed before 2030-06-15 and weighs 2.5 kg, request 1 has no date and no weight
+	requests[0].NeededBefore = nulls.NewTime(time.Date(2030, 6, 15, 0, 0, 0, 0, time.UTC))
+	requests[0].Kilograms = nulls.NewFloat64(2.5)
+	ms.NoError(ms.DB.RawQuery("UPDATE requests SET needed_before = '2030-06-15', kilograms = 2.5 WHERE id = ?",
+		requests[0].ID).Exec())
+
+	newCountryLocation := func(country string, latitude, longitude float64) nulls.Int {
+		l := Location{Description: "watch", Country: country,
+			Latitude: nulls.NewFloat64(latitude), Longitude: nulls.NewFloat64(longitude)}
+		createFixture(ms, &l)
+		return nulls.NewInt(l.ID)
+	}
+	newLocation := func(latitude, longitude float64) nulls.Int {
+		return newCountryLocation("US", latitude, longitude)
+	}
+	noCoordinates := Location{Description: "watch", Country: "KE"}
+	createFixture(ms, &noCoordinates)
+	radius := func(km int) nulls.Int { return nulls.NewInt(km) }
+	country := WatchLocationMatchCountry
+
+	tiny, small, xlarge := RequestSizeTiny, RequestSizeSmall, RequestSizeXlarge
+	text := func(s string) nulls.String { return nulls.NewString(s) }
+	date := func(y int, m time.Month, d int) nulls.Time {
+		return nulls.NewTime(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+	}
+	kg := func(k float64) nulls.Float64 { return nulls.NewFloat64(k) }
+	today := time.Now().UTC()
+	day := func(days int) nulls.Time { return nulls.NewTime(today.AddDate(0, 0, days)) }
+
+	cases := []watchMatchCase{
+		{name: "no criteria", request: requests[0], want: true},
+		{name: "same size", request: requests[0], want: true, watch: Watch{Size: &small}},
+		{name: "larger size", request: requests[0], want: true, watch: Watch{Size: &xlarge}},
+		{name: "smaller size", request: requests[0], want: false, watch: Watch{Size: &tiny}},
+		{name: "smaller size, large request", request: requests[1], want: false, watch: Watch{Size: &small}},
+		{name: "text in title", request: requests[0], want: true, watch: Watch{SearchText: text("title")}},
+		{name: "text in title, wrong case", request: requests[0], want: false,
+			watch: Watch{SearchText: text("Title")}},
+		{name: "text in description", request: requests[0], want: true,
+			watch: Watch{SearchText: text("description 0")}},
+		{name: "text in creator nickname", request: requests[0], want: true,
+			watch: Watch{SearchText: text(creator.Nickname[1:])}},
+		{name: "text not found", request: requests[0], want: false, watch: Watch{SearchText: text("nothing")}},
+		{name: "empty text", request: requests[0], want: true, watch: Watch{SearchText: text("")}},
+		{name: "same meeting", request: requests[1], want: true,
+			watch: Watch{MeetingID: nulls.NewInt(meetings[0].ID)}},
+		{name: "other meeting", request: requests[1], want: false,
+			watch: Watch{MeetingID: nulls.NewInt(meetings[1].ID)}},
+		{name: "meeting, request without meeting", request: requests[0], want: false,
+			watch: Watch{MeetingID: nulls.NewInt(meetings[0].ID)}},
+		{name: "same destination", request: requests[0], want: true, watch: Watch{DestinationID: newLocation(10, 20)}},
+		{name: "destination inside 100 km", request: requests[0], want: true,
+			watch: Watch{DestinationID: newLocation(10.85, 20)}},
+		{name: "destination outside 100 km", request: requests[0], want: false,
+			watch: Watch{DestinationID: newLocation(10.95, 20)}},
+		{name: "destination without coordinates", request: requests[0], want: false,
+			watch: Watch{DestinationID: nulls.NewInt(noCoordinates.ID)}},
+		{name: "origin nearby", request: requests[0], want: true, watch: Watch{OriginID: newLocation(-30.5, 40.5)}},
+		{name: "origin far away", request: requests[0], want: false, watch: Watch{OriginID: newLocation(30, 40)}},
+		{name: "origin, request without origin", request: requests[1], want: false,
+			watch: Watch{OriginID: newLocation(-30, 40)}},
+		{name: "destination inside radius", request: requests[0], want: true,
+			watch: Watch{DestinationID: newLocation(10.15, 20), RadiusKm: radius(20)}},
+		{name: "destination outside radius", request: requests[0], want: false,
+			watch: Watch{DestinationID: newLocation(10.25, 20), RadiusKm: radius(20)}},
+		{name: "destination inside large radius", request: requests[0], want: true,
+			watch: Watch{DestinationID: newLocation(13, 20), RadiusKm: radius(500)}},
+		{name: "destination in same country", request: requests[0], want: true,
+			watch: Watch{DestinationID: newCountryLocation("KE", 0, 38), LocationMatch: country}},
+		{name: "destination in same country, radius ignored", request: requests[0], want: true,
+			watch: Watch{DestinationID: newCountryLocation("KE", 0, 38), LocationMatch: country, RadiusKm: radius(1)}},
+		{name: "destination in same country, no coordinates", request: requests[0], want: true,
+			watch: Watch{DestinationID: nulls.NewInt(noCoordinates.ID), LocationMatch: country}},
+		{name: "destination nearby in other country", request: requests[0], want: false,
+			watch: Watch{DestinationID: newCountryLocation("TZ", 10, 20), LocationMatch: country}},
+		{name: "destination without country", request: requests[0], want: false,
+			watch: Watch{DestinationID: newCountryLocation("", 10, 20), LocationMatch: country}},
+		{name: "origin in same country", request: requests[0], want: true,
+			watch: Watch{OriginID: newCountryLocation("FR", 45, 5), LocationMatch: country}},
+		{name: "origin in other country", request: requests[0], want: false,
+			watch: Watch{OriginID: newCountryLocation("KE", -30, 40), LocationMatch: country}},
+		{name: "origin in country, request without origin", request: requests[1], want: false,
+			watch: Watch{OriginID: newCountryLocation("FR", 45, 5), LocationMatch: country}},
+		{name: "needed after earlier date", request: requests[0], want: true,
+			watch: Watch{NeededAfter: date(2030, 6, 1)}},
+		{name: "needed after same date", request: requests[0], want: true,
+			watch: Watch{NeededAfter: date(2030, 6, 15)}},
+		{name: "needed after later date", request: requests[0], want: false,
+			watch: Watch{NeededAfter: date(2030, 6, 16)}},
+		{name: "needed before later date", request: requests[0], want: true,
+			watch: Watch{NeededBefore: date(2030, 7, 1)}},
+		{name: "needed before same date", request: requests[0], want: true,
+			watch: Watch{NeededBefore: date(2030, 6, 15)}},
+		{name: "needed before earlier date", request: requests[0], want: false,
+			watch: Watch{NeededBefore: date(2030, 6, 14)}},
+		{name: "date bounds, request without date", request: requests[1], want: true,
+			watch: Watch{NeededAfter: date(2030, 6, 1), NeededBefore: date(2030, 6, 2)}},
+		{name: "more kilograms", request: requests[0], want: true, watch: Watch{Kilograms: kg(10)}},
+		{name: "same kilograms", request: requests[0], want: true, watch: Watch{Kilograms: kg(2.5)}},
+		{name: "fewer kilograms", request: requests[0], want: false, watch: Watch{Kilograms: kg(2.4)}},
+		{name: "kilograms, request without weight", request: requests[1], want: true,
+			watch: Watch{Kilograms: kg(0.1)}},
+		{name: "expires tomorrow", request: requests[0], want: true, watch: Watch{ExpiresOn: day(1)}},
+		{name: "expires today", request: requests[0], want: true, watch: Watch{ExpiresOn: day(0)}},
+		{name: "expired yesterday", request: requests[0], want: false, watch: Watch{ExpiresOn: day(-1)}},
+		{name: "deactivated", request: requests[0], want: false, watch: Watch{DeactivatedAt: nulls.NewTime(today)}},
+		{name: "all criteria", request: requests[0], want: true, watch: Watch{Size: &small,
+			SearchText: text("title"), DestinationID: newLocation(10, 20.5), OriginID: newLocation(-30, 40)}},
+		{name: "all criteria but one", request: requests[0], want: false, watch: Watch{Size: &small,
+			SearchText: text("title"), DestinationID: newLocation(10, 20.5), OriginID: newLocation(30, 40)}},
+	}
+
+	for i := range cases {
+		cases[i].watch.UUID = domain.GetUUID()
+		cases[i].watch.OwnerID = owner.ID
+		cases[i].watch.Name = cases[i].name
+		createFixture(ms, &cases[i].watch)
+	}
+
+	return cases
+}
+
+func (ms *ModelSuite) TestWatch_matchesRequest_shared() {
+	for _, tt := range createWatchMatchCases(ms) {
+		ms.T().Run(tt.name, func(t *testing.T) {
+			ms.Equal(tt.want, tt.watch.matchesRequest(tt.request))
+		})
+	}
+}
+
+func (ms *ModelSuite) Test_watchMatchCondition() {
+	cases := createWatchMatchCases(ms)
+
+	matches := map[int]map[int]bool{}
+	for _, tt := range cases {
+		if _, ok := matches[tt.request.ID]; ok {
+			continue
+		}
+		condition, args, err := watchMatchCondition(tt.request)
+		ms.NoError(err)
+		var watches Watches
+		ms.NoError(ms.DB.RawQuery("SELECT w.* FROM watches w "+watchMatchJoins+" WHERE "+condition, args...).
+			All(&watches))
+		matches[tt.request.ID] = map[int]bool{}
+		for _, w := range watches {
+			matches[tt.request.ID][w.ID] = true
+		}
+	}
+
+	for _, tt := range cases {
+		ms.T().Run(tt.name, func(t *testing.T) {
+			ms.Equal(tt.want, matches[tt.request.ID][tt.watch.ID])
+		})
+	}
+}
+
+func (ms *ModelSuite) TestWatches_FindExpired() {
+	t := ms.T()
+	watches := createWatchFixtures(ms.DB, createUserFixtures(ms.DB, 2).Users)
+
+	now := time.Now().UTC()
+	watches[0].ExpiresOn = nulls.NewTime(now.AddDate(0, 0, -1))
+	watches[1].ExpiresOn = nulls.NewTime(now)
+	watches[2].ExpiresOn = nulls.NewTime(now.AddDate(0, 0, -2))
+	watches[2].DeactivatedAt = nulls.NewTime(now)
+	for i := range watches {
+		ms.NoError(ms.DB.Update(&watches[i]))
+	}
+
+	var expired Watches
+	ms.NoError(expired.FindExpired(now))
+	ms.Equal(1, len(expired), "incorrect number of expired watches")
+	if len(expired) != 1 {
+		return
+	}
+	ms.Equal(watches[0].ID, expired[0].ID, "wrong watch found")
+
+	ms.NoError(expired[0].Deactivate())
+	ms.NoError(expired.FindExpired(now))
+	ms.Equal(0, len(expired), "deactivated watch was found")
+
+	tests := []struct {
+		name  string
+		watch Watch
+		want  bool
+	}{
+		{name: "expired", watch: watches[0], want: false},
+		{name: "expires today", watch: watches[1], want: true},
+		{name: "deactivated", watch: watches[2], want: false},
+		{name: "no expiration", watch: watches[3], want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms.Equal(tt.want, tt.watch.IsActive(now))
+		})
+	}
+}
+
+func (ms *ModelSuite) TestRequests_FindByWatch() {
+	t := ms.T()
+	users := createUserFixtures(ms.DB, 2).Users
+	requests := createRequestFixtures(ms.DB, 4, false)
+
+	// request 2 is not open and request 3 is not visible to the watch owners
+	requests[2].Status = RequestStatusAccepted
+	ms.NoError(ms.DB.Update(&requests[2]))
+	requests[3].OrganizationID = createOrganizationFixtures(ms.DB, 1)[0].ID
+	ms.NoError(ms.DB.Update(&requests[3]))
+
+	meeting := createMeetingFixtures(ms.DB, 1).Meetings[0]
+	tiny := RequestSizeTiny
+
+	watches := Watches{
+		{OwnerID: users[1].ID, SearchText: nulls.NewString("title")},
+		{OwnerID: users[1].ID, SearchText: nulls.NewString("title 0")},
+		{OwnerID: users[0].ID, SearchText: nulls.NewString("title")},
+		{OwnerID: users[1].ID, Size: &tiny},
+		{OwnerID: users[1].ID, Kilograms: nulls.NewFloat64(0.05)},
+		{OwnerID: users[1].ID, MeetingID: nulls.NewInt(meeting.ID)},
+	}
+	for i := range watches {
+		watches[i].UUID = domain.GetUUID()
+		createFixture(ms, &watches[i])
+	}
+
+	tests := []struct {
+		name    string
+		watch   Watch
+		page    int
+		perPage int
+		want    []int
+	}{
+		{name: "all", watch: watches[0], page: 1, perPage: 10, want: []int{requests[1].ID, requests[0].ID}},
+		{name: "first page", watch: watches[0], page: 1, perPage: 1, want: []int{requests[1].ID}},
+		{name: "second page", watch: watches[0], page: 2, perPage: 1, want: []int{requests[0].ID}},
+		{name: "past the end", watch: watches[0], page: 3, perPage: 1, want: []int{}},
+		{name: "one match", watch: watches[1], page: 1, perPage: 10, want: []int{requests[0].ID}},
+		{name: "own requests", watch: watches[2], page: 1, perPage: 10, want: []int{}},
+		{name: "size too small", watch: watches[3], page: 1, perPage: 10, want: []int{}},
+		{name: "kilograms", watch: watches[4], page: 1, perPage: 10, want: []int{requests[0].ID}},
+		{name: "no meeting requests", watch: watches[5], page: 1, perPage: 10, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Requests
+			var c context.Context
+			ms.NoError(got.FindByWatch(c, tt.watch, tt.page, tt.perPage))
+			ids := make([]int, len(got))
+			for i := range got {
+				ids[i] = got[i].ID
+			}
+			ms.Equal(tt.want, ids)
+
+			// the result must be the same as checking each request individually
+			for _, request := range got {
+				ms.True(tt.watch.matchesRequest(request), "request %d does not match", request.ID)
+			}
+		})
+	}
+}
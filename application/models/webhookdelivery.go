@@ -0,0 +1,225 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/gobuffalo/nulls"
+	"github.com/gobuffalo/pop"
+	"github.com/gobuffalo/validate"
+	"github.com/gobuffalo/validate/validators"
+	"github.com/gofrs/uuid"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusQueued WebhookDeliveryStatus = "QUEUED"
+	WebhookDeliveryStatusSent   WebhookDeliveryStatus = "SENT"
+	WebhookDeliveryStatusDead   WebhookDeliveryStatus = "DEAD"
+)
+
+func (e WebhookDeliveryStatus) IsValid() bool {
+	switch e {
+	case WebhookDeliveryStatusQueued, WebhookDeliveryStatusSent, WebhookDeliveryStatusDead:
+		return true
+	}
+	return false
+}
+
+func (e WebhookDeliveryStatus) String() string {
+	return string(e)
+}
+
+func (e *WebhookDeliveryStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookDeliveryStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookDeliveryStatus", str)
+	}
+	return nil
+}
+
+func (e WebhookDeliveryStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// WebhookDelivery is an event payload waiting to be posted to an organization's webhook, or a record of its delivery
+type WebhookDelivery struct {
+	ID             int                   `json:"id" db:"id"`
+	CreatedAt      time.Time             `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at" db:"updated_at"`
+	UUID           uuid.UUID             `json:"uuid" db:"uuid"`
+	WebhookID      int                   `json:"webhook_id" db:"webhook_id"`
+	Event          WebhookEvent          `json:"event" db:"event"`
+	Payload        string                `json:"payload" db:"payload"`
+	Status         WebhookDeliveryStatus `json:"status" db:"status"`
+	Attempts       int                   `json:"attempts" db:"attempts"`
+	NextAttemptAt  time.Time             `json:"next_attempt_at" db:"next_attempt_at"`
+	ResponseStatus nulls.Int             `json:"response_status" db:"response_status"`
+	LastError      nulls.String          `json:"last_error" db:"last_error"`
+	DeliveredAt    nulls.Time            `json:"delivered_at" db:"delivered_at"`
+}
+
+// String can be helpful for serializing the model
+func (d WebhookDelivery) String() string {
+	jd, _ := json.Marshal(d)
+	return string(jd)
+}
+
+// WebhookDeliveries is used for methods that operate on lists of objects
+type WebhookDeliveries []WebhookDelivery
+
+// String can be helpful for serializing the model
+func (d WebhookDeliveries) String() string {
+	jd, _ := json.Marshal(d)
+	return string(jd)
+}
+
+// Validate gets run every time you call a "pop.Validate*" (pop.ValidateAndSave, pop.ValidateAndCreate, pop.ValidateAndUpdate) method.
+func (d *WebhookDelivery) Validate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.Validate(
+		&validators.UUIDIsPresent{Field: d.UUID, Name: "UUID"},
+		&validators.IntIsPresent{Field: d.WebhookID, Name: "WebhookID"},
+		&validators.StringIsPresent{Field: d.Payload, Name: "Payload"},
+		&webhookDeliveryEventValidator{Field: d.Event, Name: "Event"},
+		&webhookDeliveryStatusValidator{Field: d.Status, Name: "Status"},
+	), nil
+}
+
+type webhookDeliveryEventValidator struct {
+	Name    string
+	Field   WebhookEvent
+	Message string
+}
+
+func (v *webhookDeliveryEventValidator) IsValid(errors *validate.Errors) {
+	if v.Field.IsValid() {
+		return
+	}
+	v.Message = fmt.Sprintf("%s is not a valid webhook event", v.Field)
+	errors.Add(validators.GenerateKey(v.Name), v.Message)
+}
+
+type webhookDeliveryStatusValidator struct {
+	Name    string
+	Field   WebhookDeliveryStatus
+	Message string
+}
+
+func (v *webhookDeliveryStatusValidator) IsValid(errors *validate.Errors) {
+	if v.Field.IsValid() {
+		return
+	}
+	v.Message = fmt.Sprintf("%s is not a valid webhook delivery status", v.Field)
+	errors.Add(validators.GenerateKey(v.Name), v.Message)
+}
+
+// ValidateCreate gets run every time you call "pop.ValidateAndCreate" method.
+func (d *WebhookDelivery) ValidateCreate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.NewErrors(), nil
+}
+
+// ValidateUpdate gets run every time you call "pop.ValidateAndUpdate" method.
+func (d *WebhookDelivery) ValidateUpdate(tx *pop.Connection) (*validate.Errors, error) {
+	return validate.NewErrors(), nil
+}
+
+// Enqueue stores a new delivery in the QUEUED state, claimed for immediate delivery by the caller. The UUID must be
+// assigned beforehand if it is included in the payload.
+func (d *WebhookDelivery) Enqueue() error {
+	d.Status = WebhookDeliveryStatusQueued
+	d.NextAttemptAt = time.Now().Add(domain.WebhookClaimDuration)
+	return create(d)
+}
+
+// Claim reserves a queued delivery by postponing its next attempt, so that it is not picked up by another worker. It
+// returns false if the delivery was already claimed, sent or abandoned.
+func (d *WebhookDelivery) Claim() (bool, error) {
+	now := time.Now()
+	next := now.Add(domain.WebhookClaimDuration)
+	n, err := DB.RawQuery(
+		"UPDATE webhook_deliveries SET next_attempt_at = ?, updated_at = ? WHERE id = ? AND status = ? AND next_attempt_at <= ?",
+		next, now, d.ID, WebhookDeliveryStatusQueued, now).ExecWithCount()
+	if err != nil {
+		return false, err
+	}
+
+	d.NextAttemptAt = next
+	return n == 1, nil
+}
+
+// RecordSent marks the delivery as accepted by the webhook endpoint
+func (d *WebhookDelivery) RecordSent(responseStatus int) error {
+	d.Attempts++
+	d.Status = WebhookDeliveryStatusSent
+	d.ResponseStatus = nulls.NewInt(responseStatus)
+	d.DeliveredAt = nulls.NewTime(time.Now())
+	d.LastError = nulls.String{}
+	return update(d)
+}
+
+// RecordFailure stores the delivery error and schedules the next attempt with exponential backoff. After
+// domain.WebhookMaxAttempts attempts, the delivery is moved to the DEAD state and is not retried. A responseStatus of
+// zero means that no response was received.
+func (d *WebhookDelivery) RecordFailure(responseStatus int, deliveryErr error) error {
+	d.Attempts++
+	d.LastError = nulls.NewString(deliveryErr.Error())
+	d.ResponseStatus = nulls.Int{}
+	if responseStatus != 0 {
+		d.ResponseStatus = nulls.NewInt(responseStatus)
+	}
+
+	if d.Attempts >= domain.WebhookMaxAttempts {
+		d.Status = WebhookDeliveryStatusDead
+		return update(d)
+	}
+
+	d.NextAttemptAt = time.Now().Add(webhookRetryDelay(d.Attempts))
+	return update(d)
+}
+
+// webhookRetryDelay returns the delay before the next delivery attempt, doubling with each failed attempt
+func webhookRetryDelay(attempts int) time.Duration {
+	return backoffDelay(attempts, domain.WebhookRetryDelay, domain.WebhookMaxRetryDelay)
+}
+
+// GetWebhook returns the webhook to which the payload is delivered
+func (d *WebhookDelivery) GetWebhook() (OrganizationWebhook, error) {
+	var webhook OrganizationWebhook
+	if err := DB.Find(&webhook, d.WebhookID); err != nil {
+		return webhook, err
+	}
+	return webhook, nil
+}
+
+// FindDue gets the queued deliveries that are due for an attempt, oldest first. Deliveries to inactive webhooks stay
+// queued until the webhook is reactivated.
+func (d *WebhookDeliveries) FindDue(limit int) error {
+	return DB.Where("status = ? AND next_attempt_at <= ?", WebhookDeliveryStatusQueued, time.Now()).
+		Where("webhook_id IN (SELECT id FROM organization_webhooks WHERE active = true)").
+		Order("next_attempt_at asc").Limit(limit).All(d)
+}
+
+// Deliveries gets one page of the webhook's delivery log, newest first, optionally filtered by status
+func (w *OrganizationWebhook) Deliveries(status *WebhookDeliveryStatus, page, perPage int) (WebhookDeliveries, error) {
+	q := DB.Where("webhook_id = ?", w.ID)
+	if status != nil {
+		q = q.Where("status = ?", *status)
+	}
+
+	var deliveries WebhookDeliveries
+	if err := q.Order("created_at desc, id desc").Paginate(page, perPage).All(&deliveries); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
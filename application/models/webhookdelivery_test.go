@@ -0,0 +1,101 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
+func createWebhookDeliveryFixtures(ms *ModelSuite, webhooks OrganizationWebhooks) WebhookDeliveries {
+	deliveries := WebhookDeliveries{
+		{WebhookID: webhooks[0].ID, Event: WebhookEventRequestCreated},
+		{WebhookID: webhooks[0].ID, Event: WebhookEventRequestCreated},
+		{WebhookID: webhooks[1].ID, Event: WebhookEventRequestCreated},
+	}
+	for i := range deliveries {
+		deliveries[i].UUID = domain.GetUUID()
+		deliveries[i].Payload = `{"event":"REQUEST_CREATED"}`
+		ms.NoError(deliveries[i].Enqueue())
+	}
+	return deliveries
+}
+
+func (ms *ModelSuite) TestWebhookDelivery_Claim() {
+	orgs := createOrganizationFixtures(ms.DB, 2)
+	webhooks := createOrganizationWebhookFixtures(ms, orgs)
+	deliveries := createWebhookDeliveryFixtures(ms, webhooks)
+
+	claimed, err := deliveries[0].Claim()
+	ms.NoError(err)
+	ms.False(claimed, "delivery claimed while the first claim is still active")
+
+	ms.NoError(DB.RawQuery("UPDATE webhook_deliveries SET next_attempt_at = ? WHERE id = ?",
+		time.Now().Add(-time.Minute), deliveries[0].ID).Exec())
+
+	claimed, err = deliveries[0].Claim()
+	ms.NoError(err)
+	ms.True(claimed, "due delivery was not claimed")
+
+	claimed, err = deliveries[0].Claim()
+	ms.NoError(err)
+	ms.False(claimed, "delivery claimed twice")
+}
+
+func (ms *ModelSuite) TestWebhookDelivery_RecordFailure() {
+	orgs := createOrganizationFixtures(ms.DB, 2)
+	webhooks := createOrganizationWebhookFixtures(ms, orgs)
+	deliveries := createWebhookDeliveryFixtures(ms, webhooks)
+	d := deliveries[0]
+
+	ms.NoError(d.RecordFailure(500, errors.New("webhook responded with status 500")))
+	ms.Equal(1, d.Attempts, "incorrect number of attempts")
+	ms.Equal(WebhookDeliveryStatusQueued, d.Status, "delivery should be retried")
+	ms.Equal(500, d.ResponseStatus.Int, "incorrect response status")
+	ms.WithinDuration(time.Now().Add(domain.WebhookRetryDelay), d.NextAttemptAt, time.Second)
+
+	ms.NoError(d.RecordFailure(0, errors.New("connection refused")))
+	ms.False(d.ResponseStatus.Valid, "response status should be cleared when there is no response")
+	ms.WithinDuration(time.Now().Add(2*domain.WebhookRetryDelay), d.NextAttemptAt, time.Second,
+		"retry delay was not doubled")
+
+	for d.Attempts < domain.WebhookMaxAttempts {
+		ms.NoError(d.RecordFailure(0, errors.New("connection refused")))
+	}
+	ms.Equal(WebhookDeliveryStatusDead, d.Status, "delivery should be abandoned after the maximum attempts")
+
+	// deliveries[2] belongs to an inactive webhook
+	var due WebhookDeliveries
+	ms.NoError(DB.RawQuery("UPDATE webhook_deliveries SET next_attempt_at = ?", time.Now().Add(-time.Minute)).Exec())
+	ms.NoError(due.FindDue(10))
+	ms.Equal(1, len(due), "only the queued delivery to the active webhook should be due")
+	ms.Equal(deliveries[1].ID, due[0].ID, "incorrect due delivery")
+
+	ms.NoError(deliveries[1].RecordSent(204))
+	ms.True(deliveries[1].DeliveredAt.Valid, "delivery time was not recorded")
+	ms.NoError(due.FindDue(10))
+	ms.Equal(0, len(due), "sent delivery should not be due")
+}
+
+func (ms *ModelSuite) TestOrganizationWebhook_Deliveries() {
+	orgs := createOrganizationFixtures(ms.DB, 2)
+	webhooks := createOrganizationWebhookFixtures(ms, orgs)
+	deliveries := createWebhookDeliveryFixtures(ms, webhooks)
+	ms.NoError(deliveries[0].RecordSent(200))
+
+	got, err := webhooks[0].Deliveries(nil, 1, 10)
+	ms.NoError(err)
+	ms.Equal(2, len(got), "wrong number of deliveries")
+	ms.Equal(deliveries[1].ID, got[0].ID, "deliveries should be newest first")
+
+	sent := WebhookDeliveryStatusSent
+	got, err = webhooks[0].Deliveries(&sent, 1, 10)
+	ms.NoError(err)
+	ms.Equal(1, len(got), "wrong number of sent deliveries")
+	ms.Equal(deliveries[0].ID, got[0].ID)
+
+	got, err = webhooks[0].Deliveries(nil, 2, 1)
+	ms.NoError(err)
+	ms.Equal(1, len(got), "wrong page size")
+	ms.Equal(deliveries[0].ID, got[0].ID, "wrong delivery on the second page")
+}
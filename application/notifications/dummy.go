@@ -1,7 +1,6 @@
 package notifications
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
 
@@ -31,6 +30,10 @@ var dummyTemplates = map[string]dummyTemplate{
 		subject: "new request",
 		body:    "There is a new request for an item.",
 	},
+	domain.MessageTemplateDigest: {
+		subject: "new requests",
+		body:    "Here are the new requests.",
+	},
 	domain.MessageTemplateNewThreadMessage: {
 		subject: "new message",
 		body:    "You have a new message.",
@@ -91,6 +94,26 @@ var dummyTemplates = map[string]dummyTemplate{
 		subject: domain.MessageTemplatePotentialProviderSelfDestroyed,
 		body:    "An offer to fulfill your request was retracted",
 	},
+	domain.MessageTemplateWatchExpired: {
+		subject: domain.MessageTemplateWatchExpired,
+		body:    "Your watch has expired",
+	},
+	domain.MessageTemplateWatchSummary: {
+		subject: domain.MessageTemplateWatchSummary,
+		body:    "Here are the open requests that match your watch",
+	},
+	domain.MessageTemplateMeetingInvite: {
+		subject: domain.MessageTemplateMeetingInvite,
+		body:    "You are invited to an event",
+	},
+	domain.MessageTemplateMeetingOrganizerAdded: {
+		subject: domain.MessageTemplateMeetingOrganizerAdded,
+		body:    "You are an organizer of an event",
+	},
+	domain.MessageTemplateMeetingAnnouncement: {
+		subject: domain.MessageTemplateMeetingAnnouncement,
+		body:    "An organizer posted an announcement for an event",
+	},
 }
 
 func (t *DummyEmailService) Send(msg Message) error {
@@ -101,12 +124,10 @@ func (t *DummyEmailService) Send(msg Message) error {
 		return errors.New(errMsg)
 	}
 
-	eTemplate := msg.Template
-	bodyBuf := &bytes.Buffer{}
-	if err := eR.HTML(eTemplate).Render(bodyBuf, msg.Data); err != nil {
-		errMsg := "error rendering message body - " + err.Error()
-		domain.ErrLogger.Printf(errMsg)
-		return errors.New(errMsg)
+	body, _, err := renderEmailBody(msg)
+	if err != nil {
+		domain.ErrLogger.Printf(err.Error())
+		return err
 	}
 
 	domain.Logger.Printf("dummy message subject: %s, recipient: %s",
@@ -115,7 +136,7 @@ func (t *DummyEmailService) Send(msg Message) error {
 	t.sentMessages = append(t.sentMessages,
 		dummyMessage{
 			subject:   msg.Subject,
-			body:      bodyBuf.String(),
+			body:      body,
 			fromName:  msg.FromName,
 			fromEmail: msg.FromEmail,
 			toName:    msg.ToName,
@@ -1,21 +1,138 @@
 package notifications
 
 import (
+	"bytes"
+	"errors"
+	"time"
+
 	"github.com/gobuffalo/buffalo/render"
 	"github.com/gobuffalo/packr/v2"
+	"github.com/gobuffalo/plush"
 	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/models"
+	"jaytaylor.com/html2text"
 )
 
+var mailTemplates = packr.New("app:mailers:templates", "../templates/mail")
+
 var eR = render.New(render.Options{
-	HTMLLayout:   "layout.plush.html",
-	TemplatesBox: packr.New("app:mailers:templates", "../templates/mail"),
-	Helpers:      render.Helpers{},
+	HTMLLayout:      "layout.plush.html",
+	TemplatesBox:    mailTemplates,
+	TemplateEngines: mailTemplateEngines(),
+	Helpers:         templateHelpers(),
 })
 
+// emailTimeFormat is the format of times displayed in messages, in the recipient's time zone
+const emailTimeFormat = "2 Jan 2006 15:04 MST"
+
+// templateHelpers returns the helpers available to the message templates
+func templateHelpers() render.Helpers {
+	return render.Helpers{
+		"t":         translate,
+		"localTime": localTime,
+	}
+}
+
+// mailTemplateEngines returns the template engines for mail template file names, e.g. "new_request.fr.plush.html".
+// Templates are rendered once, by the plush engine. The other extensions only describe the file.
+func mailTemplateEngines() map[string]render.TemplateEngine {
+	engines := map[string]render.TemplateEngine{
+		"plush": plush.BuffaloRenderer,
+		"html":  noopTemplateEngine,
+	}
+	for _, language := range domain.AllowedLanguages {
+		engines[language] = noopTemplateEngine
+	}
+	return engines
+}
+
+func noopTemplateEngine(input string, data map[string]interface{}, helpers map[string]interface{}) (string, error) {
+	return input, nil
+}
+
+// translate is the `t` template helper. It returns the translation of the given message ID in the language of the
+// message being rendered.
+func translate(translationID string, help plush.HelperContext) (string, error) {
+	language, _ := help.Value("language").(string)
+	return domain.TranslateWithLang(language, translationID, map[string]string{"AppName": domain.Env.AppName})
+}
+
+// localTime is the `localTime` template helper. It formats the given time in the time zone of the message recipient.
+func localTime(t time.Time, help plush.HelperContext) string {
+	timeZone, _ := help.Value("timeZone").(string)
+	location := models.StandardPreferences{TimeZone: timeZone}.GetLocation()
+	return t.In(location).Format(emailTimeFormat)
+}
+
+// localizedTemplate returns the file name of the template in the given language, or of the English template if there
+// is no translation
+func localizedTemplate(template, language string) string {
+	if language != "" && language != domain.UserPreferenceLanguageEnglish {
+		name := template + "." + language + ".plush.html"
+		if mailTemplates.Has(name) {
+			return name
+		}
+	}
+	return template + ".plush.html"
+}
+
+// messageLanguage returns the language of the message, defaulting to English
+func messageLanguage(msg Message) string {
+	if msg.Language == "" {
+		return domain.UserPreferenceLanguageEnglish
+	}
+	return msg.Language
+}
+
 type EmailService interface {
 	Send(msg Message) error
 }
 
+// renderEmailBody renders the message template as HTML, in the message language, and converts the HTML to plain text for use in a multipart
+// message. If the conversion fails, the HTML is used as the text body. If the message was already rendered, the
+// rendered body is returned.
+func renderEmailBody(msg Message) (htmlBody, textBody string, err error) {
+	if msg.HTMLBody != "" {
+		return msg.HTMLBody, msg.TextBody, nil
+	}
+
+	if msg.Data == nil {
+		msg.Data = map[string]interface{}{}
+	}
+	msg.Data["uiURL"] = domain.Env.UIURL
+	msg.Data["appName"] = domain.Env.AppName
+	msg.Data["language"] = messageLanguage(msg)
+	msg.Data["timeZone"] = msg.TimeZone
+	msg.Data["unsubscribeURL"] = msg.UnsubscribeURL
+
+	bodyBuf := &bytes.Buffer{}
+	if err := eR.HTML(localizedTemplate(msg.Template, msg.Language)).Render(bodyBuf, msg.Data); err != nil {
+		return "", "", errors.New("error rendering message body - " + err.Error())
+	}
+	htmlBody = bodyBuf.String()
+
+	textBody, err = html2text.FromString(htmlBody)
+	if err != nil {
+		domain.Logger.Printf("error converting html email to plain text ... %s", err.Error())
+		textBody = htmlBody
+	}
+
+	return htmlBody, textBody, nil
+}
+
+// listUnsubscribeHeaders returns the email headers that allow mail clients to offer an unsubscribe button, including
+// one-click unsubscribe as described in RFC 8058. If the message has no unsubscribe URL, nil is returned.
+func listUnsubscribeHeaders(msg Message) map[string]string {
+	if msg.UnsubscribeURL == "" {
+		return nil
+	}
+
+	return map[string]string{
+		"List-Unsubscribe":      "<" + msg.UnsubscribeURL + ">",
+		"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+	}
+}
+
 // GetEmailTemplate returns the filename of the email template corresponding to a particular status change.
 //  Most of those will just be the same as the name of the status change.
 func GetEmailTemplate(key string) string {
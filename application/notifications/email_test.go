@@ -3,6 +3,7 @@ package notifications
 import (
 	"testing"
 	"text/template"
+	"time"
 
 	"github.com/silinternational/wecarry-api/domain"
 	"github.com/stretchr/testify/assert"
@@ -23,6 +24,7 @@ func TestSend(t *testing.T) {
 			"requestTitle":   "My Request<script>doBadThings()</script>",
 			"messageContent": "I can bring it<script>doBadThings()</script>",
 			"sentByNickname": "Fred<script>doBadThings()</script>",
+			"sentAt":         time.Now(),
 			"threadURL":      "ourthread.example.com",
 		},
 	}
@@ -44,3 +46,120 @@ func TestSend(t *testing.T) {
 	assert.Contains(t, body, template.HTMLEscapeString(msg.Data["messageContent"].(string)))
 	assert.NotContains(t, body, "<script>")
 }
+
+func TestRenderEmailBody_Language(t *testing.T) {
+	data := map[string]interface{}{
+		"requestURL":         "https://example.com/requests/1",
+		"requestTitle":       "Coffee",
+		"requestDestination": "Paris",
+		"requestDescription": "A bag of coffee",
+		"receiverNickname":   "Fred",
+		"sentByNickname":     "Fred",
+		"messageContent":     "Hello",
+		"sentAt":             time.Now(),
+		"threadURL":          "https://example.com/messages/1",
+	}
+
+	tests := []struct {
+		name         string
+		template     string
+		language     string
+		wantBody     string
+		wantFooter   string
+		wantLanguage string
+	}{
+		{
+			name:         "english",
+			template:     domain.MessageTemplateNewRequest,
+			wantBody:     "There is a new request",
+			wantFooter:   "Please do not reply",
+			wantLanguage: `lang="en"`,
+		},
+		{
+			name:         "french",
+			template:     domain.MessageTemplateNewRequest,
+			language:     domain.UserPreferenceLanguageFrench,
+			wantBody:     "Il y a une nouvelle demande",
+			wantFooter:   "Merci de ne pas y répondre",
+			wantLanguage: `lang="fr"`,
+		},
+		{
+			name:         "korean",
+			template:     domain.MessageTemplateNewThreadMessage,
+			language:     domain.UserPreferenceLanguageKorean,
+			wantBody:     "Fred님이 새 메시지를 보냈습니다",
+			wantFooter:   "회신하지 마십시오",
+			wantLanguage: `lang="ko"`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			body, _, err := renderEmailBody(Message{Template: test.template, Language: test.language, Data: data})
+			assert.NoError(t, err)
+			assert.Contains(t, body, test.wantBody)
+			assert.Contains(t, body, test.wantFooter)
+			assert.Contains(t, body, test.wantLanguage)
+		})
+	}
+}
+
+func TestLocalizedTemplate(t *testing.T) {
+	assert.Equal(t, "new_request.fr.plush.html",
+		localizedTemplate(domain.MessageTemplateNewRequest, domain.UserPreferenceLanguageFrench))
+	assert.Equal(t, "new_request.plush.html",
+		localizedTemplate(domain.MessageTemplateNewRequest, domain.UserPreferenceLanguageEnglish))
+	assert.Equal(t, "new_request.plush.html", localizedTemplate(domain.MessageTemplateNewRequest, ""))
+	assert.Equal(t, "not_a_template.plush.html",
+		localizedTemplate("not_a_template", domain.UserPreferenceLanguageFrench), "no fallback to English")
+}
+
+func TestRenderEmailBody_Unsubscribe(t *testing.T) {
+	data := map[string]interface{}{
+		"firstName":    "Fred",
+		"userEmail":    "fred@example.com",
+		"supportEmail": "support@example.com",
+	}
+
+	body, _, err := renderEmailBody(Message{Template: domain.MessageTemplateNewUserWelcome, Data: data})
+	assert.NoError(t, err)
+	assert.NotContains(t, body, "Unsubscribe from these notifications")
+
+	msg := Message{
+		Template:       domain.MessageTemplateNewUserWelcome,
+		Data:           data,
+		UnsubscribeURL: "https://api.example.com/unsubscribe/token",
+	}
+	body, _, err = renderEmailBody(msg)
+	assert.NoError(t, err)
+	assert.Contains(t, body, `<a href="https://api.example.com/unsubscribe/token">Unsubscribe from these notifications</a>`)
+}
+
+func TestRenderEmailBody_TimeZone(t *testing.T) {
+	sentAt := time.Date(2020, 4, 20, 23, 30, 0, 0, time.UTC)
+	data := map[string]interface{}{
+		"requestURL":     "https://example.com/requests/1",
+		"requestTitle":   "Coffee",
+		"sentByNickname": "Fred",
+		"messageContent": "Hello",
+		"sentAt":         sentAt,
+		"threadURL":      "https://example.com/messages/1",
+	}
+
+	tests := []struct {
+		name     string
+		timeZone string
+		want     string
+	}{
+		{name: "no preference", want: "20 Apr 2020 23:30 UTC"},
+		{name: "invalid preference", timeZone: "Nowhere/Special", want: "20 Apr 2020 23:30 UTC"},
+		{name: "time zone", timeZone: "Asia/Seoul", want: "21 Apr 2020 08:30 KST"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msg := Message{Template: domain.MessageTemplateNewThreadMessage, TimeZone: test.timeZone, Data: data}
+			body, _, err := renderEmailBody(msg)
+			assert.NoError(t, err)
+			assert.Contains(t, body, test.want)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package notifications
+
+import (
+	"github.com/gobuffalo/nulls"
+
+	"github.com/silinternational/wecarry-api/models"
+)
+
+// recordNotification stores the message as an in-app notification for the recipient
+func recordNotification(msg Message) error {
+	n := models.Notification{
+		UserID: msg.ToUserID,
+		Type:   msg.Template,
+		Title:  msg.Subject,
+	}
+	if msg.RequestID != 0 {
+		n.RequestID = nulls.NewInt(msg.RequestID)
+	}
+	if msg.ThreadID != 0 {
+		n.ThreadID = nulls.NewInt(msg.ThreadID)
+	}
+	if msg.MeetingID != 0 {
+		n.MeetingID = nulls.NewInt(msg.MeetingID)
+	}
+
+	return n.Create()
+}
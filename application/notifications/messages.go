@@ -1,5 +1,11 @@
 package notifications
 
+import (
+	"time"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
 type Message struct {
 	Template  string
 	Data      map[string]interface{}
@@ -10,4 +16,85 @@ type Message struct {
 	ToEmail   string
 	ToPhone   string
 	Subject   string
+
+	// ToUserID identifies the recipient, whose notification preferences determine the delivery channel. If zero, the
+	// message is sent by email.
+	ToUserID int
+
+	// Language is the recipient's preferred language, used to select a localized template. If empty, or if there is
+	// no template for the language, the English template is used.
+	Language string
+
+	// TimeZone is the recipient's preferred time zone, used to display times in the message. If empty, it is taken
+	// from the recipient's preferences when the message is sent, and UTC is used if there is no preference.
+	TimeZone string
+
+	// HTMLBody and TextBody are the rendered email body. If empty, the email body is rendered from the template.
+	HTMLBody string
+	TextBody string
+
+	// IdempotencyKey identifies a unique email. A second email with the same key is not sent. If empty, the email is
+	// always sent.
+	IdempotencyKey string
+
+	// UnsubscribeURL allows the recipient to opt out of this type of email without logging in. If empty, it is
+	// created by the EmailNotifier for messages with a notification preference.
+	UnsubscribeURL string
+
+	// NotBefore, if set, is the time before which the message is not delivered. It is set for messages held during
+	// the recipient's quiet hours, which are stored in the outbox until then.
+	NotBefore time.Time
+
+	// Channel, if set, is the notification channel for the message, regardless of the recipient's preferences
+	Channel string
+
+	// PreferenceKey is the notification preference that applies to this message. If empty, it is determined by the
+	// message template.
+	PreferenceKey string
+
+	// RequestID, ThreadID and MeetingID identify the objects related to the message, if any. They are recorded with
+	// the in-app notification.
+	RequestID int
+	ThreadID  int
+	MeetingID int
+}
+
+// templateSubjects maps message templates to the message ID of their email subject
+var templateSubjects = map[string]string{
+	domain.MessageTemplateNewRequest:                      "Email.Subject.NewRequest",
+	domain.MessageTemplateDigest:                          "Email.Subject.Digest",
+	domain.MessageTemplateNewThreadMessage:                "Email.Subject.Message.Created",
+	domain.MessageTemplateNewUserWelcome:                  "Email.Subject.Welcome",
+	domain.MessageTemplateRequestFromAcceptedToCompleted:  "Email.Subject.Request.FromAcceptedOrDeliveredToCompleted",
+	domain.MessageTemplateRequestFromAcceptedToDelivered:  "Email.Subject.Request.FromAcceptedToDelivered",
+	domain.MessageTemplateRequestFromAcceptedToOpen:       "Email.Subject.Request.FromAcceptedToOpen",
+	domain.MessageTemplateRequestFromAcceptedToReceived:   "Email.Subject.Request.FromAcceptedOrDeliveredToCompleted",
+	domain.MessageTemplateRequestFromAcceptedToRemoved:    "Email.Subject.Request.FromAcceptedToRemoved",
+	domain.MessageTemplateRequestFromCompletedToAccepted:  "Email.Subject.Request.FromCompletedToAcceptedOrDelivered",
+	domain.MessageTemplateRequestFromCompletedToDelivered: "Email.Subject.Request.FromCompletedToAcceptedOrDelivered",
+	domain.MessageTemplateRequestFromDeliveredToAccepted:  "Email.Subject.Request.FromDeliveredToAccepted",
+	domain.MessageTemplateRequestFromDeliveredToCompleted: "Email.Subject.Request.FromAcceptedOrDeliveredToCompleted",
+	domain.MessageTemplateRequestFromOpenToAccepted:       "Email.Subject.Request.FromOpenToAccepted",
+	domain.MessageTemplateRequestDelivered:                "Email.Subject.Request.FromAcceptedToDelivered",
+	domain.MessageTemplateRequestReceived:                 "Email.Subject.Request.FromAcceptedOrDeliveredToCompleted",
+	domain.MessageTemplateRequestNotReceivedAfterAll:      "Email.Subject.Request.FromCompletedToAcceptedOrDelivered",
+	domain.MessageTemplatePotentialProviderCreated:        "Email.Subject.Request.NewOffer",
+	domain.MessageTemplatePotentialProviderRejected:       "Email.Subject.Request.OfferRejected",
+	domain.MessageTemplatePotentialProviderSelfDestroyed:  "Email.Subject.Request.OfferRetracted",
+	domain.MessageTemplateWatchExpired:                    "Email.Subject.WatchExpired",
+	domain.MessageTemplateWatchSummary:                    "Email.Subject.WatchSummary",
+	domain.MessageTemplateMeetingInvite:                   "Email.Subject.MeetingInvite",
+	domain.MessageTemplateMeetingOrganizerAdded:           "Email.Subject.MeetingOrganizerAdded",
+	domain.MessageTemplateMeetingAnnouncement:             "Email.Subject.MeetingAnnouncement",
+}
+
+// TranslateSubject returns the email subject of the message template in the given language. The data fills in the
+// placeholders of the subject text.
+func TranslateSubject(language, template string, data map[string]string) string {
+	subjectID, ok := templateSubjects[template]
+	if !ok {
+		domain.ErrLogger.Printf("no email subject for message template '%s'", template)
+		return ""
+	}
+	return domain.GetTranslatedSubject(language, subjectID, data)
 }
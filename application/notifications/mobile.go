@@ -1,7 +1,10 @@
 package notifications
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"text/template"
 
 	"github.com/silinternational/wecarry-api/domain"
 )
@@ -10,23 +13,127 @@ type MobileService interface {
 	Send(msg Message) error
 }
 
+// smsTemplates are the short text versions of the message templates that may be sent by text message
+var smsTemplates = map[string]*template.Template{
+	domain.MessageTemplatePhoneCode: newSMSTemplate(domain.MessageTemplatePhoneCode,
+		`Your {{.appName}} verification code is {{.code}}`),
+	domain.MessageTemplateNewThreadMessage: newSMSTemplate(domain.MessageTemplateNewThreadMessage,
+		`{{.appName}}: {{.sentByNickname}} sent you a message about "{{.requestTitle}}". {{.threadURL}}`),
+	domain.MessageTemplateRequestFromOpenToAccepted: newSMSTemplate(domain.MessageTemplateRequestFromOpenToAccepted,
+		`{{.appName}}: {{.receiverNickname}} accepted your offer to carry "{{.requestTitle}}". {{.requestURL}}`),
+	domain.MessageTemplateRequestDelivered: newSMSTemplate(domain.MessageTemplateRequestDelivered,
+		`{{.appName}}: {{.providerNickname}} says "{{.requestTitle}}" was delivered. Please confirm you received it. {{.requestURL}}`),
+}
+
+// smsTranslations are the translation IDs of the text messages that are sent in the recipient's language
+var smsTranslations = map[string]string{
+	domain.MessageTemplateMeetingAnnouncement: "SMS.MeetingAnnouncement",
+}
+
+func newSMSTemplate(name, text string) *template.Template {
+	return template.Must(template.New(name).Option("missingkey=zero").Parse(text))
+}
+
+// hasSMSTemplate returns true if there is a text message version of the given template
+func hasSMSTemplate(name string) bool {
+	if _, ok := smsTranslations[name]; ok {
+		return true
+	}
+	_, ok := smsTemplates[name]
+	return ok
+}
+
+// getMobileService returns the text message service selected by domain.Env.MobileService
+func getMobileService() (MobileService, error) {
+	switch domain.Env.MobileService {
+	case MobileServiceTwilio:
+		return &TwilioService{}, nil
+	case MobileServiceDummy:
+		return &TestMobileService, nil
+	}
+	return nil, fmt.Errorf("unknown mobile service '%s'", domain.Env.MobileService)
+}
+
+// renderSMS renders the text message version of a message. A message that was already rendered, e.g. one held in the
+// outbox during the recipient's quiet hours, is returned as is.
+func renderSMS(msg Message) (string, error) {
+	if msg.TextBody != "" {
+		return msg.TextBody, nil
+	}
+
+	data := map[string]interface{}{
+		"appName": domain.Env.AppName,
+	}
+	for k, v := range msg.Data {
+		data[k] = v
+	}
+
+	if translationID, ok := smsTranslations[msg.Template]; ok {
+		body, err := domain.TranslateWithLang(messageLanguage(msg), translationID, data)
+		if err != nil {
+			return "", errors.New("error translating text message - " + err.Error())
+		}
+		return body, nil
+	}
+
+	t, ok := smsTemplates[msg.Template]
+	if !ok {
+		return "", fmt.Errorf("no text message template for %s", msg.Template)
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", errors.New("error rendering text message - " + err.Error())
+	}
+
+	return buf.String(), nil
+}
+
 type DummyMobileService struct {
-	numberSent int
+	sentMessages []dummySMS
 }
 
-type msgTemplate struct {
-	subject, body string
+var TestMobileService DummyMobileService
+
+type dummySMS struct {
+	toPhone, body string
 }
 
-var templateData = map[string]msgTemplate{
-	domain.MessageTemplateNewThreadMessage: {
-		subject: "new message", body: "You have a new message."},
-	domain.MessageTemplateNewRequest: {
-		subject: "new request", body: "There is a new request for an item from your location."},
+// DummySMSInfo describes a text message sent by the DummyMobileService
+type DummySMSInfo struct {
+	ToPhone, Body string
 }
 
 func (t *DummyMobileService) Send(msg Message) error {
-	fmt.Printf("new message sent: %s", templateData[msg.Template].subject)
-	t.numberSent++
+	body, err := renderSMS(msg)
+	if err != nil {
+		return err
+	}
+
+	domain.Logger.Printf("dummy text message to %s: %s", msg.ToPhone, body)
+
+	t.sentMessages = append(t.sentMessages, dummySMS{toPhone: msg.ToPhone, body: body})
 	return nil
 }
+
+// GetNumberOfMessagesSent returns the number of messages sent since initialization or the last call to
+// DeleteSentMessages
+func (t *DummyMobileService) GetNumberOfMessagesSent() int {
+	return len(t.sentMessages)
+}
+
+// DeleteSentMessages erases the store of sent messages
+func (t *DummyMobileService) DeleteSentMessages() {
+	t.sentMessages = []dummySMS{}
+}
+
+func (t *DummyMobileService) GetSentMessages() []DummySMSInfo {
+	messages := make([]DummySMSInfo, len(t.sentMessages))
+	for i, m := range t.sentMessages {
+		messages[i] = DummySMSInfo{
+			ToPhone: m.toPhone,
+			Body:    m.body,
+		}
+	}
+	return messages
+}
@@ -1,21 +1,160 @@
 package notifications
 
-import "github.com/silinternational/wecarry-api/domain"
+import (
+	"time"
 
-var notifiers []Notifier
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/models"
+)
+
+// notifiers holds the Notifier for each notification channel
+var notifiers = map[string]Notifier{}
+
+// templatePreferenceKeys maps message templates to the notification preference that applies to them
+var templatePreferenceKeys = map[string]string{
+	domain.MessageTemplateNewRequest:                     domain.UserPreferenceKeyNotifyNewRequest,
+	domain.MessageTemplateNewThreadMessage:               domain.UserPreferenceKeyNotifyNewMessage,
+	domain.MessageTemplatePotentialProviderCreated:       domain.UserPreferenceKeyNotifyOfferReceived,
+	domain.MessageTemplatePotentialProviderRejected:      domain.UserPreferenceKeyNotifyOfferRejected,
+	domain.MessageTemplatePotentialProviderSelfDestroyed: domain.UserPreferenceKeyNotifyOfferRetracted,
+	domain.MessageTemplateRequestFromOpenToAccepted:      domain.UserPreferenceKeyNotifyRequestAccepted,
+	domain.MessageTemplateRequestDelivered:               domain.UserPreferenceKeyNotifyRequestDelivered,
+	domain.MessageTemplateRequestReceived:                domain.UserPreferenceKeyNotifyRequestReceived,
+	domain.MessageTemplateRequestFromDeliveredToAccepted: domain.UserPreferenceKeyNotifyRequestNotDelivered,
+	domain.MessageTemplateRequestNotReceivedAfterAll:     domain.UserPreferenceKeyNotifyRequestNotReceived,
+	domain.MessageTemplateRequestFromAcceptedToOpen:      domain.UserPreferenceKeyNotifyRequestReopened,
+	domain.MessageTemplateRequestFromAcceptedToRemoved:   domain.UserPreferenceKeyNotifyRequestRemoved,
+	domain.MessageTemplateMeetingAnnouncement:            domain.UserPreferenceKeyNotifyMeetingAnnouncement,
+}
 
 func init() {
-	email := EmailNotifier{} // The type of sender is determined by domain.Env.EmailService
-	notifiers = append(notifiers, &email)
+	notifiers[domain.NotificationChannelEmail] = &EmailNotifier{} // The type of sender is determined by domain.Env.EmailService
+	notifiers[domain.NotificationChannelMobile] = &MobileNotifier{}
+	notifiers[domain.NotificationChannelPush] = &PushNotifier{}
 }
 
+// Send delivers a message through the channel selected by the recipient's notification preferences, and records it as
+// an in-app notification. If the recipient has opted out of this type of notification, nothing is sent. Non-urgent
+// messages are held until the end of the recipient's quiet hours.
 func Send(msg Message) error {
-	for _, n := range notifiers {
-		if err := n.Send(msg); err != nil {
-			return err
+	channel := getChannel(msg)
+
+	if channel != domain.NotificationChannelNone && msg.ToUserID != 0 {
+		if err := recordNotification(msg); err != nil {
+			domain.ErrLogger.Printf("error recording in-app notification for %s message, %s", msg.Template, err)
 		}
+	}
+
+	return dispatch(msg, channel)
+}
+
+// dispatch sends a message through the given channel. Non-urgent messages sent during the recipient's quiet hours are
+// rendered now and stored in the outbox, to be delivered by DeliverOutbox when the quiet hours end.
+func dispatch(msg Message, channel string) error {
+	n, ok := notifiers[channel]
+	if !ok {
+		domain.Logger.Printf("%s message not sent, notification channel is '%s'", msg.Template, channel)
+		return nil
+	}
+
+	prefs := getRecipientPreferences(msg)
+	if msg.TimeZone == "" {
+		msg.TimeZone = prefs.TimeZone
+	}
+
+	now := time.Now()
+	if delay := prefs.GetQuietHoursDelay(now); delay > 0 && isDeferrable(msg) {
+		msg.NotBefore = now.Add(delay)
+	}
+
+	if err := n.Send(msg); err != nil {
+		return err
+	}
+
+	if msg.NotBefore.IsZero() {
 		domain.Logger.Printf("%T: %s message sent", n, msg.Template)
+	} else {
+		domain.Logger.Printf("%T: %s message to user %d deferred until %s for quiet hours", n, msg.Template,
+			msg.ToUserID, msg.NotBefore.Format(time.RFC3339))
 	}
 
 	return nil
 }
+
+// isDeferrable returns true if a message is not urgent, so it can be held during the recipient's quiet hours. These
+// are the notifications that the recipient can turn off, including digests. Messages that the recipient is waiting
+// for, e.g. the welcome message, are sent right away.
+func isDeferrable(msg Message) bool {
+	return msg.ToUserID != 0 && (getPreferenceKey(msg) != "" || msg.Template == domain.MessageTemplateDigest)
+}
+
+// getRecipientPreferences returns the standard preferences of the message recipient. If the recipient is not a user
+// or the preferences cannot be loaded, empty preferences are returned.
+func getRecipientPreferences(msg Message) models.StandardPreferences {
+	if msg.ToUserID == 0 {
+		return models.StandardPreferences{}
+	}
+
+	user := models.User{ID: msg.ToUserID}
+	prefs, err := user.GetPreferences()
+	if err != nil {
+		domain.ErrLogger.Printf("error getting message recipient's preferences, %s", err)
+		return models.StandardPreferences{}
+	}
+
+	return prefs
+}
+
+// getChannel determines the notification channel for a message from the recipient's preferences
+func getChannel(msg Message) string {
+	if msg.Channel != "" {
+		return msg.Channel
+	}
+
+	key := getPreferenceKey(msg)
+	if key == "" || msg.ToUserID == 0 {
+		return domain.NotificationChannelEmail
+	}
+
+	var user models.User
+	if err := user.FindByID(msg.ToUserID); err != nil {
+		domain.ErrLogger.Printf("error finding message recipient, %s", err)
+		return domain.NotificationChannelEmail
+	}
+
+	return user.GetNotificationChannel(key)
+}
+
+// getPreferenceKey returns the notification preference that applies to a message, if any
+func getPreferenceKey(msg Message) string {
+	if msg.PreferenceKey != "" {
+		return msg.PreferenceKey
+	}
+	return templatePreferenceKeys[msg.Template]
+}
+
+// getUnsubscribeURL creates a URL for the recipient to opt out of the type of notification in the message. If the
+// message is not a notification that can be turned off, an empty string is returned.
+func getUnsubscribeURL(msg Message) string {
+	category := getPreferenceKey(msg)
+	if msg.Template == domain.MessageTemplateDigest {
+		category = domain.UnsubscribeCategoryDigest
+	}
+	if category == "" || msg.ToUserID == 0 {
+		return ""
+	}
+
+	var user models.User
+	if err := user.FindByID(msg.ToUserID); err != nil {
+		domain.ErrLogger.Printf("error finding message recipient, %s", err)
+		return ""
+	}
+
+	token, err := user.NewUnsubscribeToken(category)
+	if err != nil {
+		domain.ErrLogger.Printf("error creating unsubscribe token, %s", err)
+		return ""
+	}
+
+	return domain.GetUnsubscribeURL(token)
+}
@@ -0,0 +1,109 @@
+package notifications
+
+import (
+	"testing"
+
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetChannel(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  Message
+		want string
+	}{
+		{
+			name: "no recipient user",
+			msg: Message{
+				Template: domain.MessageTemplateNewRequest,
+				ToEmail:  "to@example.com",
+			},
+			want: domain.NotificationChannelEmail,
+		},
+		{
+			name: "template without a preference",
+			msg: Message{
+				Template: domain.MessageTemplateNewUserWelcome,
+				ToEmail:  "to@example.com",
+				ToUserID: 1,
+			},
+			want: domain.NotificationChannelEmail,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, getChannel(test.msg))
+		})
+	}
+}
+
+func TestMobileNotifier_Send(t *testing.T) {
+	TestMobileService.DeleteSentMessages()
+
+	msg := Message{
+		Template: domain.MessageTemplateRequestFromOpenToAccepted,
+		ToPhone:  "+15555550100",
+		Data: map[string]interface{}{
+			"receiverNickname": "Fred",
+			"requestTitle":     "Coffee",
+			"requestURL":       "https://example.com/requests/1",
+		},
+	}
+
+	var m MobileNotifier
+	assert.NoError(t, m.Send(msg))
+
+	sent := TestMobileService.GetSentMessages()
+	assert.Equal(t, 1, len(sent), "wrong number of text messages sent")
+	assert.Equal(t, msg.ToPhone, sent[0].ToPhone, "wrong phone number")
+	assert.Contains(t, sent[0].Body, "Fred accepted your offer to carry \"Coffee\"")
+}
+
+func TestIsDeferrable(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  Message
+		want bool
+	}{
+		{
+			name: "no recipient user",
+			msg:  Message{Template: domain.MessageTemplateNewThreadMessage},
+			want: false,
+		},
+		{
+			name: "notification with a preference",
+			msg:  Message{Template: domain.MessageTemplateNewThreadMessage, ToUserID: 1},
+			want: true,
+		},
+		{
+			name: "digest",
+			msg:  Message{Template: domain.MessageTemplateDigest, ToUserID: 1},
+			want: true,
+		},
+		{
+			name: "welcome message",
+			msg:  Message{Template: domain.MessageTemplateNewUserWelcome, ToUserID: 1},
+			want: false,
+		},
+		{
+			name: "watch expired",
+			msg:  Message{Template: domain.MessageTemplateWatchExpired, ToUserID: 1},
+			want: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, isDeferrable(test.msg))
+		})
+	}
+}
+
+func TestTranslateSubject(t *testing.T) {
+	subject := TranslateSubject(domain.UserPreferenceLanguageFrench, domain.MessageTemplateMeetingAnnouncement,
+		map[string]string{"meetingName": "Conference"})
+	assert.Equal(t, `Nouvelle annonce pour l'événement "Conference" sur `+domain.Env.AppName, subject)
+
+	assert.Equal(t, "", TranslateSubject(domain.UserPreferenceLanguageEnglish, domain.MessageTemplatePhoneCode,
+		map[string]string{}), "a template without an email version should not have a subject")
+}
@@ -1,18 +1,50 @@
 package notifications
 
 import (
+	"fmt"
+
+	"github.com/gobuffalo/nulls"
+
 	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/models"
 )
 
 const (
 	EmailServiceSendGrid = "sendgrid"
 	EmailServiceSES      = "ses"
+	EmailServiceSMTP     = "smtp"
 	EmailServiceDummy    = "dummy"
 	MobileServiceTwilio  = "twilio"
 	MobileServiceDummy   = "dummy"
 )
 
-// Notifier is an abstraction layer for multiple types of notifications: email, mobile, and push (TBD).
+// CheckConfig returns an error if the configured email or mobile service is unknown, so that a misconfigured app fails
+// at startup rather than dropping messages.
+func CheckConfig() error {
+	switch domain.Env.EmailService {
+	case EmailServiceSendGrid, EmailServiceSES, EmailServiceSMTP, EmailServiceDummy:
+	default:
+		return fmt.Errorf("unknown EMAIL_SERVICE '%s'", domain.Env.EmailService)
+	}
+
+	if domain.Env.EmailService == EmailServiceSMTP {
+		switch domain.Env.SMTPSecurity {
+		case SMTPSecurityStartTLS, SMTPSecurityTLS, SMTPSecurityNone:
+		default:
+			return fmt.Errorf("unknown SMTP_SECURITY '%s'", domain.Env.SMTPSecurity)
+		}
+	}
+
+	switch domain.Env.MobileService {
+	case MobileServiceTwilio, MobileServiceDummy:
+	default:
+		return fmt.Errorf("unknown MOBILE_SERVICE '%s'", domain.Env.MobileService)
+	}
+
+	return nil
+}
+
+// Notifier is an abstraction layer for multiple types of notifications: email, mobile, and push.
 type Notifier interface {
 	Send(msg Message) error
 }
@@ -21,57 +53,116 @@ type Notifier interface {
 type EmailNotifier struct {
 }
 
-// Send a notification using an email notifier.
+// Send a notification using an email notifier. The email is rendered and stored in the outbox, and then delivered. If
+// delivery fails, it is retried later by DeliverOutbox. No email is sent to an address that bounced or complained. An
+// email held for the recipient's quiet hours is left in the outbox until msg.NotBefore.
 func (e *EmailNotifier) Send(msg Message) error {
-	var emailService EmailService
-
-	emailServiceType := domain.Env.EmailService
-	switch emailServiceType {
-	case EmailServiceSendGrid:
-		emailService = &SendGridService{}
-	case EmailServiceSES:
-		emailService = &SES{}
-	case EmailServiceDummy:
-		emailService = &TestEmailService
-	default:
-		emailService = &TestEmailService
+	suppressed, err := models.IsEmailSuppressed(msg.ToEmail)
+	if err != nil {
+		return err
+	}
+	if suppressed {
+		domain.Logger.Printf("%s email not sent, recipient address is suppressed", msg.Template)
+		return nil
 	}
 
-	emailMessage := Message{
-		FromName:  msg.FromName,
-		FromEmail: msg.FromEmail,
-		ToName:    msg.ToName,
-		ToEmail:   msg.ToEmail,
-		Template:  msg.Template,
-		Data:      msg.Data,
-		Subject:   msg.Subject,
+	if msg.UnsubscribeURL == "" {
+		msg.UnsubscribeURL = getUnsubscribeURL(msg)
+	}
+
+	htmlBody, textBody, err := renderEmailBody(msg)
+	if err != nil {
+		return err
 	}
 
-	return emailService.Send(emailMessage)
+	o := models.OutboxMessage{
+		IdempotencyKey: msg.IdempotencyKey,
+		Template:       msg.Template,
+		Subject:        msg.Subject,
+		FromName:       msg.FromName,
+		FromEmail:      msg.FromEmail,
+		ToName:         msg.ToName,
+		ToEmail:        msg.ToEmail,
+		HTMLBody:       htmlBody,
+		TextBody:       textBody,
+	}
+	if msg.ToUserID != 0 {
+		o.UserID = nulls.NewInt(msg.ToUserID)
+	}
+	if msg.UnsubscribeURL != "" {
+		o.UnsubscribeURL = nulls.NewString(msg.UnsubscribeURL)
+	}
+	if !msg.NotBefore.IsZero() {
+		o.NotBefore = nulls.NewTime(msg.NotBefore)
+	}
+
+	created, err := o.Enqueue()
+	if err != nil {
+		return fmt.Errorf("error adding %s email to the outbox, %s", msg.Template, err)
+	}
+	if !created {
+		domain.Logger.Printf("%s email with key %s was already sent", msg.Template, msg.IdempotencyKey)
+		return nil
+	}
+	if o.NotBefore.Valid {
+		return nil
+	}
+
+	if err := deliverOutboxMessage(&o); err != nil {
+		domain.ErrLogger.Printf("%s email not delivered, will retry, %s", msg.Template, err)
+	}
+
+	return nil
 }
 
-// MobileNotifier is an email notifier that conforms to the Notifier interface.
+// MobileNotifier is a mobile text message notifier that conforms to the Notifier interface.
 type MobileNotifier struct {
 }
 
-// Send a notification using a mobile notifier.
+// Send a notification using a mobile notifier. If the message has no recipient phone number, the recipient user's
+// verified phone number is used. If there is no phone number, or no text version of the message template, the message
+// is sent by email instead. A text message held for the recipient's quiet hours is rendered and stored in the outbox.
 func (m *MobileNotifier) Send(msg Message) error {
-	var mobileService MobileService
+	mobileService, err := getMobileService()
+	if err != nil {
+		return err
+	}
 
-	mobileServiceType := domain.Env.MobileService
-	switch mobileServiceType {
-	case MobileServiceDummy:
-		mobileService = &DummyMobileService{}
-	default:
-		mobileService = &DummyMobileService{}
+	if !hasSMSTemplate(msg.Template) {
+		domain.Logger.Printf("no text message template for %s, sending by email", msg.Template)
+		return notifiers[domain.NotificationChannelEmail].Send(msg)
+	}
+
+	toPhone := msg.ToPhone
+	if toPhone == "" && msg.ToUserID != 0 {
+		var user models.User
+		if err := user.FindByID(msg.ToUserID); err != nil {
+			return fmt.Errorf("error finding text message recipient, %s", err)
+		}
+		toPhone = user.PhoneNumber.String
+	}
+
+	if toPhone == "" {
+		domain.Logger.Printf("no phone number for user %d, sending %s message by email", msg.ToUserID, msg.Template)
+		return notifiers[domain.NotificationChannelEmail].Send(msg)
 	}
 
 	mobileMessage := Message{
 		FromName:  msg.FromName,
 		FromPhone: msg.FromPhone,
 		ToName:    msg.ToName,
-		ToPhone:   msg.ToPhone,
+		ToPhone:   toPhone,
 		Template:  msg.Template,
+		Data:      msg.Data,
+		Language:  msg.Language,
+	}
+
+	if !msg.NotBefore.IsZero() {
+		body, err := renderSMS(mobileMessage)
+		if err != nil {
+			return err
+		}
+		return deferToOutbox(msg, domain.NotificationChannelMobile, body)
 	}
 
 	return mobileService.Send(mobileMessage)
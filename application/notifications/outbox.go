@@ -0,0 +1,190 @@
+package notifications
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gobuffalo/nulls"
+
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/models"
+)
+
+// errEmailSuppressed is the delivery error recorded for email to an address that bounced or complained
+var errEmailSuppressed = errors.New("recipient address is suppressed after a bounce or complaint")
+
+// errNoRecipient is the delivery error recorded for a held text message or push notification if the recipient no
+// longer has a phone number or push subscription
+var errNoRecipient = errors.New("recipient has no phone number or push subscription")
+
+// getEmailService returns the email service selected by domain.Env.EmailService
+func getEmailService() (EmailService, error) {
+	switch domain.Env.EmailService {
+	case EmailServiceSendGrid:
+		return &SendGridService{}, nil
+	case EmailServiceSES:
+		return &SES{}, nil
+	case EmailServiceSMTP:
+		return &SMTPService{}, nil
+	case EmailServiceDummy:
+		return &TestEmailService, nil
+	}
+	return nil, fmt.Errorf("unknown email service '%s'", domain.Env.EmailService)
+}
+
+// deferToOutbox stores a rendered text message or push notification in the outbox, to be delivered by DeliverOutbox
+// at msg.NotBefore
+func deferToOutbox(msg Message, channel, body string) error {
+	o := models.OutboxMessage{
+		Channel:        channel,
+		IdempotencyKey: msg.IdempotencyKey,
+		UserID:         nulls.NewInt(msg.ToUserID),
+		Template:       msg.Template,
+		Subject:        msg.Subject,
+		ToName:         msg.ToName,
+		TextBody:       body,
+		NotBefore:      nulls.NewTime(msg.NotBefore),
+	}
+
+	created, err := o.Enqueue()
+	if err != nil {
+		return fmt.Errorf("error adding %s %s message to the outbox, %s", msg.Template, channel, err)
+	}
+	if !created {
+		domain.Logger.Printf("%s %s message with key %s was already sent", msg.Template, channel, msg.IdempotencyKey)
+	}
+	return nil
+}
+
+// deliverOutboxMessage sends a message from the outbox and records the result. The message must have been claimed by
+// the caller.
+func deliverOutboxMessage(o *models.OutboxMessage) error {
+	var sendErr error
+	switch o.Channel {
+	case domain.NotificationChannelMobile:
+		sendErr = sendOutboxSMS(o)
+	case domain.NotificationChannelPush:
+		sendErr = sendOutboxPush(o)
+	default:
+		sendErr = sendOutboxEmail(o)
+	}
+
+	if sendErr == errEmailSuppressed || sendErr == errNoRecipient {
+		if err := o.Abandon(sendErr); err != nil {
+			domain.ErrLogger.Printf("error abandoning undeliverable outbox message, %s", err)
+		}
+		return sendErr
+	}
+	if sendErr != nil {
+		if err := o.RecordFailure(sendErr); err != nil {
+			domain.ErrLogger.Printf("error recording outbox delivery failure, %s", err)
+		}
+		return sendErr
+	}
+
+	if err := o.RecordSent(); err != nil {
+		// the message was sent, so don't return an error that would cause it to be sent again
+		domain.ErrLogger.Printf("error recording outbox delivery, %s", err)
+	}
+
+	return nil
+}
+
+// sendOutboxEmail sends an email from the outbox, unless the recipient address has been suppressed
+func sendOutboxEmail(o *models.OutboxMessage) error {
+	suppressed, err := models.IsEmailSuppressed(o.ToEmail)
+	if err != nil {
+		return err
+	}
+	if suppressed {
+		return errEmailSuppressed
+	}
+
+	emailService, err := getEmailService()
+	if err != nil {
+		return err
+	}
+
+	return emailService.Send(Message{
+		Template:       o.Template,
+		Subject:        o.Subject,
+		FromName:       o.FromName,
+		FromEmail:      o.FromEmail,
+		ToName:         o.ToName,
+		ToEmail:        o.ToEmail,
+		HTMLBody:       o.HTMLBody,
+		TextBody:       o.TextBody,
+		Data:           map[string]interface{}{},
+		UnsubscribeURL: o.UnsubscribeURL.String,
+	})
+}
+
+// sendOutboxSMS sends a text message from the outbox to the recipient's current phone number
+func sendOutboxSMS(o *models.OutboxMessage) error {
+	user, err := o.GetUser()
+	if err != nil {
+		return err
+	}
+	if user == nil || !user.PhoneNumber.Valid || user.PhoneNumber.String == "" {
+		return errNoRecipient
+	}
+
+	mobileService, err := getMobileService()
+	if err != nil {
+		return err
+	}
+
+	return mobileService.Send(Message{
+		Template: o.Template,
+		ToName:   o.ToName,
+		ToPhone:  user.PhoneNumber.String,
+		TextBody: o.TextBody,
+	})
+}
+
+// sendOutboxPush sends a push notification from the outbox to the recipient's current subscriptions
+func sendOutboxPush(o *models.OutboxMessage) error {
+	if !o.UserID.Valid {
+		return errNoRecipient
+	}
+
+	var subscriptions models.PushSubscriptions
+	if err := subscriptions.FindByUser(models.User{ID: o.UserID.Int}); err != nil {
+		return fmt.Errorf("error finding push subscriptions, %s", err)
+	}
+	if len(subscriptions) == 0 {
+		return errNoRecipient
+	}
+
+	return sendPushPayload(o.Template, []byte(o.TextBody), subscriptions)
+}
+
+// DeliverOutbox attempts delivery of the queued messages that are due, including those held for the recipients' quiet
+// hours. It returns the number of messages sent and the number that failed.
+func DeliverOutbox() (sent, failed int, err error) {
+	var due models.OutboxMessages
+	if err := due.FindDue(domain.OutboxBatchSize); err != nil {
+		return 0, 0, fmt.Errorf("error finding outbox messages, %s", err)
+	}
+
+	for i := range due {
+		claimed, err := due[i].Claim()
+		if err != nil {
+			domain.ErrLogger.Printf("error claiming outbox message %d, %s", due[i].ID, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		if err := deliverOutboxMessage(&due[i]); err != nil {
+			domain.ErrLogger.Printf("outbox message %d not delivered on attempt %d, %s",
+				due[i].ID, due[i].Attempts, err)
+			failed++
+			continue
+		}
+		sent++
+	}
+
+	return sent, failed, nil
+}
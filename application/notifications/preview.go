@@ -0,0 +1,151 @@
+package notifications
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/models"
+)
+
+// TemplatePreview is a message template rendered with sample data. If the template has no email version, HTMLBody is
+// empty and TextBody is the text message version, if there is one.
+type TemplatePreview struct {
+	Template string
+	Subject  string
+	HTMLBody string
+	TextBody string
+}
+
+// previewRequest is the sample request in a digest preview. Its fields match those used by the digest template.
+type previewRequest struct {
+	Title       string
+	URL         string
+	Destination string
+	WatchMatch  bool
+}
+
+// previewData returns the sample data used to render message templates for a preview
+func previewData() map[string]interface{} {
+	requestURL := domain.GetRequestUIURL("00000000-0000-0000-0000-000000000000")
+	return map[string]interface{}{
+		"appName":            domain.Env.AppName,
+		"uiURL":              domain.Env.UIURL,
+		"requestURL":         requestURL,
+		"requestTitle":       "A bag of coffee",
+		"requestDescription": "Two pounds of whole beans, any roast",
+		"requestDestination": "Nairobi, Kenya",
+		"receiverNickname":   "Requester",
+		"providerNickname":   "Provider",
+		"ppNickname":         "Provider",
+		"ppEmail":            "provider@example.com",
+		"sentByNickname":     "Provider",
+		"messageContent":     "I can bring it next week.",
+		"sentAt":             time.Now(),
+		"threadURL":          domain.Env.UIURL + "/messages/00000000-0000-0000-0000-000000000000",
+		"firstName":          "Requester",
+		"userEmail":          "requester@example.com",
+		"supportEmail":       domain.Env.SupportEmail,
+		"code":               "123456",
+		"watchName":          "Coffee to Nairobi",
+		"watchExpiresOn":     time.Now().AddDate(0, 0, -1).Format(domain.DateFormat),
+		"inviterNickname":    "Organizer",
+		"meetingName":        "Annual Conference",
+		"meetingDescription": "Our yearly gathering",
+		"meetingLocation":    "Nairobi, Kenya",
+		"meetingStartDate":   time.Now().AddDate(0, 1, 0).Format(domain.DateFormat),
+		"meetingEndDate":     time.Now().AddDate(0, 1, 4).Format(domain.DateFormat),
+		"meetingMoreInfoURL": "https://www.example.com/conference",
+		"inviteURL":          domain.GetInviteUIURL("00000000-0000-0000-0000-000000000000"),
+		"addedByNickname":    "Organizer",
+		"meetingURL":         domain.GetMeetingUIURL("00000000-0000-0000-0000-000000000000"),
+		"authorNickname":     "Organizer",
+		"announcement":       "The carry exchange desk is in room B at 3pm.",
+		"requests": []previewRequest{
+			{Title: "A bag of coffee", URL: requestURL, Destination: "Nairobi, Kenya", WatchMatch: true},
+			{Title: "Cheese", URL: requestURL, Destination: "Lyon, France"},
+		},
+	}
+}
+
+// PreviewTemplate renders a message template in the given language with sample data, using the same renderer as
+// the notifications that are sent
+func PreviewTemplate(template, language string) (TemplatePreview, error) {
+	if !domain.IsStringInSlice(template, domain.MessageTemplates) {
+		return TemplatePreview{}, fmt.Errorf("unknown message template '%s'", template)
+	}
+	if language == "" {
+		language = domain.UserPreferenceLanguageEnglish
+	}
+
+	preview := TemplatePreview{Template: template}
+	data := previewData()
+
+	if subjectID, ok := templateSubjects[template]; ok {
+		preview.Subject = domain.GetTranslatedSubject(language, subjectID, map[string]string{
+			"requestTitle":    data["requestTitle"].(string),
+			"sentByNickname":  data["sentByNickname"].(string),
+			"count":           strconv.Itoa(len(data["requests"].([]previewRequest))),
+			"watchName":       data["watchName"].(string),
+			"inviterNickname": data["inviterNickname"].(string),
+			"addedByNickname": data["addedByNickname"].(string),
+			"meetingName":     data["meetingName"].(string),
+		})
+	}
+
+	emailTemplate := GetEmailTemplate(template)
+	if !mailTemplates.Has(emailTemplate + ".plush.html") {
+		if hasSMSTemplate(template) {
+			text, err := renderSMS(Message{Template: template, Data: data})
+			if err != nil {
+				return TemplatePreview{}, err
+			}
+			preview.TextBody = text
+		}
+		return preview, nil
+	}
+
+	htmlBody, textBody, err := renderEmailBody(Message{
+		Template:       emailTemplate,
+		Data:           data,
+		Language:       language,
+		UnsubscribeURL: domain.Env.UIURL + "/unsubscribe",
+	})
+	if err != nil {
+		return TemplatePreview{}, err
+	}
+
+	preview.HTMLBody = htmlBody
+	preview.TextBody = textBody
+	return preview, nil
+}
+
+// SendTemplatePreview renders a message template in the given language with sample data and emails it to the given
+// user, regardless of the user's notification preferences
+func SendTemplatePreview(template, language string, user models.User) (TemplatePreview, error) {
+	preview, err := PreviewTemplate(template, language)
+	if err != nil {
+		return TemplatePreview{}, err
+	}
+	if preview.HTMLBody == "" {
+		return TemplatePreview{}, errors.New("message template " + template + " has no email version")
+	}
+
+	msg := Message{
+		Template:  template,
+		Subject:   "[TEST] " + preview.Subject,
+		FromEmail: domain.EmailFromAddress(nil),
+		ToName:    user.GetRealName(),
+		ToEmail:   user.Email,
+		Language:  language,
+		HTMLBody:  preview.HTMLBody,
+		TextBody:  preview.TextBody,
+	}
+
+	if err := notifiers[domain.NotificationChannelEmail].Send(msg); err != nil {
+		return TemplatePreview{}, err
+	}
+	return preview, nil
+}
@@ -0,0 +1,51 @@
+package notifications
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
+func TestPreviewTemplate(t *testing.T) {
+	for _, template := range domain.MessageTemplates {
+		for _, language := range domain.AllowedLanguages {
+			t.Run(template+" "+language, func(t *testing.T) {
+				preview, err := PreviewTemplate(template, language)
+				require.NoError(t, err)
+				assert.Equal(t, template, preview.Template)
+
+				if preview.HTMLBody == "" {
+					assert.Empty(t, preview.Subject, "template without email should not have a subject")
+					return
+				}
+				assert.NotEmpty(t, preview.Subject)
+				assert.NotEmpty(t, preview.TextBody)
+				assert.Contains(t, preview.HTMLBody, `lang="`+language+`"`)
+				assert.NotContains(t, preview.Subject, "<no value>")
+			})
+		}
+	}
+
+	t.Run("new request in Spanish", func(t *testing.T) {
+		preview, err := PreviewTemplate(domain.MessageTemplateNewRequest, domain.UserPreferenceLanguageSpanish)
+		require.NoError(t, err)
+		assert.Contains(t, preview.HTMLBody, "A bag of coffee")
+		assert.Equal(t, domain.GetTranslatedSubject(domain.UserPreferenceLanguageSpanish, "Email.Subject.NewRequest",
+			map[string]string{}), preview.Subject)
+	})
+
+	t.Run("phone code", func(t *testing.T) {
+		preview, err := PreviewTemplate(domain.MessageTemplatePhoneCode, "")
+		require.NoError(t, err)
+		assert.Empty(t, preview.HTMLBody)
+		assert.Contains(t, preview.TextBody, "123456")
+	})
+
+	t.Run("unknown template", func(t *testing.T) {
+		_, err := PreviewTemplate("not_a_template", "")
+		assert.Error(t, err)
+	})
+}
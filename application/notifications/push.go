@@ -0,0 +1,168 @@
+package notifications
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	webpush "github.com/SherClockHolmes/webpush-go"
+	"github.com/gobuffalo/buffalo/render"
+	"jaytaylor.com/html2text"
+
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/models"
+)
+
+const (
+	// pushTTL is the number of seconds a push service should hold a message for an offline browser
+	pushTTL = 24 * 60 * 60
+
+	// pushMaxBodyLength limits the message body to keep the encrypted payload under the 4KB push service limit
+	pushMaxBodyLength = 1000
+)
+
+// pR renders the message templates without the email layout, for use in push notification text
+var pR = render.New(render.Options{
+	TemplatesBox:    mailTemplates,
+	TemplateEngines: mailTemplateEngines(),
+	Helpers:         templateHelpers(),
+})
+
+// pushPayload is the JSON content of a push notification, to be displayed by the UI's service worker
+type pushPayload struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	URL   string `json:"url"`
+	Type  string `json:"type"`
+}
+
+// PushNotifier is a Web Push notifier that conforms to the Notifier interface.
+type PushNotifier struct {
+}
+
+// Send a notification to each of the recipient's subscribed browsers. If the recipient has no subscriptions, the
+// message is sent by email instead. A notification held for the recipient's quiet hours is stored in the outbox.
+func (p *PushNotifier) Send(msg Message) error {
+	var subscriptions models.PushSubscriptions
+	if err := subscriptions.FindByUser(models.User{ID: msg.ToUserID}); err != nil {
+		return fmt.Errorf("error finding push subscriptions, %s", err)
+	}
+
+	if len(subscriptions) == 0 {
+		domain.Logger.Printf("no push subscriptions for user %d, sending %s message by email",
+			msg.ToUserID, msg.Template)
+		return notifiers[domain.NotificationChannelEmail].Send(msg)
+	}
+
+	payload, err := newPushPayload(msg)
+	if err != nil {
+		return err
+	}
+
+	if !msg.NotBefore.IsZero() {
+		return deferToOutbox(msg, domain.NotificationChannelPush, string(payload))
+	}
+
+	return sendPushPayload(msg.Template, payload, subscriptions)
+}
+
+// sendPushPayload sends a push notification payload to each of the given subscriptions, removing any that have expired.
+// An error is returned if it was not delivered to any subscription.
+func sendPushPayload(template string, payload []byte, subscriptions models.PushSubscriptions) error {
+	var sent int
+	for _, s := range subscriptions {
+		gone, err := sendWebPush(payload, s)
+		if gone {
+			if err := s.Destroy(); err != nil {
+				domain.ErrLogger.Printf("error removing expired push subscription, %s", err)
+			}
+			continue
+		}
+		if err != nil {
+			domain.ErrLogger.Printf("error sending push notification, %s", err)
+			continue
+		}
+		sent++
+	}
+
+	if sent == 0 {
+		return fmt.Errorf("%s push notification not delivered to any of %d subscriptions",
+			template, len(subscriptions))
+	}
+
+	return nil
+}
+
+// newPushPayload renders the message template as plain text and encodes it into a push notification payload
+func newPushPayload(msg Message) ([]byte, error) {
+	data := map[string]interface{}{}
+	for k, v := range msg.Data {
+		data[k] = v
+	}
+	data["uiURL"] = domain.Env.UIURL
+	data["appName"] = domain.Env.AppName
+	data["language"] = messageLanguage(msg)
+	data["timeZone"] = msg.TimeZone
+
+	bodyBuf := &bytes.Buffer{}
+	if err := pR.HTML(localizedTemplate(msg.Template, msg.Language)).Render(bodyBuf, data); err != nil {
+		return nil, errors.New("error rendering push message body - " + err.Error())
+	}
+
+	body, err := html2text.FromString(bodyBuf.String(), html2text.Options{OmitLinks: true})
+	if err != nil {
+		return nil, errors.New("error converting push message body to plain text - " + err.Error())
+	}
+	payload := pushPayload{
+		Title: msg.Subject,
+		Body:  domain.Truncate(body, "…", pushMaxBodyLength),
+		URL:   pushURL(data),
+		Type:  msg.Template,
+	}
+
+	return json.Marshal(payload)
+}
+
+// pushURL selects the most specific link in the message data for the notification to open when clicked
+func pushURL(data map[string]interface{}) string {
+	for _, key := range []string{"threadURL", "requestURL", "meetingURL", "uiURL"} {
+		if url, ok := data[key].(string); ok && url != "" {
+			return url
+		}
+	}
+	return ""
+}
+
+// sendWebPush encrypts the payload and sends it to the subscription's push service. If the push service reports that
+// the subscription no longer exists, `gone` is true.
+func sendWebPush(payload []byte, s models.PushSubscription) (gone bool, err error) {
+	resp, err := webpush.SendNotification(payload, &webpush.Subscription{
+		Endpoint: s.Endpoint,
+		Keys: webpush.Keys{
+			Auth:   s.Auth,
+			P256dh: s.P256dh,
+		},
+	}, &webpush.Options{
+		HTTPClient:      &http.Client{Timeout: 30 * time.Second},
+		Subscriber:      domain.Env.VapidSubscriber,
+		TTL:             pushTTL,
+		VAPIDPublicKey:  domain.Env.VapidPublicKey,
+		VAPIDPrivateKey: domain.Env.VapidPrivateKey,
+	})
+	if err != nil {
+		return false, fmt.Errorf("error sending web push request, %s", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return true, nil
+	case resp.StatusCode >= 400:
+		return false, fmt.Errorf("error response (%d) from push service", resp.StatusCode)
+	}
+
+	return false, nil
+}
@@ -0,0 +1,192 @@
+package notifications
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	webpush "github.com/SherClockHolmes/webpush-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/hkdf"
+
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/models"
+)
+
+// fakeBrowser holds the keys a browser would generate for a push subscription
+type fakeBrowser struct {
+	privateKey []byte
+	publicKey  []byte
+	authSecret []byte
+}
+
+func newFakeBrowser(t *testing.T) fakeBrowser {
+	privateKey, x, y, err := elliptic.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	authSecret := make([]byte, 16)
+	_, err = rand.Read(authSecret)
+	require.NoError(t, err)
+
+	return fakeBrowser{
+		privateKey: privateKey,
+		publicKey:  elliptic.Marshal(elliptic.P256(), x, y),
+		authSecret: authSecret,
+	}
+}
+
+func (b fakeBrowser) subscription(endpoint string) models.PushSubscription {
+	return models.PushSubscription{
+		Endpoint: endpoint,
+		P256dh:   base64.RawURLEncoding.EncodeToString(b.publicKey),
+		Auth:     base64.RawURLEncoding.EncodeToString(b.authSecret),
+	}
+}
+
+// decrypt reverses the aes128gcm content encoding of RFC 8291
+func (b fakeBrowser) decrypt(t *testing.T, body []byte) []byte {
+	require.True(t, len(body) > 21, "push message body is too short")
+	salt := body[:16]
+	keyIDLength := int(body[20])
+	serverPublicKey := body[21 : 21+keyIDLength]
+	ciphertext := body[21+keyIDLength:]
+
+	curve := elliptic.P256()
+	sx, sy := elliptic.Unmarshal(curve, serverPublicKey)
+	require.NotNil(t, sx, "invalid server public key")
+	secretX, _ := curve.ScalarMult(sx, sy, b.privateKey)
+
+	info := append([]byte("WebPush: info\x00"), b.publicKey...)
+	info = append(info, serverPublicKey...)
+	ikm := readHKDF(t, hkdf.New(sha256.New, secretX.Bytes(), b.authSecret, info), 32)
+	key := readHKDF(t, hkdf.New(sha256.New, ikm, salt, []byte("Content-Encoding: aes128gcm\x00")), 16)
+	nonce := readHKDF(t, hkdf.New(sha256.New, ikm, salt, []byte("Content-Encoding: nonce\x00")), 12)
+
+	c, err := aes.NewCipher(key)
+	require.NoError(t, err)
+	gcm, err := cipher.NewGCM(c)
+	require.NoError(t, err)
+
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	require.NoError(t, err, "unable to decrypt push message")
+
+	plaintext = bytes.TrimRight(plaintext, "\x00")
+	require.True(t, len(plaintext) > 0 && plaintext[len(plaintext)-1] == 2, "missing padding delimiter")
+	return plaintext[:len(plaintext)-1]
+}
+
+func readHKDF(t *testing.T, r io.Reader, n int) []byte {
+	b := make([]byte, n)
+	_, err := io.ReadFull(r, b)
+	require.NoError(t, err)
+	return b
+}
+
+// fakePushService records the requests it receives and responds with the given status code
+type fakePushService struct {
+	status   int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (f *fakePushService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	f.requests = append(f.requests, r)
+	f.bodies = append(f.bodies, body)
+	w.WriteHeader(f.status)
+}
+
+// setVapidKeys configures a new VAPID key pair and returns a function to restore the original configuration
+func setVapidKeys(t *testing.T) func() {
+	privateKey, publicKey, err := webpush.GenerateVAPIDKeys()
+	require.NoError(t, err)
+
+	oldEnv := domain.Env
+	domain.Env.VapidPrivateKey = privateKey
+	domain.Env.VapidPublicKey = publicKey
+	domain.Env.VapidSubscriber = "support@example.com"
+	return func() { domain.Env = oldEnv }
+}
+
+func TestSendWebPush(t *testing.T) {
+	defer setVapidKeys(t)()
+	browser := newFakeBrowser(t)
+	payload := []byte(`{"title":"a push message"}`)
+
+	tests := []struct {
+		name     string
+		status   int
+		wantGone bool
+		wantErr  bool
+	}{
+		{name: "created", status: http.StatusCreated},
+		{name: "not found", status: http.StatusNotFound, wantGone: true},
+		{name: "gone", status: http.StatusGone, wantGone: true},
+		{name: "error", status: http.StatusBadRequest, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := &fakePushService{status: test.status}
+			server := httptest.NewServer(service)
+			defer server.Close()
+
+			gone, err := sendWebPush(payload, browser.subscription(server.URL+"/push/abc123"))
+			if test.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.wantGone, gone)
+
+			require.Equal(t, 1, len(service.requests))
+			r := service.requests[0]
+			assert.Equal(t, "/push/abc123", r.URL.Path)
+			assert.Equal(t, "aes128gcm", r.Header.Get("Content-Encoding"))
+			assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "vapid t="),
+				"missing VAPID authorization header")
+			assert.Contains(t, r.Header.Get("Authorization"), "k="+domain.Env.VapidPublicKey)
+			assert.Equal(t, payload, browser.decrypt(t, service.bodies[0]))
+		})
+	}
+}
+
+func TestNewPushPayload(t *testing.T) {
+	msg := Message{
+		Template: domain.MessageTemplateNewThreadMessage,
+		Subject:  "new message",
+		Data: map[string]interface{}{
+			"requestURL":     "https://example.com/requests/1",
+			"requestTitle":   "My Request",
+			"messageContent": "I can bring it<script>doBadThings()</script>",
+			"sentByNickname": "Fred",
+			"sentAt":         time.Now(),
+			"threadURL":      "https://example.com/messages/1",
+		},
+	}
+
+	payload, err := newPushPayload(msg)
+	require.NoError(t, err)
+
+	var got pushPayload
+	require.NoError(t, json.Unmarshal(payload, &got))
+	assert.Equal(t, msg.Subject, got.Title)
+	assert.Equal(t, msg.Template, got.Type)
+	assert.Equal(t, "https://example.com/messages/1", got.URL)
+	assert.Contains(t, got.Body, msg.Data["messageContent"], "body should be plain text, not escaped HTML")
+	assert.NotContains(t, got.Body, "<html")
+	assert.NotContains(t, got.Body, "<p>")
+	assert.True(t, len(payload) < 4096, "payload is too large for a push message")
+}
@@ -1,14 +1,18 @@
 package notifications
 
 import (
-	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"math/big"
 
 	"github.com/sendgrid/sendgrid-go"
 	"github.com/sendgrid/sendgrid-go/helpers/mail"
 	"github.com/silinternational/wecarry-api/domain"
-	"jaytaylor.com/html2text"
 )
 
 type SendGridService struct {
@@ -28,22 +32,15 @@ func (e *SendGridService) Send(msg Message) error {
 	from := mail.NewEmail(msg.FromName, msg.FromEmail)
 	to := mail.NewEmail(msg.ToName, msg.ToEmail)
 
-	msg.Data["uiURL"] = domain.Env.UIURL
-	msg.Data["appName"] = domain.Env.AppName
-
-	bodyBuf := &bytes.Buffer{}
-	if err := eR.HTML(msg.Template).Render(bodyBuf, msg.Data); err != nil {
-		return errors.New("error rendering message body - " + err.Error())
-	}
-	body := bodyBuf.String()
-
-	tbody, err := html2text.FromString(body)
+	body, tbody, err := renderEmailBody(msg)
 	if err != nil {
-		domain.Logger.Printf("error converting html email to plain text ... %s", err.Error())
-		tbody = body
+		return err
 	}
 
 	m := mail.NewSingleEmail(from, msg.Subject, to, tbody, body)
+	for name, value := range listUnsubscribeHeaders(msg) {
+		m.SetHeader(name, value)
+	}
 	client := sendgrid.NewSendClient(apiKey)
 	response, err := client.Send(m)
 
@@ -60,3 +57,69 @@ func (e *SendGridService) Send(msg Message) error {
 
 	return nil
 }
+
+const (
+	// SendGridSignatureHeader holds the signature of a SendGrid event webhook request
+	SendGridSignatureHeader = "X-Twilio-Email-Event-Webhook-Signature"
+
+	// SendGridTimestampHeader holds the timestamp of a SendGrid event webhook request, which is included in the
+	// signed payload
+	SendGridTimestampHeader = "X-Twilio-Email-Event-Webhook-Timestamp"
+)
+
+// SendGridEvent is one event posted by the SendGrid event webhook. Only the fields used by the App are included.
+type SendGridEvent struct {
+	Email  string `json:"email"`
+	Event  string `json:"event"`
+	Type   string `json:"type"`
+	Reason string `json:"reason"`
+}
+
+// IsPermanentBounce is true for a bounce after which mail to the address will not be delivered. Blocked messages are
+// temporary failures and may be delivered later.
+func (e SendGridEvent) IsPermanentBounce() bool {
+	return e.Event == "bounce" && e.Type != "blocked"
+}
+
+// IsComplaint is true if the recipient marked the email as spam
+func (e SendGridEvent) IsComplaint() bool {
+	return e.Event == "spamreport"
+}
+
+// VerifySendGridSignature checks the ECDSA signature of a SendGrid event webhook request. The public key is the
+// base64-encoded verification key shown in the SendGrid mail settings.
+func VerifySendGridSignature(publicKey, signature, timestamp string, body []byte) error {
+	if publicKey == "" {
+		return errors.New("no SendGrid webhook verification key is configured")
+	}
+
+	der, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return fmt.Errorf("invalid SendGrid webhook verification key, %s", err)
+	}
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return fmt.Errorf("invalid SendGrid webhook verification key, %s", err)
+	}
+	ecdsaKey, ok := key.(*ecdsa.PublicKey)
+	if !ok {
+		return errors.New("SendGrid webhook verification key is not an ECDSA key")
+	}
+
+	sigDER, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("invalid SendGrid webhook signature encoding, %s", err)
+	}
+	var sig struct {
+		R, S *big.Int
+	}
+	if _, err := asn1.Unmarshal(sigDER, &sig); err != nil {
+		return fmt.Errorf("invalid SendGrid webhook signature, %s", err)
+	}
+
+	digest := sha256.Sum256(append([]byte(timestamp), body...))
+	if !ecdsa.Verify(ecdsaKey, digest[:], sig.R, sig.S) {
+		return errors.New("SendGrid webhook signature is not valid")
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package notifications
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifySendGridSignature(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	publicKey := base64.StdEncoding.EncodeToString(der)
+
+	timestamp := "1587556800"
+	body := []byte(`[{"email":"user@example.com","event":"bounce","type":"bounce"}]`)
+
+	digest := sha256.Sum256(append([]byte(timestamp), body...))
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+	sigDER, err := asn1.Marshal(struct{ R, S interface{} }{r, s})
+	require.NoError(t, err)
+	signature := base64.StdEncoding.EncodeToString(sigDER)
+
+	assert.NoError(t, VerifySendGridSignature(publicKey, signature, timestamp, body), "valid signature was rejected")
+
+	assert.Error(t, VerifySendGridSignature(publicKey, signature, "1587556801", body),
+		"signature with a different timestamp was accepted")
+	assert.Error(t, VerifySendGridSignature(publicKey, signature, timestamp, []byte(`[]`)),
+		"signature of a different body was accepted")
+	assert.Error(t, VerifySendGridSignature("", signature, timestamp, body),
+		"signature was accepted with no key configured")
+	assert.Error(t, VerifySendGridSignature(publicKey, "not a signature", timestamp, body),
+		"malformed signature was accepted")
+}
+
+func TestSendGridEvent(t *testing.T) {
+	tests := []struct {
+		event         SendGridEvent
+		wantPermanent bool
+		wantComplaint bool
+	}{
+		{event: SendGridEvent{Event: "bounce", Type: "bounce"}, wantPermanent: true},
+		{event: SendGridEvent{Event: "bounce", Type: "blocked"}},
+		{event: SendGridEvent{Event: "spamreport"}, wantComplaint: true},
+		{event: SendGridEvent{Event: "delivered"}},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.wantPermanent, test.event.IsPermanentBounce(), "%+v", test.event)
+		assert.Equal(t, test.wantComplaint, test.event.IsComplaint(), "%+v", test.event)
+	}
+}
@@ -0,0 +1,277 @@
+package notifications
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
+const (
+	SMTPSecurityStartTLS = "starttls"
+	SMTPSecurityTLS      = "tls"
+	SMTPSecurityNone     = "none"
+
+	smtpDialTimeout = 30 * time.Second
+
+	// smtpIdleTimeout is the time an unused connection is kept open for reuse
+	smtpIdleTimeout = 30 * time.Second
+)
+
+// smtpRootCAs is the set of CAs used to verify the SMTP server certificate. If nil, the system set is used.
+var smtpRootCAs *x509.CertPool
+
+// smtpConn is the connection shared by all messages sent by the SMTPService
+var smtpConn struct {
+	sync.Mutex
+	client *smtp.Client
+	timer  *time.Timer
+}
+
+// SMTPService sends email through an SMTP relay. The connection is reused for subsequent messages until it has been
+// idle for smtpIdleTimeout.
+type SMTPService struct {
+}
+
+// Send a message
+func (s *SMTPService) Send(msg Message) error {
+	body, tbody, err := renderEmailBody(msg)
+	if err != nil {
+		return err
+	}
+
+	from, err := smtpAddress(msg.FromName, msg.FromEmail)
+	if err != nil {
+		return fmt.Errorf("invalid from address, %s", err)
+	}
+	to, err := smtpAddress(msg.ToName, msg.ToEmail)
+	if err != nil {
+		return fmt.Errorf("invalid to address, %s", err)
+	}
+
+	data, err := buildMIMEMessage(from, to, msg.Subject, body, tbody, listUnsubscribeHeaders(msg))
+	if err != nil {
+		return err
+	}
+
+	smtpConn.Lock()
+	defer smtpConn.Unlock()
+
+	c, err := getSMTPClient()
+	if err != nil {
+		return err
+	}
+
+	if err := smtpDeliver(c, from.Address, to.Address, data); err != nil {
+		closeSMTPClient()
+		return fmt.Errorf("error attempting to send message, %s", err)
+	}
+
+	if smtpConn.timer != nil {
+		smtpConn.timer.Stop()
+	}
+	smtpConn.timer = time.AfterFunc(smtpIdleTimeout, func() {
+		smtpConn.Lock()
+		defer smtpConn.Unlock()
+		closeSMTPClient()
+	})
+
+	return nil
+}
+
+// smtpAddress parses an address that may include a display name. If it doesn't, the given name is used.
+func smtpAddress(name, address string) (*mail.Address, error) {
+	a, err := mail.ParseAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	if a.Name == "" {
+		a.Name = name
+	}
+	return a, nil
+}
+
+// buildMIMEMessage creates a multipart/alternative message with plain text and HTML parts. Any extra headers are
+// added to the message header.
+func buildMIMEMessage(from, to *mail.Address, subject, htmlBody, textBody string,
+	extraHeaders map[string]string) ([]byte, error) {
+
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+
+	header := [][2]string{
+		{"From", from.String()},
+		{"To", to.String()},
+		{"Subject", mime.QEncoding.Encode("utf-8", subject)},
+		{"Date", time.Now().Format(time.RFC1123Z)},
+		{"Message-Id", fmt.Sprintf("<%s@%s>", domain.GetUUID().String(), smtpDomain(from.Address))},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", "multipart/alternative; boundary=" + mw.Boundary()},
+	}
+	names := make([]string, 0, len(extraHeaders))
+	for name := range extraHeaders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		header = append(header, [2]string{name, extraHeaders[name]})
+	}
+	for _, h := range header {
+		buf.WriteString(h[0] + ": " + h[1] + "\r\n")
+	}
+	buf.WriteString("\r\n")
+
+	parts := []struct{ contentType, content string }{
+		{"text/plain; charset=UTF-8", textBody},
+		{"text/html; charset=UTF-8", htmlBody},
+	}
+	for _, p := range parts {
+		w, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {p.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write([]byte(p.content)); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// smtpDomain returns the domain part of an email address
+func smtpDomain(address string) string {
+	i := strings.LastIndex(address, "@")
+	if i < 0 {
+		return "localhost"
+	}
+	return address[i+1:]
+}
+
+// getSMTPClient returns the open connection if it is still usable, or opens a new one. smtpConn must be locked.
+func getSMTPClient() (*smtp.Client, error) {
+	if smtpConn.client != nil {
+		if err := smtpConn.client.Noop(); err == nil {
+			return smtpConn.client, nil
+		}
+		closeSMTPClient()
+	}
+
+	c, err := dialSMTP()
+	if err != nil {
+		return nil, err
+	}
+
+	smtpConn.client = c
+	return c, nil
+}
+
+// closeSMTPClient closes the open connection, if any. smtpConn must be locked.
+func closeSMTPClient() {
+	if smtpConn.client == nil {
+		return
+	}
+
+	if err := smtpConn.client.Quit(); err != nil {
+		_ = smtpConn.client.Close()
+	}
+	smtpConn.client = nil
+}
+
+// dialSMTP connects and authenticates to the SMTP server
+func dialSMTP() (*smtp.Client, error) {
+	host := domain.Env.SMTPHost
+	if host == "" {
+		return nil, errors.New("SMTP host is required")
+	}
+
+	addr := net.JoinHostPort(host, domain.Env.SMTPPort)
+	tlsConfig := &tls.Config{ServerName: host, RootCAs: smtpRootCAs}
+	dialer := &net.Dialer{Timeout: smtpDialTimeout}
+
+	var conn net.Conn
+	var err error
+	switch domain.Env.SMTPSecurity {
+	case SMTPSecurityTLS:
+		conn, err = tls.DialWithDialer(dialer, "tcp", addr, tlsConfig)
+	case SMTPSecurityStartTLS, SMTPSecurityNone:
+		conn, err = dialer.Dial("tcp", addr)
+	default:
+		return nil, fmt.Errorf("invalid SMTP security option '%s'", domain.Env.SMTPSecurity)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to SMTP server, %s", err)
+	}
+
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("error starting SMTP session, %s", err)
+	}
+
+	if domain.Env.SMTPSecurity == SMTPSecurityStartTLS {
+		if ok, _ := c.Extension("STARTTLS"); !ok {
+			_ = c.Close()
+			return nil, errors.New("SMTP server does not support STARTTLS")
+		}
+		if err := c.StartTLS(tlsConfig); err != nil {
+			_ = c.Close()
+			return nil, fmt.Errorf("error starting TLS on SMTP connection, %s", err)
+		}
+	}
+
+	if domain.Env.SMTPUsername != "" {
+		auth := smtp.PlainAuth("", domain.Env.SMTPUsername, domain.Env.SMTPPassword, host)
+		if err := c.Auth(auth); err != nil {
+			_ = c.Close()
+			return nil, fmt.Errorf("SMTP authentication failed, %s", err)
+		}
+	}
+
+	return c, nil
+}
+
+// smtpDeliver sends one message on an open connection
+func smtpDeliver(c *smtp.Client, from, to string, data []byte) error {
+	if err := c.Mail(from); err != nil {
+		return err
+	}
+	if err := c.Rcpt(to); err != nil {
+		return err
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+
+	return w.Close()
+}
@@ -0,0 +1,328 @@
+package notifications
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"io/ioutil"
+	"math/big"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
+// smtpSink is a minimal SMTP server that records the messages it receives
+type smtpSink struct {
+	listener  net.Listener
+	tlsConfig *tls.Config
+	startTLS  bool
+
+	sync.Mutex
+	connections int
+	auth        []string
+	from        []string
+	to          []string
+	messages    []string
+	usedTLS     []bool
+}
+
+// newSMTPSink starts an SMTP sink on a random local port. If implicitTLS is true, the connection is TLS from the
+// start, otherwise STARTTLS is offered.
+func newSMTPSink(t *testing.T, implicitTLS bool) *smtpSink {
+	cert, pool := newTestCertificate(t)
+	s := &smtpSink{
+		tlsConfig: &tls.Config{Certificates: []tls.Certificate{cert}},
+		startTLS:  !implicitTLS,
+	}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	if implicitTLS {
+		l = tls.NewListener(l, s.tlsConfig)
+	}
+	s.listener = l
+	smtpRootCAs = pool
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+
+	return s
+}
+
+func (s *smtpSink) port() string {
+	_, port, _ := net.SplitHostPort(s.listener.Addr().String())
+	return port
+}
+
+func (s *smtpSink) close() {
+	_ = s.listener.Close()
+}
+
+func (s *smtpSink) serve(conn net.Conn) {
+	defer conn.Close()
+
+	s.Lock()
+	s.connections++
+	s.Unlock()
+
+	_, isTLS := conn.(*tls.Conn)
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+	reply("220 localhost ESMTP sink")
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+
+		switch cmd {
+		case "EHLO", "HELO":
+			reply("250-localhost")
+			if s.startTLS && !isTLS {
+				reply("250-STARTTLS")
+			}
+			reply("250-AUTH PLAIN")
+			reply("250 8BITMIME")
+		case "STARTTLS":
+			reply("220 ready to start TLS")
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn = tlsConn
+			r = bufio.NewReader(conn)
+			isTLS = true
+		case "AUTH":
+			fields := strings.Fields(line)
+			decoded, _ := base64.StdEncoding.DecodeString(fields[len(fields)-1])
+			s.Lock()
+			s.auth = append(s.auth, string(decoded))
+			s.Unlock()
+			reply("235 authenticated")
+		case "MAIL":
+			s.Lock()
+			s.from = append(s.from, line)
+			s.Unlock()
+			reply("250 ok")
+		case "RCPT":
+			s.Lock()
+			s.to = append(s.to, line)
+			s.Unlock()
+			reply("250 ok")
+		case "DATA":
+			reply("354 send the message")
+			var data strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(l, "."))
+			}
+			s.Lock()
+			s.messages = append(s.messages, data.String())
+			s.usedTLS = append(s.usedTLS, isTLS)
+			s.Unlock()
+			reply("250 queued")
+		case "RSET", "NOOP":
+			reply("250 ok")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+// newTestCertificate creates a self-signed certificate for 127.0.0.1 and a pool that trusts it
+func newTestCertificate(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, pool
+}
+
+func TestSMTPService_Send(t *testing.T) {
+	oldEnv := domain.Env
+	defer func() {
+		domain.Env = oldEnv
+		smtpRootCAs = nil
+	}()
+
+	tests := []struct {
+		name        string
+		security    string
+		implicitTLS bool
+		username    string
+		wantTLS     bool
+	}{
+		{name: "starttls with auth", security: SMTPSecurityStartTLS, username: "relay-user", wantTLS: true},
+		{name: "implicit tls", security: SMTPSecurityTLS, implicitTLS: true, wantTLS: true},
+		{name: "no tls", security: SMTPSecurityNone},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sink := newSMTPSink(t, test.implicitTLS)
+			defer sink.close()
+
+			domain.Env.SMTPHost = "127.0.0.1"
+			domain.Env.SMTPPort = sink.port()
+			domain.Env.SMTPSecurity = test.security
+			domain.Env.SMTPUsername = test.username
+			domain.Env.SMTPPassword = "relay-password"
+
+			msg := Message{
+				FromName:       "WeCarry",
+				FromEmail:      "no_reply@example.com",
+				ToName:         "Fred Jones",
+				ToEmail:        "fred@example.com",
+				Template:       domain.MessageTemplateNewUserWelcome,
+				Subject:        "Bienvenue à WeCarry",
+				UnsubscribeURL: "https://api.example.com/unsubscribe/token",
+				Data: map[string]interface{}{
+					"firstName":    "Fred",
+					"userEmail":    "fred@example.com",
+					"supportEmail": "support@example.com",
+				},
+			}
+
+			var s SMTPService
+			require.NoError(t, s.Send(msg))
+			require.NoError(t, s.Send(msg))
+
+			smtpConn.Lock()
+			closeSMTPClient()
+			smtpConn.Unlock()
+
+			sink.Lock()
+			defer sink.Unlock()
+
+			assert.Equal(t, 1, sink.connections, "connection was not reused")
+			require.Equal(t, 2, len(sink.messages), "incorrect number of messages received")
+			assert.Equal(t, test.wantTLS, sink.usedTLS[0], "incorrect use of TLS")
+			assert.Equal(t, "MAIL FROM:<no_reply@example.com> BODY=8BITMIME", sink.from[0])
+			assert.Equal(t, "RCPT TO:<fred@example.com>", sink.to[0])
+
+			if test.username != "" {
+				require.Equal(t, 1, len(sink.auth), "incorrect number of authentications")
+				assert.Equal(t, "\x00relay-user\x00relay-password", sink.auth[0])
+			} else {
+				assert.Equal(t, 0, len(sink.auth), "unexpected authentication")
+			}
+
+			m, err := mail.ReadMessage(strings.NewReader(sink.messages[0]))
+			require.NoError(t, err)
+
+			subject, err := new(mime.WordDecoder).DecodeHeader(m.Header.Get("Subject"))
+			require.NoError(t, err)
+			assert.Equal(t, msg.Subject, subject)
+			assert.Contains(t, m.Header.Get("From"), "<no_reply@example.com>")
+			assert.Equal(t, `"Fred Jones" <fred@example.com>`, m.Header.Get("To"))
+			assert.Equal(t, "<"+msg.UnsubscribeURL+">", m.Header.Get("List-Unsubscribe"))
+			assert.Equal(t, "List-Unsubscribe=One-Click", m.Header.Get("List-Unsubscribe-Post"))
+
+			mediaType, params, err := mime.ParseMediaType(m.Header.Get("Content-Type"))
+			require.NoError(t, err)
+			assert.Equal(t, "multipart/alternative", mediaType)
+
+			mr := multipart.NewReader(m.Body, params["boundary"])
+			var contentTypes []string
+			for {
+				p, err := mr.NextPart()
+				if err != nil {
+					break
+				}
+				contentTypes = append(contentTypes, p.Header.Get("Content-Type"))
+				body, _ := ioutil.ReadAll(p)
+				if strings.HasPrefix(p.Header.Get("Content-Type"), "text/plain") {
+					assert.NotContains(t, string(body), "<html")
+				} else {
+					assert.Contains(t, string(body), `href="`+msg.UnsubscribeURL+`"`)
+				}
+			}
+			assert.Equal(t, []string{"text/plain; charset=UTF-8", "text/html; charset=UTF-8"}, contentTypes)
+		})
+	}
+}
+
+func TestCheckConfig(t *testing.T) {
+	oldEnv := domain.Env
+	defer func() { domain.Env = oldEnv }()
+
+	tests := []struct {
+		name          string
+		emailService  string
+		smtpSecurity  string
+		mobileService string
+		wantErr       bool
+	}{
+		{name: "sendgrid", emailService: EmailServiceSendGrid, mobileService: MobileServiceDummy},
+		{name: "smtp", emailService: EmailServiceSMTP, smtpSecurity: SMTPSecurityTLS, mobileService: MobileServiceTwilio},
+		{name: "unknown email", emailService: "carrier_pigeon", mobileService: MobileServiceDummy, wantErr: true},
+		{name: "bad smtp security", emailService: EmailServiceSMTP, smtpSecurity: "ssl", mobileService: MobileServiceDummy,
+			wantErr: true},
+		{name: "unknown mobile", emailService: EmailServiceDummy, mobileService: "smoke_signals", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			domain.Env.EmailService = test.emailService
+			domain.Env.SMTPSecurity = test.smtpSecurity
+			domain.Env.MobileService = test.mobileService
+
+			err := CheckConfig()
+			if test.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
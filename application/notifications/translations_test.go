@@ -0,0 +1,182 @@
+package notifications
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/nicksnyder/go-i18n/i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
+// notificationTranslationPrefix is the prefix of the message IDs used for notification text. Some of these IDs are
+// not passed to the translation functions directly, e.g. the email subjects in templateSubjects, so any string literal
+// with this prefix is considered to be a message ID.
+const notificationTranslationPrefix = "Email."
+
+// translationFuncs maps the translation functions to the position of their message ID argument
+var translationFuncs = map[string]int{
+	"GetTranslatedSubject": 1,
+	"TranslateWithLang":    1,
+	"translateUnsubscribe": 1,
+}
+
+var templateTranslationRegexp = regexp.MustCompile(`\bt\("([^"]+)"\)`)
+
+// findSourceTranslationIDs returns the message IDs used in the Go source files under root, with the position of
+// each use
+func findSourceTranslationIDs(t *testing.T, root string) map[string]string {
+	ids := map[string]string{}
+	fset := token.NewFileSet()
+
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			name := info.Name()
+			if path != root && (name == "node_modules" || name == "vendor" || strings.HasPrefix(name, ".")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+
+		addLiteral := func(lit *ast.BasicLit) {
+			if lit.Kind != token.STRING {
+				return
+			}
+			if id, err := strconv.Unquote(lit.Value); err == nil {
+				ids[id] = fset.Position(lit.Pos()).String()
+			}
+		}
+
+		ast.Inspect(f, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.CallExpr:
+				var name string
+				switch fun := node.Fun.(type) {
+				case *ast.Ident:
+					name = fun.Name
+				case *ast.SelectorExpr:
+					name = fun.Sel.Name
+				}
+				if i, ok := translationFuncs[name]; ok && len(node.Args) > i {
+					if lit, ok := node.Args[i].(*ast.BasicLit); ok {
+						addLiteral(lit)
+					}
+				}
+			case *ast.BasicLit:
+				if s, err := strconv.Unquote(node.Value); err == nil && strings.HasPrefix(s, notificationTranslationPrefix) {
+					addLiteral(node)
+				}
+			}
+			return true
+		})
+		return nil
+	})
+	require.NoError(t, err)
+
+	return ids
+}
+
+// findTemplateTranslationIDs returns the message IDs used by the `t` helper in the mail templates
+func findTemplateTranslationIDs(t *testing.T, dir string) map[string]string {
+	ids := map[string]string{}
+
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	for _, f := range files {
+		content, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+		require.NoError(t, err)
+		for _, match := range templateTranslationRegexp.FindAllStringSubmatch(string(content), -1) {
+			ids[match[1]] = f.Name()
+		}
+	}
+
+	return ids
+}
+
+func TestTranslationCompleteness(t *testing.T) {
+	used := findSourceTranslationIDs(t, "..")
+	for id, where := range findTemplateTranslationIDs(t, "../templates/mail") {
+		used[id] = where
+	}
+	require.NotEmpty(t, used, "no message IDs found")
+
+	var usedIDs []string
+	for id := range used {
+		usedIDs = append(usedIDs, id)
+	}
+	sort.Strings(usedIDs)
+
+	for _, lang := range domain.AllowedLanguages {
+		t.Run(lang, func(t *testing.T) {
+			require.NoError(t, i18n.LoadTranslationFile("../locales/global."+lang+".yaml"))
+
+			available := map[string]bool{}
+			for _, id := range i18n.LanguageTranslationIDs(lang) {
+				available[id] = true
+			}
+
+			for _, id := range usedIDs {
+				assert.True(t, available[id], "translation of '%s' (used at %s) is missing", id, used[id])
+			}
+		})
+	}
+}
+
+func TestLocalizedTemplateNames(t *testing.T) {
+	files, err := ioutil.ReadDir("../templates/mail")
+	require.NoError(t, err)
+
+	for _, f := range files {
+		parts := strings.Split(f.Name(), ".")
+		if len(parts) != 4 {
+			continue
+		}
+
+		assert.True(t, domain.IsLanguageAllowed(parts[1]) && parts[1] != domain.UserPreferenceLanguageEnglish,
+			"template %s has an unsupported language", f.Name())
+		assert.True(t, mailTemplates.Has(parts[0]+".plush.html"),
+			"template %s has no English version", f.Name())
+	}
+}
+
+func TestLocalizedTemplatesComplete(t *testing.T) {
+	files, err := ioutil.ReadDir("../templates/mail")
+	require.NoError(t, err)
+
+	for _, f := range files {
+		parts := strings.Split(f.Name(), ".")
+		if len(parts) != 3 || f.Name() == "layout.plush.html" {
+			continue
+		}
+
+		for _, language := range domain.AllowedLanguages {
+			if language == domain.UserPreferenceLanguageEnglish {
+				continue
+			}
+			name := parts[0] + "." + language + ".plush.html"
+			assert.True(t, mailTemplates.Has(name), "template %s has no %s version", f.Name(), language)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package notifications
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
+// TwilioService sends text messages using the Twilio Messages API, or a compatible service at domain.Env.TwilioBaseURL
+type TwilioService struct {
+}
+
+// twilioError is the error response body from the Twilio API
+type twilioError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Send a text message
+func (t *TwilioService) Send(msg Message) error {
+	sid := domain.Env.TwilioAccountSID
+	if sid == "" || domain.Env.TwilioAuthToken == "" || domain.Env.TwilioFromNumber == "" {
+		return errors.New("Twilio account SID, auth token and from number are required")
+	}
+
+	body, err := renderSMS(msg)
+	if err != nil {
+		return err
+	}
+
+	form := url.Values{}
+	form.Set("To", msg.ToPhone)
+	form.Set("From", domain.Env.TwilioFromNumber)
+	form.Set("Body", body)
+
+	endpoint := fmt.Sprintf("%s/2010-04-01/Accounts/%s/Messages.json",
+		strings.TrimRight(domain.Env.TwilioBaseURL, "/"), url.PathEscape(sid))
+	req, err := http.NewRequest(http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("error creating Twilio request, %s", err)
+	}
+	req.SetBasicAuth(sid, domain.Env.TwilioAuthToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error attempting to send text message, %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		var twErr twilioError
+		_ = json.NewDecoder(resp.Body).Decode(&twErr)
+		return fmt.Errorf("error response (%d) from Twilio API, code %d: %s",
+			resp.StatusCode, twErr.Code, twErr.Message)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package notifications
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/silinternational/wecarry-api/domain"
+)
+
+// fakeTwilio is a stand-in for the Twilio Messages API
+type fakeTwilio struct {
+	status   int
+	path     string
+	user     string
+	password string
+	form     url.Values
+}
+
+func (f *fakeTwilio) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.path = r.URL.Path
+	f.user, f.password, _ = r.BasicAuth()
+	_ = r.ParseForm()
+	f.form = r.PostForm
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(f.status)
+	if f.status >= 300 {
+		_, _ = w.Write([]byte(`{"code": 21211, "message": "The 'To' number is not a valid phone number."}`))
+		return
+	}
+	_, _ = w.Write([]byte(`{"sid": "SM123", "status": "queued"}`))
+}
+
+func TestTwilioService_Send(t *testing.T) {
+	oldEnv := domain.Env
+	defer func() { domain.Env = oldEnv }()
+
+	domain.Env.AppName = "WeCarry"
+	domain.Env.TwilioAccountSID = "AC123"
+	domain.Env.TwilioAuthToken = "secret"
+	domain.Env.TwilioFromNumber = "+15555550100"
+
+	msg := Message{
+		Template: domain.MessageTemplateNewThreadMessage,
+		ToPhone:  "+15555550199",
+		Data: map[string]interface{}{
+			"sentByNickname": "Fred",
+			"requestTitle":   "Coffee & <tea>",
+			"threadURL":      "https://example.com/messages/1",
+		},
+	}
+
+	tests := []struct {
+		name    string
+		status  int
+		wantErr string
+	}{
+		{name: "created", status: http.StatusCreated},
+		{name: "error", status: http.StatusBadRequest, wantErr: "21211"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fake := &fakeTwilio{status: test.status}
+			server := httptest.NewServer(fake)
+			defer server.Close()
+			domain.Env.TwilioBaseURL = server.URL + "/"
+
+			var s TwilioService
+			err := s.Send(msg)
+			if test.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, "/2010-04-01/Accounts/AC123/Messages.json", fake.path)
+			assert.Equal(t, "AC123", fake.user)
+			assert.Equal(t, "secret", fake.password)
+			assert.Equal(t, "+15555550199", fake.form.Get("To"))
+			assert.Equal(t, "+15555550100", fake.form.Get("From"))
+			assert.Equal(t,
+				`WeCarry: Fred sent you a message about "Coffee & <tea>". https://example.com/messages/1`,
+				fake.form.Get("Body"))
+		})
+	}
+}
+
+func TestRenderSMS(t *testing.T) {
+	for name := range smsTemplates {
+		t.Run(name, func(t *testing.T) {
+			body, err := renderSMS(Message{Template: name, Data: map[string]interface{}{}})
+			require.NoError(t, err)
+			assert.True(t, len(body) < 160, "text message template is too long: %s", body)
+		})
+	}
+
+	_, err := renderSMS(Message{Template: domain.MessageTemplateNewRequest})
+	assert.Error(t, err, "expected an error for a template without a text version")
+}
+
+func TestRenderSMS_Language(t *testing.T) {
+	data := map[string]interface{}{
+		"authorNickname": "Fred",
+		"meetingName":    "Conference",
+		"announcement":   "The exchange desk is in room B",
+	}
+
+	tests := []struct {
+		name     string
+		language string
+		want     string
+	}{
+		{
+			name: "default",
+			want: `posted an announcement for "Conference": The exchange desk is in room B`,
+		},
+		{
+			name:     "french",
+			language: domain.UserPreferenceLanguageFrench,
+			want:     `a publié une annonce pour "Conference" : The exchange desk is in room B`,
+		},
+		{
+			name:     "spanish",
+			language: domain.UserPreferenceLanguageSpanish,
+			want:     `publicó un anuncio para "Conference": The exchange desk is in room B`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			body, err := renderSMS(Message{Template: domain.MessageTemplateMeetingAnnouncement, Data: data,
+				Language: test.language})
+			require.NoError(t, err)
+			assert.Contains(t, body, test.want)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package webhooks
+
+import (
+	"time"
+
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/models"
+)
+
+// LocationData is the location of a request or meeting in a webhook payload
+type LocationData struct {
+	Description string   `json:"description"`
+	Country     string   `json:"country"`
+	Latitude    *float64 `json:"latitude,omitempty"`
+	Longitude   *float64 `json:"longitude,omitempty"`
+}
+
+// UserData identifies a user in a webhook payload
+type UserData struct {
+	ID       string `json:"id"`
+	Nickname string `json:"nickname"`
+}
+
+// RequestData is the payload data of the request events
+type RequestData struct {
+	ID             string                   `json:"id"`
+	Title          string                   `json:"title"`
+	Status         models.RequestStatus     `json:"status"`
+	OldStatus      models.RequestStatus     `json:"oldStatus,omitempty"`
+	Visibility     models.RequestVisibility `json:"visibility"`
+	URL            string                   `json:"url"`
+	Destination    LocationData             `json:"destination"`
+	NeededBefore   *time.Time               `json:"neededBefore,omitempty"`
+	OrganizationID string                   `json:"organizationID"`
+	CreatedAt      time.Time                `json:"createdAt"`
+}
+
+// PotentialProviderData is the payload data of the potential provider events
+type PotentialProviderData struct {
+	Request  RequestData `json:"request"`
+	Provider UserData    `json:"provider"`
+}
+
+// MeetingData is the payload data of the meeting events
+type MeetingData struct {
+	ID        string       `json:"id"`
+	Name      string       `json:"name"`
+	StartDate string       `json:"startDate"`
+	EndDate   string       `json:"endDate"`
+	Location  LocationData `json:"location"`
+	CreatedAt time.Time    `json:"createdAt"`
+}
+
+// UserJoinedOrganizationData is the payload data of the user joined organization event
+type UserJoinedOrganizationData struct {
+	User UserData `json:"user"`
+}
+
+// NewLocationData converts a location for a webhook payload
+func NewLocationData(l models.Location) LocationData {
+	data := LocationData{
+		Description: l.Description,
+		Country:     l.Country,
+	}
+	if l.Latitude.Valid && l.Longitude.Valid {
+		data.Latitude = &l.Latitude.Float64
+		data.Longitude = &l.Longitude.Float64
+	}
+	return data
+}
+
+// NewUserData converts a user for a webhook payload. Only public profile data is included.
+func NewUserData(u models.User) UserData {
+	return UserData{
+		ID:       u.UUID.String(),
+		Nickname: u.Nickname,
+	}
+}
+
+// NewRequestData converts a request for a webhook payload
+func NewRequestData(r models.Request) (RequestData, error) {
+	destination, err := r.GetDestination()
+	if err != nil {
+		return RequestData{}, err
+	}
+
+	org, err := r.GetOrganization()
+	if err != nil {
+		return RequestData{}, err
+	}
+
+	data := RequestData{
+		ID:             r.UUID.String(),
+		Title:          r.Title,
+		Status:         r.Status,
+		Visibility:     r.Visibility,
+		URL:            domain.GetRequestUIURL(r.UUID.String()),
+		Destination:    NewLocationData(*destination),
+		OrganizationID: org.UUID.String(),
+		CreatedAt:      r.CreatedAt,
+	}
+	if r.NeededBefore.Valid {
+		data.NeededBefore = &r.NeededBefore.Time
+	}
+	return data, nil
+}
+
+// NewMeetingData converts a meeting for a webhook payload
+func NewMeetingData(m models.Meeting) (MeetingData, error) {
+	location, err := m.GetLocation()
+	if err != nil {
+		return MeetingData{}, err
+	}
+
+	return MeetingData{
+		ID:        m.UUID.String(),
+		Name:      m.Name,
+		StartDate: m.StartDate.Format(domain.DateFormat),
+		EndDate:   m.EndDate.Format(domain.DateFormat),
+		Location:  NewLocationData(location),
+		CreatedAt: m.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,223 @@
+// Package webhooks delivers notice of App events to the endpoints registered by organizations
+package webhooks
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/models"
+)
+
+const (
+	HeaderEvent     = "X-WeCarry-Event"
+	HeaderDelivery  = "X-WeCarry-Delivery"
+	HeaderTimestamp = "X-WeCarry-Timestamp"
+	HeaderSignature = "X-WeCarry-Signature"
+
+	signaturePrefix = "sha256="
+)
+
+// client is the HTTP client used for deliveries. It is a variable so that it can be replaced in tests.
+var client = &http.Client{
+	Timeout: domain.WebhookTimeout,
+	Transport: &http.Transport{
+		DialContext:         (&net.Dialer{Timeout: domain.WebhookTimeout, Control: checkPublicAddress}).DialContext,
+		TLSHandshakeTimeout: domain.WebhookTimeout,
+	},
+}
+
+// checkPublicAddress refuses connections to non-public addresses. The webhook URL is checked when it is saved, but the
+// host name may resolve differently by the time a payload is delivered, including after a redirect.
+func checkPublicAddress(network, address string, c syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if !domain.IsPublicIP(net.ParseIP(host)) {
+		return fmt.Errorf("webhook delivery to non-public address %s refused", host)
+	}
+	return nil
+}
+
+// Payload is the JSON body posted to a webhook
+type Payload struct {
+	ID             string              `json:"id"`
+	Event          models.WebhookEvent `json:"event"`
+	CreatedAt      time.Time           `json:"createdAt"`
+	OrganizationID string              `json:"organizationID"`
+	Data           interface{}         `json:"data"`
+}
+
+// Sign returns the signature of a webhook body, computed as the hex-encoded HMAC-SHA256 of the timestamp header, a
+// period, and the body, using the webhook's secret as the key
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Dispatch queues the event for each active webhook subscribed to it, and attempts the first delivery. The isVisible
+// function limits the event to the organizations that are allowed to see its data.
+func Dispatch(event models.WebhookEvent, data interface{}, isVisible func(orgID int) (bool, error)) error {
+	var webhooks models.OrganizationWebhooks
+	if err := webhooks.FindActiveByEvent(event); err != nil {
+		return fmt.Errorf("error finding webhooks for event %s, %s", event, err)
+	}
+
+	var lastErr error
+	for i := range webhooks {
+		visible, err := isVisible(webhooks[i].OrganizationID)
+		if err != nil {
+			domain.ErrLogger.Printf("error checking visibility of %s event for webhook %s, %s",
+				event, webhooks[i].UUID, err)
+			lastErr = err
+			continue
+		}
+		if !visible {
+			continue
+		}
+
+		delivery, err := enqueue(webhooks[i], event, data)
+		if err != nil {
+			domain.ErrLogger.Printf("error queueing %s event for webhook %s, %s", event, webhooks[i].UUID, err)
+			lastErr = err
+			continue
+		}
+
+		if err := deliver(&delivery, webhooks[i]); err != nil {
+			domain.ErrLogger.Printf("webhook delivery %s not delivered on first attempt, %s", delivery.UUID, err)
+		}
+	}
+
+	return lastErr
+}
+
+// enqueue stores a delivery of the event to the given webhook
+func enqueue(webhook models.OrganizationWebhook, event models.WebhookEvent, data interface{}) (models.WebhookDelivery,
+	error) {
+	org, err := webhook.GetOrganization()
+	if err != nil {
+		return models.WebhookDelivery{}, err
+	}
+
+	delivery := models.WebhookDelivery{
+		UUID:      domain.GetUUID(),
+		WebhookID: webhook.ID,
+		Event:     event,
+	}
+
+	body, err := json.Marshal(Payload{
+		ID:             delivery.UUID.String(),
+		Event:          event,
+		CreatedAt:      time.Now().UTC(),
+		OrganizationID: org.UUID.String(),
+		Data:           data,
+	})
+	if err != nil {
+		return delivery, fmt.Errorf("error encoding webhook payload, %s", err)
+	}
+	delivery.Payload = string(body)
+
+	return delivery, delivery.Enqueue()
+}
+
+// deliver posts a queued delivery to its webhook and records the result. The delivery must have been claimed by the
+// caller.
+func deliver(d *models.WebhookDelivery, webhook models.OrganizationWebhook) error {
+	status, postErr := post(webhook.URL, webhook.Secret, d.Event, d.UUID, []byte(d.Payload))
+	if postErr != nil {
+		if err := d.RecordFailure(status, postErr); err != nil {
+			domain.ErrLogger.Printf("error recording webhook delivery failure, %s", err)
+		}
+		return postErr
+	}
+
+	if err := d.RecordSent(status); err != nil {
+		// the payload was delivered, so don't return an error that would cause it to be sent again
+		domain.ErrLogger.Printf("error recording webhook delivery, %s", err)
+	}
+	return nil
+}
+
+// post sends a signed payload to a webhook URL. It returns the response status, or zero if no response was received,
+// and an error unless the response status is 2xx.
+func post(url, secret string, event models.WebhookEvent, deliveryID uuid.UUID, body []byte) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("error creating webhook request, %s", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", domain.Env.AppName+"-Webhooks")
+	req.Header.Set(HeaderEvent, event.String())
+	req.Header.Set(HeaderDelivery, deliveryID.String())
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(secret, timestamp, body))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// drain a limited amount of the body so that the connection can be reused
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, errors.New("webhook responded with status " + resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// DeliverDue retries the queued deliveries that are due. It returns the number of payloads delivered and the number
+// that failed.
+func DeliverDue() (sent, failed int, err error) {
+	var due models.WebhookDeliveries
+	if err := due.FindDue(domain.WebhookBatchSize); err != nil {
+		return 0, 0, fmt.Errorf("error finding webhook deliveries, %s", err)
+	}
+
+	for i := range due {
+		claimed, err := due[i].Claim()
+		if err != nil {
+			domain.ErrLogger.Printf("error claiming webhook delivery %d, %s", due[i].ID, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		webhook, err := due[i].GetWebhook()
+		if err != nil {
+			domain.ErrLogger.Printf("error loading webhook for delivery %d, %s", due[i].ID, err)
+			continue
+		}
+
+		if err := deliver(&due[i], webhook); err != nil {
+			domain.ErrLogger.Printf("webhook delivery %d not delivered on attempt %d, %s",
+				due[i].ID, due[i].Attempts, err)
+			failed++
+			continue
+		}
+		sent++
+	}
+
+	return sent, failed, nil
+}
@@ -0,0 +1,111 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/silinternational/wecarry-api/domain"
+	"github.com/silinternational/wecarry-api/models"
+)
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"event":"REQUEST_CREATED"}`)
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("1587480000." + string(body)))
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	if got := Sign("secret", "1587480000", body); got != want {
+		t.Errorf("Sign() = %s, want %s", got, want)
+	}
+	if Sign("other", "1587480000", body) == want {
+		t.Error("Sign() should depend on the secret")
+	}
+	if Sign("secret", "1587480001", body) == want {
+		t.Error("Sign() should depend on the timestamp")
+	}
+}
+
+func TestPost(t *testing.T) {
+	var gotHeader http.Header
+	var gotBody []byte
+	status := http.StatusNoContent
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header
+		gotBody, _ = ioutil.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	oldClient := client
+	client = server.Client()
+	defer func() { client = oldClient }()
+
+	body := []byte(`{"event":"MEETING_CREATED"}`)
+	deliveryID := domain.GetUUID()
+
+	gotStatus, err := post(server.URL, "secret", models.WebhookEventMeetingCreated, deliveryID, body)
+	if err != nil {
+		t.Fatalf("post() returned error: %s", err)
+	}
+	if gotStatus != http.StatusNoContent {
+		t.Errorf("post() status = %d, want %d", gotStatus, http.StatusNoContent)
+	}
+	if string(gotBody) != string(body) {
+		t.Errorf("wrong body posted: %s", gotBody)
+	}
+	if gotHeader.Get(HeaderEvent) != "MEETING_CREATED" {
+		t.Errorf("wrong event header: %s", gotHeader.Get(HeaderEvent))
+	}
+	if gotHeader.Get(HeaderDelivery) != deliveryID.String() {
+		t.Errorf("wrong delivery header: %s", gotHeader.Get(HeaderDelivery))
+	}
+	if gotHeader.Get("Content-Type") != "application/json" {
+		t.Errorf("wrong content type: %s", gotHeader.Get("Content-Type"))
+	}
+	wantSignature := Sign("secret", gotHeader.Get(HeaderTimestamp), body)
+	if gotHeader.Get(HeaderSignature) != wantSignature {
+		t.Errorf("wrong signature header: %s, want %s", gotHeader.Get(HeaderSignature), wantSignature)
+	}
+
+	status = http.StatusGone
+	gotStatus, err = post(server.URL, "secret", models.WebhookEventMeetingCreated, deliveryID, body)
+	if err == nil {
+		t.Error("post() should return an error for a non-2xx response")
+	}
+	if gotStatus != http.StatusGone {
+		t.Errorf("post() status = %d, want %d", gotStatus, http.StatusGone)
+	}
+
+	server.Close()
+	gotStatus, err = post(server.URL, "secret", models.WebhookEventMeetingCreated, deliveryID, body)
+	if err == nil {
+		t.Error("post() should return an error when there is no response")
+	}
+	if gotStatus != 0 {
+		t.Errorf("post() status = %d, want 0 when there is no response", gotStatus)
+	}
+}
+
+func TestPost_NonPublicAddress(t *testing.T) {
+	var called bool
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	// the test server listens on a loopback address, which the delivery client must refuse
+	gotStatus, err := post(server.URL, "secret", models.WebhookEventMeetingCreated, domain.GetUUID(), []byte(`{}`))
+	if err == nil {
+		t.Error("post() should return an error for a loopback address")
+	}
+	if gotStatus != 0 || called {
+		t.Error("payload was posted to a loopback address")
+	}
+}